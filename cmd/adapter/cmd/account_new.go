@@ -69,11 +69,11 @@ func (c *AccountNewCommand) newAccount(ctx context.Context) error {
 		Descfile: c.descfile,
 		IsQuick:  false,
 
-		ChainName:    c.cli.RootOptions.Name,
-		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
-		CryptoType:   c.cli.RootOptions.Crypto,
-		CliConf:      c.cli.CliConf,
+		ChainName:  c.cli.RootOptions.Name,
+		Keys:       c.cli.RootOptions.Keys,
+		Client:     c.cli.Client(),
+		CryptoType: c.cli.RootOptions.Crypto,
+		CliConf:    c.cli.CliConf,
 
 		DebugTx: c.debug,
 	}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	xpb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// AdminCommand admin cmd
+type AdminCommand struct {
+}
+
+// NewAdminCommand new admin cmd
+func NewAdminCommand(cli *Cli) *cobra.Command {
+	var host, token string
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Operate node runtime management: loglevel|goroutines|heap|cpu|addpeer|chains|drain.",
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if host != "" {
+				cli.CliConf.AdminHost = host
+			}
+			if token != "" {
+				cli.CliConf.AdminToken = token
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&host, "admin-host", "", "admin service ip:port, default adminHost in client config")
+	cmd.PersistentFlags().StringVar(&token, "admin-token", "", "admin token, default adminToken in client config")
+	cmd.AddCommand(NewAdminLogLevelCommand(cli))
+	cmd.AddCommand(NewAdminProfileCommands(cli)...)
+	cmd.AddCommand(NewAdminAddPeerCommand(cli))
+	cmd.AddCommand(NewAdminChainCommands(cli)...)
+	cmd.AddCommand(NewAdminDrainCommand(cli))
+	cmd.AddCommand(NewAdminWebhookCommand(cli))
+	return cmd
+}
+
+// adminToken 每次请求携带admin token
+type adminToken string
+
+func (t adminToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t adminToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// AdminClient get admin client
+func (c *Cli) AdminClient() (xpb.AdminClient, error) {
+	if c.CliConf.AdminToken == "" {
+		return nil, fmt.Errorf("admin token unset")
+	}
+	conn, err := grpc.Dial(c.CliConf.AdminHost, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1),
+		grpc.WithPerRPCCredentials(adminToken(c.CliConf.AdminToken)))
+	if err != nil {
+		return nil, err
+	}
+	return xpb.NewAdminClient(conn), nil
+}
+
+func newAdminReqHeader() *xpb.ReqHeader {
+	return &xpb.ReqHeader{
+		LogId:    utils.GenLogId(),
+		SelfName: "xchain-cli",
+	}
+}
+
+func checkAdminRespHeader(header *xpb.RespHeader) error {
+	if header.GetErrCode() != 0 {
+		return fmt.Errorf("admin request failed.log_id:%s err_code:%d err_msg:%s",
+			header.GetLogId(), header.GetErrCode(), header.GetErrMsg())
+	}
+	return nil
+}
+
+func init() {
+	AddCommand(NewAdminCommand)
+}
@@ -0,0 +1,170 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	xpb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// AdminChainsCommand parallel chain lifecycle cmd
+type AdminChainsCommand struct {
+	cli *Cli
+	// 创世块配置文件
+	genesis string
+	// 创建后不加载
+	noLoad bool
+}
+
+// NewAdminChainCommands new list/create/load/disable chain cmds
+func NewAdminChainCommands(cli *Cli) []*cobra.Command {
+	c := new(AdminChainsCommand)
+	c.cli = cli
+
+	list := &cobra.Command{
+		Use:   "chains",
+		Short: "List chains in the data dir with their states",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.listChains(context.TODO())
+		},
+	}
+
+	create := &cobra.Command{
+		Use:     "createchain name",
+		Short:   "Create a parallel chain from a genesis json and load it",
+		Example: "xchain-cli admin createchain hello --genesis ./data/genesis/xuper.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.createChain(context.TODO(), args[0])
+		},
+	}
+	create.Flags().StringVarP(&c.genesis, "genesis", "g", "", "genesis config file path")
+	create.Flags().BoolVar(&c.noLoad, "no-load", false, "create the chain without loading it")
+
+	load := &cobra.Command{
+		Use:   "loadchain name",
+		Short: "Load a chain in the data dir",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.loadChain(context.TODO(), args[0], true)
+		},
+	}
+
+	disable := &cobra.Command{
+		Use:   "disablechain name",
+		Short: "Stop mining a chain and hide it from services, restart the node to load it again",
+		Long: "Stop mining a chain and hide it from services. The kernel can not remove a chain, " +
+			"so its storage and p2p message handling keep running until the node restarts.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.loadChain(context.TODO(), args[0], false)
+		},
+	}
+
+	return []*cobra.Command{list, create, load, disable}
+}
+
+func (c *AdminChainsCommand) listChains(ctx context.Context) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ListChains(ctx, &xpb.BaseReq{Header: newAdminReqHeader()})
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSTATUS\tHEIGHT\tROOT")
+	for _, chain := range resp.GetChains() {
+		height := "-"
+		if chain.GetStatus() == xpb.ChainStatus_CHAIN_LOADED {
+			height = fmt.Sprintf("%d", chain.GetHeight())
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", chain.GetName(), chainStatusName(chain.GetStatus()),
+			height, chain.GetRoot())
+	}
+	return w.Flush()
+}
+
+func (c *AdminChainsCommand) createChain(ctx context.Context, name string) error {
+	if c.genesis == "" {
+		return errors.New("genesis config file unset")
+	}
+	genesis, err := ioutil.ReadFile(c.genesis)
+	if err != nil {
+		return err
+	}
+	if !json.Valid(genesis) {
+		return fmt.Errorf("genesis config is not valid json: %s", c.genesis)
+	}
+
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+	req := &xpb.CreateChainReq{
+		Header:  newAdminReqHeader(),
+		Name:    name,
+		Genesis: genesis,
+		Load:    !c.noLoad,
+	}
+	resp, err := client.CreateChain(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+	return printChainInfo(resp.GetChain())
+}
+
+func (c *AdminChainsCommand) loadChain(ctx context.Context, name string, load bool) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+	req := &xpb.ChainReq{
+		Header: newAdminReqHeader(),
+		Name:   name,
+	}
+
+	var resp *xpb.ChainResp
+	if load {
+		resp, err = client.LoadChain(ctx, req)
+	} else {
+		resp, err = client.DisableChain(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+	return printChainInfo(resp.GetChain())
+}
+
+func printChainInfo(chain *xpb.ChainInfo) error {
+	fmt.Printf("chain: %s\nstatus: %s\nheight: %d\n", chain.GetName(),
+		chainStatusName(chain.GetStatus()), chain.GetHeight())
+	return nil
+}
+
+func chainStatusName(status xpb.ChainStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "CHAIN_"))
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	xpb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// AdminDrainCommand drain cmd
+type AdminDrainCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	timeout int64
+	resume  bool
+}
+
+// NewAdminDrainCommand new drain cmd
+func NewAdminDrainCommand(cli *Cli) *cobra.Command {
+	c := new(AdminDrainCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "drain",
+		Short: "Stop accepting PostTx and wait for in-flight requests, --resume to accept again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.drain(context.TODO())
+		},
+	}
+	c.cmd.Flags().Int64Var(&c.timeout, "timeout", 30, "seconds to wait for in-flight requests")
+	c.cmd.Flags().BoolVar(&c.resume, "resume", false, "resume accepting transactions")
+	return c.cmd
+}
+
+func (c *AdminDrainCommand) drain(ctx context.Context) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+	req := &xpb.DrainReq{
+		Header:  newAdminReqHeader(),
+		Timeout: c.timeout,
+		Resume:  c.resume,
+	}
+	resp, err := client.Drain(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+
+	fmt.Printf("draining: %v, inflight: %d\n", resp.GetDraining(), resp.GetInflight())
+	if resp.GetDraining() && resp.GetInflight() > 0 {
+		return fmt.Errorf("drain timeout, %d requests still in flight", resp.GetInflight())
+	}
+	return nil
+}
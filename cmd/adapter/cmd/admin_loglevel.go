@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	xpb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// AdminLogLevelCommand log level cmd
+type AdminLogLevelCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewAdminLogLevelCommand new log level cmd
+func NewAdminLogLevelCommand(cli *Cli) *cobra.Command {
+	c := new(AdminLogLevelCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "loglevel [module level]",
+		Short: "Get runtime log levels, or set the level of a module, empty level restores the config level",
+		Long: "Get runtime log levels, or set the level of a module, empty level restores the config level.\n" +
+			"The level can not be more verbose than the level of log.yaml, and only node service modules are\n" +
+			"controlled, an unknown module is rejected with the available modules.",
+		Args: cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.logLevel(context.TODO(), args)
+		},
+	}
+	return c.cmd
+}
+
+func (c *AdminLogLevelCommand) logLevel(ctx context.Context, args []string) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+
+	var resp *xpb.LogLevelResp
+	if len(args) == 0 {
+		resp, err = client.GetLogLevels(ctx, &xpb.BaseReq{Header: newAdminReqHeader()})
+	} else {
+		req := &xpb.SetLogLevelReq{
+			Header: newAdminReqHeader(),
+			Module: args[0],
+		}
+		if len(args) > 1 {
+			req.Level = args[1]
+		}
+		resp, err = client.SetLogLevel(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(resp.GetLevels(), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	xpb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// AdminAddPeerCommand add peer cmd
+type AdminAddPeerCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+}
+
+// NewAdminAddPeerCommand new add peer cmd
+func NewAdminAddPeerCommand(cli *Cli) *cobra.Command {
+	c := new(AdminAddPeerCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "addpeer address",
+		Short: "Connect a p2p peer, e.g. /ip4/127.0.0.1/tcp/47101/p2p/Qm...",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.addPeer(context.TODO(), args[0])
+		},
+	}
+	return c.cmd
+}
+
+func (c *AdminAddPeerCommand) addPeer(ctx context.Context, address string) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+	req := &xpb.PeerReq{
+		Header:  newAdminReqHeader(),
+		Address: address,
+	}
+	resp, err := client.AddPeer(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+
+	output, err := json.MarshalIndent(resp.GetPeers(), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	xpb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// AdminProfileCommand goroutine/heap/cpu profile cmd
+type AdminProfileCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	output  string
+	seconds int64
+}
+
+// NewAdminProfileCommands new goroutines, heap and cpu cmd
+func NewAdminProfileCommands(cli *Cli) []*cobra.Command {
+	return []*cobra.Command{
+		newAdminProfileCommand(cli, "goroutines", "Dump the stacks of all goroutines"),
+		newAdminProfileCommand(cli, "heap", "Write a heap profile"),
+		newAdminProfileCommand(cli, "cpu", "Sample a cpu profile, blocks until sampling finishes"),
+	}
+}
+
+func newAdminProfileCommand(cli *Cli, name, short string) *cobra.Command {
+	c := new(AdminProfileCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   name,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.profile(context.TODO(), name)
+		},
+	}
+	c.cmd.Flags().StringVarP(&c.output, "output", "o", "", "output file, default stdout")
+	if name == "cpu" {
+		c.cmd.Flags().Int64Var(&c.seconds, "seconds", 30, "sampling duration in seconds")
+	}
+	return c.cmd
+}
+
+func (c *AdminProfileCommand) profile(ctx context.Context, name string) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+
+	var resp *xpb.ProfileResp
+	switch name {
+	case "goroutines":
+		resp, err = client.DumpGoroutines(ctx, &xpb.BaseReq{Header: newAdminReqHeader()})
+	case "heap":
+		resp, err = client.HeapProfile(ctx, &xpb.BaseReq{Header: newAdminReqHeader()})
+	default:
+		resp, err = client.CpuProfile(ctx, &xpb.CpuProfileReq{Header: newAdminReqHeader(), Seconds: c.seconds})
+	}
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+
+	if c.output == "" {
+		_, err = os.Stdout.Write(resp.GetData())
+		return err
+	}
+	if err := ioutil.WriteFile(c.output, resp.GetData(), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("write %s profile to %s, %d bytes\n", name, c.output, len(resp.GetData()))
+	return nil
+}
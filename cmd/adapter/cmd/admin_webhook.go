@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	xpb "github.com/xuperchain/xuperos/common/xupospb"
+)
+
+// AdminWebhookCommand webhook subscription cmd
+type AdminWebhookCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	url    string
+	secret string
+	filter xpb.WebhookFilter
+}
+
+// NewAdminWebhookCommand new webhook cmd
+func NewAdminWebhookCommand(cli *Cli) *cobra.Command {
+	c := new(AdminWebhookCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "webhook",
+		Short: "Manage webhook subscriptions of contract events",
+	}
+
+	add := &cobra.Command{
+		Use:     "add name",
+		Short:   "Add a webhook subscription",
+		Example: "xchain-cli admin webhook add counter --url http://127.0.0.1:8080/events --secret changeme --contract counter",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.add(context.TODO(), args[0])
+		},
+	}
+	add.Flags().StringVar(&c.url, "url", "", "target url, events are POSTed as json")
+	add.Flags().StringVar(&c.secret, "secret", "", "HMAC-SHA256 signature secret")
+	add.Flags().StringVar(&c.filter.Start, "start", "", "first block height, default the tip block")
+	add.Flags().StringVar(&c.filter.Contract, "contract", "", "contract name regexp")
+	add.Flags().StringVar(&c.filter.EventName, "event-name", "", "contract event name regexp")
+	add.Flags().StringVar(&c.filter.Initiator, "initiator", "", "tx initiator regexp")
+	add.Flags().StringVar(&c.filter.AuthRequire, "auth-require", "", "tx auth require regexp")
+	add.Flags().StringVar(&c.filter.FromAddr, "from-addr", "", "utxo from address regexp")
+	add.Flags().StringVar(&c.filter.ToAddr, "to-addr", "", "utxo to address regexp")
+
+	remove := &cobra.Command{
+		Use:   "remove name",
+		Short: "Remove a webhook subscription added by admin",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.remove(context.TODO(), args[0])
+		},
+	}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List webhook subscriptions and delivery states",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.list(context.TODO())
+		},
+	}
+
+	c.cmd.AddCommand(add, remove, list)
+	return c.cmd
+}
+
+func (c *AdminWebhookCommand) add(ctx context.Context, name string) error {
+	if c.url == "" {
+		return errors.New("url unset")
+	}
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+
+	filter := c.filter
+	filter.Bcname = c.cli.RootOptions.Name
+	req := &xpb.WebhookReq{
+		Header: newAdminReqHeader(),
+		Webhook: &xpb.Webhook{
+			Name:   name,
+			Url:    c.url,
+			Secret: c.secret,
+			Filter: &filter,
+		},
+	}
+	resp, err := client.AddWebhook(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+	fmt.Printf("add webhook %s succ\n", name)
+	return nil
+}
+
+func (c *AdminWebhookCommand) remove(ctx context.Context, name string) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+	req := &xpb.WebhookNameReq{
+		Header: newAdminReqHeader(),
+		Name:   name,
+	}
+	resp, err := client.RemoveWebhook(ctx, req)
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+	fmt.Printf("remove webhook %s succ\n", name)
+	return nil
+}
+
+func (c *AdminWebhookCommand) list(ctx context.Context) error {
+	client, err := c.cli.AdminClient()
+	if err != nil {
+		return err
+	}
+	resp, err := client.ListWebhooks(ctx, &xpb.BaseReq{Header: newAdminReqHeader()})
+	if err != nil {
+		return err
+	}
+	if err := checkAdminRespHeader(resp.GetHeader()); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCE\tBCNAME\tURL\tCURSOR\tDELIVERED\tRETRIES\tLAST ERROR")
+	for _, st := range resp.GetWebhooks() {
+		hook := st.GetWebhook()
+		cursor := "-"
+		if st.GetCursorHeight() >= 0 {
+			cursor = fmt.Sprintf("%d", st.GetCursorHeight())
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\n", hook.GetName(), st.GetSource(),
+			hook.GetFilter().GetBcname(), hook.GetUrl(), cursor, st.GetDelivered(), st.GetRetries(),
+			st.GetLastError())
+	}
+	return w.Flush()
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+// BlockRangeCommand 通过GetBlocksStream下载一段高度的主干区块
+type BlockRangeCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	content bool
+	output  string
+}
+
+// NewBlockRangeCommand new blocks cmd
+func NewBlockRangeCommand(cli *Cli) *cobra.Command {
+	c := new(BlockRangeCommand)
+	c.cli = cli
+	c.cmd = &cobra.Command{
+		Use:   "blocks [OPTIONS] start_height [end_height]",
+		Short: "Download trunk blocks of a height range, one json block per line.",
+		Example: `  xchain-cli blocks 100 200
+  xchain-cli blocks 0 --content --output ./blocks.json --compress gzip`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			start, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			// 不指定结束高度时下载到当前主干高度
+			var end int64
+			if len(args) > 1 {
+				end, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			ctx := context.TODO()
+			return c.download(ctx, start, end)
+		},
+	}
+	c.addFlags()
+	return c.cmd
+}
+
+func (c *BlockRangeCommand) addFlags() {
+	c.cmd.Flags().BoolVar(&c.content, "content", false, "whether download the txs of blocks")
+	c.cmd.Flags().StringVarP(&c.output, "output", "o", "", "output file, print to stdout if empty")
+}
+
+func (c *BlockRangeCommand) download(ctx context.Context, start, end int64) error {
+	out := io.Writer(os.Stdout)
+	if c.output != "" {
+		f, err := os.Create(c.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	req := &pb.BlocksRange{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Bcname:      c.cli.RootOptions.Name,
+		StartHeight: start,
+		EndHeight:   end,
+		NeedContent: c.content,
+	}
+	stream, err := c.cli.XchainClient().GetBlocksStream(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+	count := 0
+	for {
+		block, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		// 节点排空时中断，提示从下一个高度继续下载
+		if height, ok := common.NextHeight(err); ok {
+			return fmt.Errorf("%d blocks downloaded, stream interrupted, resume from height %d: %v",
+				count, height, err)
+		}
+		if err != nil {
+			return err
+		}
+		if block.GetBlock() == nil {
+			return errors.New("block not found")
+		}
+		if err := encoder.Encode(FromInternalBlockPB(block.GetBlock())); err != nil {
+			return err
+		}
+		count++
+	}
+
+	if c.output != "" {
+		fmt.Printf("%d blocks saved to %s\n", count, c.output)
+	}
+	return nil
+}
+
+func init() {
+	AddCommand(NewBlockRangeCommand)
+}
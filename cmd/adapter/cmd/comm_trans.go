@@ -7,25 +7,16 @@ package cmd
 import (
 	"bufio"
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"math/big"
 	"os"
-	"strconv"
-	"time"
 
 	"github.com/golang/protobuf/proto"
-	"google.golang.org/grpc"
 
-	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
-	"github.com/xuperchain/xupercore/kernel/contract"
-	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
-	"github.com/xuperchain/xupercore/lib/utils"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
-	"github.com/xuperchain/xuperos/service/adapter/common"
+	"github.com/xuperchain/xuperos/sdk"
 )
 
 const (
@@ -53,10 +44,10 @@ type CommTrans struct {
 	IsQuick    bool
 	IsPrint    bool
 
-	ChainName    string
-	Keys         string
-	XchainClient pb.XchainClient
-	CryptoType   string
+	ChainName  string
+	Keys       string
+	Client     *sdk.Client
+	CryptoType string
 
 	// DebugTx if enabled, tx will be printed instead of being posted
 	DebugTx bool
@@ -65,104 +56,93 @@ type CommTrans struct {
 
 // GenerateTx generate raw tx
 func (c *CommTrans) GenerateTx(ctx context.Context) (*pb.Transaction, error) {
-	preExeRPCRes, preExeReqs, err := c.GenPreExeRes(ctx)
+	req, err := c.request()
 	if err != nil {
 		return nil, err
 	}
-
-	desc, _ := c.GetDesc()
-
-	tx, err := c.GenRawTx(ctx, desc, preExeRPCRes.GetResponse(), preExeReqs)
-	return tx, err
+	tx, preExeRes, err := c.Client.BuildTx(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	c.printPreExeRes(preExeRes)
+	return tx, nil
 }
 
 // GenPreExeRes 得到预执行的结果
 func (c *CommTrans) GenPreExeRes(ctx context.Context) (
 	*pb.InvokeRPCResponse, []*pb.InvokeRequest, error) {
-	preExeReqs := []*pb.InvokeRequest{}
-	if c.ModuleName != "" {
-		if c.ModuleName == "xkernel" {
-			preExeReqs = append(preExeReqs, &pb.InvokeRequest{
-				ModuleName:   c.ModuleName,
-				ContractName: c.ContractName,
-				MethodName:   c.MethodName,
-				Args:         c.Args,
-			})
-		} else {
-			invokeReq := &pb.InvokeRequest{
-				ModuleName:   c.ModuleName,
-				ContractName: c.ContractName,
-				MethodName:   c.MethodName,
-				Args:         c.Args,
-			}
-			// transfer to contract
-			if c.To == c.ContractName {
-				invokeReq.Amount = c.Amount
-			}
-			preExeReqs = append(preExeReqs, invokeReq)
-		}
-	} else {
-		tmpReq, err := c.GetInvokeRequestFromDesc()
-		if err != nil {
-			return nil, nil, fmt.Errorf("Get pb.InvokeRPCRequest error:%s", err)
-		}
-		if tmpReq != nil {
-			preExeReqs = append(preExeReqs, tmpReq)
-		}
+	req, err := c.request()
+	if err != nil {
+		return nil, nil, err
 	}
-
-	preExeRPCReq := &pb.InvokeRPCRequest{
-		Bcname: c.ChainName,
-		Header: &pb.Header{
-			Logid: utils.GenLogId(),
-		},
-		Requests: preExeReqs,
+	preExeRes, err := c.Client.PreExec(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, res := range preExeRes.GetResponses() {
+		fmt.Printf("contract response: %s\n", string(res.Body))
 	}
+	return &pb.InvokeRPCResponse{Response: preExeRes}, preExeRes.GetRequests(), nil
+}
 
-	initiator, err := c.genInitiator()
+// request 由命令参数生成sdk的交易请求
+func (c *CommTrans) request() (*sdk.Request, error) {
+	account, err := sdk.LoadAccount(c.Keys)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := c.GetDesc()
 	if err != nil {
-		return nil, nil, fmt.Errorf("Get initiator error: %s", err.Error())
+		return nil, fmt.Errorf("get desc error:%s", err)
+	}
+	req := &sdk.Request{
+		Initiator:    account,
+		To:           c.To,
+		Amount:       c.Amount,
+		FrozenHeight: c.FrozenHeight,
+		Fee:          c.Fee,
+		Desc:         desc,
+		Version:      c.Version,
+	}
+	if c.From != "" && c.From != account.Address {
+		req.ContractAccount = c.From
 	}
 
-	preExeRPCReq.Initiator = initiator
-	if !c.IsQuick {
-		preExeRPCReq.AuthRequire, err = c.genAuthRequireQuick()
-		if err != nil {
-			return nil, nil, fmt.Errorf("Get auth require quick error: %s", err.Error())
-		}
+	if c.ModuleName != "" {
+		req.Invokes = append(req.Invokes, &pb.InvokeRequest{
+			ModuleName:   c.ModuleName,
+			ContractName: c.ContractName,
+			MethodName:   c.MethodName,
+			Args:         c.Args,
+		})
 	} else {
-		preExeRPCReq.AuthRequire, err = c.GenAuthRequire(c.MultiAddrs)
+		invokeReq, err := c.ReadPreExeReq(desc)
 		if err != nil {
-			return nil, nil, fmt.Errorf("Get auth require error: %s", err.Error())
+			return nil, fmt.Errorf("Get pb.InvokeRPCRequest error:%s", err)
+		}
+		if invokeReq != nil {
+			req.Invokes = append(req.Invokes, invokeReq)
 		}
 	}
-	preExeRPCRes, err := c.XchainClient.PreExec(ctx, preExeRPCReq)
-	if err != nil {
-		return nil, nil, fmt.Errorf("PreExe contract response : %v, logid:%s", err, preExeRPCReq.Header.Logid)
-	}
-	for _, res := range preExeRPCRes.Response.Responses {
-		if res.Status >= contract.StatusErrorThreshold {
-			return nil, nil, fmt.Errorf("contract error status:%d message:%s", res.Status, res.Message)
+
+	// 走multisig gen流程时从文件读取需要签名的地址
+	if c.IsQuick {
+		req.AuthRequire, err = c.GenAuthRequire(c.MultiAddrs)
+		if err != nil {
+			return nil, fmt.Errorf("Get auth require error: %s", err.Error())
 		}
-		fmt.Printf("contract response: %s\n", string(res.Body))
 	}
-	return preExeRPCRes, preExeRPCRes.Response.Requests, nil
+	return req, nil
 }
 
-// GetInvokeRequestFromDesc get invokerequest from desc file
-func (c *CommTrans) GetInvokeRequestFromDesc() (*pb.InvokeRequest, error) {
-	desc, err := c.GetDesc()
-	if err != nil {
-		return nil, fmt.Errorf("get desc error:%s", err)
+func (c *CommTrans) printPreExeRes(preExeRes *pb.InvokeResponse) {
+	for _, res := range preExeRes.GetResponses() {
+		fmt.Printf("contract response: %s\n", string(res.Body))
 	}
-
-	var preExeReq *pb.InvokeRequest
-	preExeReq, err = c.ReadPreExeReq(desc)
-	if err != nil {
-		return nil, err
+	fmt.Printf("The gas you cousume is: %v\n", preExeRes.GetGasUsed())
+	if c.Fee != "" && c.Fee != "0" {
+		fmt.Printf("The fee you pay is: %v\n", c.Fee)
 	}
-
-	return preExeReq, nil
 }
 
 // GetDesc 解析desc字段，主要是针对合约
@@ -192,365 +172,47 @@ func (c *CommTrans) ReadPreExeReq(buf []byte) (*pb.InvokeRequest, error) {
 	return &params.InvokeRequest, nil
 }
 
-// GenRawTx 生成一个完整raw的交易
-func (c *CommTrans) GenRawTx(ctx context.Context, desc []byte, preExeRes *pb.InvokeResponse,
-	preExeReqs []*pb.InvokeRequest) (*pb.Transaction, error) {
-	tx := &pb.Transaction{
-		Desc:      desc,
-		Coinbase:  false,
-		Nonce:     utils.GenNonce(),
-		Timestamp: time.Now().UnixNano(),
-		Version:   utxo.TxVersion,
-	}
-
-	var gasUsed int64
-	if preExeRes != nil {
-		gasUsed = preExeRes.GasUsed
-		fmt.Printf("The gas you cousume is: %v\n", gasUsed)
-	}
-
-	if preExeRes.GetUtxoInputs() != nil {
-		tx.TxInputs = append(tx.TxInputs, preExeRes.GetUtxoInputs()...)
-	}
-	if preExeRes.GetUtxoOutputs() != nil {
-		tx.TxOutputs = append(tx.TxOutputs, preExeRes.GetUtxoOutputs()...)
-	}
-
-	txOutputs, totalNeed, err := c.GenTxOutputs(gasUsed)
-	if err != nil {
-		return nil, err
-	}
-	tx.TxOutputs = append(tx.TxOutputs, txOutputs...)
-
-	txInputs, deltaTxOutput, err := c.GenTxInputs(ctx, totalNeed)
-	if err != nil {
-		return nil, err
-	}
-
-	tx.TxInputs = append(tx.TxInputs, txInputs...)
-	if deltaTxOutput != nil {
-		tx.TxOutputs = append(tx.TxOutputs, deltaTxOutput)
-	}
-
-	// 填充contract预执行结果
-	if preExeRes != nil {
-		tx.TxInputsExt = preExeRes.GetInputs()
-		tx.TxOutputsExt = preExeRes.GetOutputs()
-		tx.ContractRequests = preExeReqs
-	}
-
-	// 填充交易发起者的addr
-	fromAddr, err := c.genInitiator()
-	if err != nil {
-		return nil, err
-	}
-	tx.Initiator = fromAddr
-
-	return tx, nil
-}
-
-// genInitiator generate initiator of transaction
-func (c *CommTrans) genInitiator() (string, error) {
-	if c.From != "" {
-		return c.From, nil
-	}
-	fromAddr, err := readAddress(c.Keys)
-	if err != nil {
-		return "", err
-	}
-	return fromAddr, nil
-}
-
-// GenTxOutputs 填充得到transaction的repeated TxOutput tx_outputs
-func (c *CommTrans) GenTxOutputs(gasUsed int64) ([]*pb.TxOutput, *big.Int, error) {
-	// 组装转账的账户信息
-	account := &pb.TxDataAccount{
-		Address:      c.To,
-		Amount:       c.Amount,
-		FrozenHeight: c.FrozenHeight,
-	}
-
-	accounts := []*pb.TxDataAccount{}
-	if c.To != "" {
-		accounts = append(accounts, account)
-	}
-
-	// 如果有小费,增加转个小费地址的账户
-	// 如果有合约，需要支付gas
-	if gasUsed > 0 {
-		if c.Fee != "" && c.Fee != "0" {
-			fee, _ := strconv.ParseInt(c.Fee, 10, 64)
-			if fee < gasUsed {
-				return nil, nil, errors.New("Fee not enough")
-			}
-		} else {
-			return nil, nil, errors.New("You need add fee")
-		}
-		fmt.Printf("The fee you pay is: %v\n", c.Fee)
-		accounts = append(accounts, newFeeAccount(c.Fee))
-	} else if c.Fee != "" && c.Fee != "0" && gasUsed <= 0 {
-		fmt.Printf("The fee you pay is: %v\n", c.Fee)
-		accounts = append(accounts, newFeeAccount(c.Fee))
-	}
-
-	// 组装txOutputs
-	bigZero := big.NewInt(0)
-	totalNeed := big.NewInt(0)
-	txOutputs := []*pb.TxOutput{}
-	for _, acc := range accounts {
-		amount, ok := big.NewInt(0).SetString(acc.Amount, 10)
-		if !ok {
-			return nil, nil, ErrInvalidAmount
-		}
-		cmpRes := amount.Cmp(bigZero)
-		if cmpRes < 0 {
-			return nil, nil, ErrNegativeAmount
-		} else if cmpRes == 0 {
-			// trim 0 output
-			continue
-		}
-		// 得到总的转账金额
-		totalNeed.Add(totalNeed, amount)
-
-		txOutput := &pb.TxOutput{}
-		txOutput.Amount = amount.Bytes()
-		txOutput.ToAddr = []byte(acc.Address)
-		txOutput.FrozenHeight = acc.FrozenHeight
-		txOutputs = append(txOutputs, txOutput)
-	}
-
-	return txOutputs, totalNeed, nil
-}
-
-// GenTxInputs 填充得到transaction的repeated TxInput tx_inputs,
-// 如果输入大于输出，增加一个转给自己(data/keys/)的输入-输出的交易
-func (c *CommTrans) GenTxInputs(ctx context.Context, totalNeed *big.Int) (
-	[]*pb.TxInput, *pb.TxOutput, error) {
-	var fromAddr string
-	var err error
-	if c.From != "" {
-		fromAddr = c.From
-	} else {
-		fromAddr, err = readAddress(c.Keys)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
-	utxoInput := &pb.UtxoInput{
-		Bcname:    c.ChainName,
-		Address:   fromAddr,
-		TotalNeed: totalNeed.String(),
-		NeedLock:  false,
-	}
-
-	utxoOutputs, err := c.XchainClient.SelectUTXO(ctx, utxoInput)
-	if err != nil {
-		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, err)
-	}
-	if utxoOutputs.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, utxoOutputs.Header.Error)
-	}
-
-	// 组装txInputs
-	var txInputs []*pb.TxInput
-	var txOutput *pb.TxOutput
-	for _, utxo := range utxoOutputs.UtxoList {
-		txInput := &pb.TxInput{}
-		txInput.RefTxid = utxo.RefTxid
-		txInput.RefOffset = utxo.RefOffset
-		txInput.FromAddr = utxo.ToAddr
-		txInput.Amount = utxo.Amount
-		txInputs = append(txInputs, txInput)
-	}
-
-	utxoTotal, ok := big.NewInt(0).SetString(utxoOutputs.TotalSelected, 10)
-	if !ok {
-		return nil, nil, ErrSelectUtxo
-	}
-
-	// 通过selectUTXO选出的作为交易的输入大于输出,
-	// 则多出来再生成一笔交易转给自己
-	if utxoTotal.Cmp(totalNeed) > 0 {
-		delta := utxoTotal.Sub(utxoTotal, totalNeed)
-		txOutput = &pb.TxOutput{
-			ToAddr: []byte(fromAddr),
-			Amount: delta.Bytes(),
-		}
-	}
-
-	return txInputs, txOutput, nil
-}
-
 // Transfer quick access to transfer
 func (c *CommTrans) Transfer(ctx context.Context) error {
-	var err error
-	tx := &pb.Transaction{}
-	if c.CliConf.ComplianceCheck.IsNeedComplianceCheck == true {
-		preSelectUTXORes, err := c.GenPreExeWithSelectUtxoRes(ctx)
+	if c.DebugTx {
+		tx, err := c.GenerateTx(ctx)
 		if err != nil {
 			return err
 		}
-		return c.GenCompleteTxAndPost(ctx, preSelectUTXORes)
-	} else {
-		tx, err = c.GenerateTx(ctx)
-		if err != nil {
-			return err
-		}
-
-		if c.DebugTx {
-			ttx := FromPBTx(tx)
-			out, _ := json.MarshalIndent(ttx, "", "  ")
-			fmt.Println(string(out))
-			return nil
-		}
-
-		return c.SendTx(ctx, tx)
+		return printTx(tx)
 	}
-}
 
-// SendTx post tx
-func (c *CommTrans) SendTx(ctx context.Context, tx *pb.Transaction) error {
-	fromAddr, err := readAddress(c.Keys)
+	req, err := c.request()
 	if err != nil {
 		return err
 	}
-
-	var authRequire string
-	if c.From != "" {
-		authRequire = c.From + "/" + fromAddr
-	} else {
-		authRequire = fromAddr
-	}
-	tx.AuthRequire = append(tx.AuthRequire, authRequire)
-
-	signInfos, err := c.genInitSign(tx)
-	if err != nil {
-		return err
-	}
-	tx.InitiatorSigns = signInfos
-	tx.AuthRequireSigns = signInfos
-
-	tx.Txid, err = common.MakeTxId(tx)
-	if err != nil {
-		return errors.New("MakeTxDigesthash txid error")
-	}
-
-	txid, err := c.postTx(ctx, tx)
+	// 配置了合规检查时由sdk先请求背书服务签名
+	txid, preExeRes, err := c.Client.Submit(ctx, req)
 	if err != nil {
 		return err
 	}
+	c.printPreExeRes(preExeRes)
 	fmt.Printf("Tx id: %s\n", txid)
-
 	return nil
 }
 
-func (c *CommTrans) genInitSign(tx *pb.Transaction) ([]*pb.SignatureInfo, error) {
-	fromPubkey, err := readPublicKey(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
-	if err != nil {
-		return nil, errors.New("Create crypto client error")
-	}
-	fromScrkey, err := readPrivateKey(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
-	if err != nil {
-		return nil, err
-	}
-
-	signInfo := &pb.SignatureInfo{
-		PublicKey: fromPubkey,
-		Sign:      signTx,
-	}
-
-	signInfos := []*pb.SignatureInfo{}
-	signInfos = append(signInfos, signInfo)
-
-	return signInfos, nil
-}
-
-func (c *CommTrans) genAuthRequireSignsFromPath(tx *pb.Transaction, path string) ([]*pb.SignatureInfo, error) {
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
+// signAndPost 发起者签名后提交，accountPath不为空时由其中的地址做auth require签名
+func (c *CommTrans) signAndPost(ctx context.Context, tx *pb.Transaction, accountPath string) (string, error) {
+	initiator, err := sdk.LoadAccount(c.Keys)
 	if err != nil {
-		return nil, errors.New("Create crypto client error")
+		return "", err
 	}
-
-	authRequireSigns := []*pb.SignatureInfo{}
-	if path == "" {
-		initPubkey, err := readPublicKey(c.Keys)
-		if err != nil {
-			return nil, err
-		}
-
-		initScrkey, err := readPrivateKey(c.Keys)
+	var signers []*sdk.Account
+	if accountPath != "" {
+		signers, err = sdk.DirKeystore(accountPath).Accounts()
 		if err != nil {
-			return nil, err
+			return "", err
 		}
-		signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(initScrkey))
-		if err != nil {
-			return nil, err
-		}
-		signInfo := &pb.SignatureInfo{
-			PublicKey: initPubkey,
-			Sign:      signTx,
-		}
-		authRequireSigns = append(authRequireSigns, signInfo)
-		return authRequireSigns, nil
-	}
-	dir, err := ioutil.ReadDir(path)
-	if err != nil {
-		return nil, err
 	}
-	for _, fi := range dir {
-		if fi.IsDir() {
-			sk, err := readPrivateKey(path + "/" + fi.Name())
-			if err != nil {
-				return nil, err
-			}
-			pk, err := readPublicKey(path + "/" + fi.Name())
-			if err != nil {
-				return nil, err
-			}
-			signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(sk))
-			if err != nil {
-				return nil, err
-			}
-			signInfo := &pb.SignatureInfo{
-				PublicKey: pk,
-				Sign:      signTx,
-			}
-			authRequireSigns = append(authRequireSigns, signInfo)
-		}
-	}
-	return authRequireSigns, nil
-}
-
-func (c *CommTrans) postTx(ctx context.Context, tx *pb.Transaction) (string, error) {
-	txStatus := &pb.TxStatus{
-		Bcname: c.ChainName,
-		Status: pb.TransactionStatus_UNCONFIRM,
-		Tx:     tx,
-		Header: &pb.Header{
-			Logid: utils.GenLogId(),
-		},
-		Txid: tx.Txid,
-	}
-
-	reply, err := c.XchainClient.PostTx(ctx, txStatus)
-	if err != nil {
+	if err := c.Client.SignTx(tx, initiator, signers...); err != nil {
 		return "", err
 	}
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return "", fmt.Errorf("Failed to post tx:%s, logid:%s", reply.Header.Error.String(), reply.Header.Logid)
-	}
-
-	return hex.EncodeToString(txStatus.Txid), nil
+	return c.Client.PostTx(ctx, tx)
 }
 
 // GenerateMultisigGenRawTx for mulitisig gen cmd
@@ -570,21 +232,6 @@ func (c *CommTrans) GenerateMultisigGenRawTx(ctx context.Context) error {
 	return c.GenTxFile(tx)
 }
 
-func (c *CommTrans) genAuthRequireQuick() ([]string, error) {
-
-	fromAddr, err := readAddress(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-	authRequires := []string{}
-	if c.From != "" {
-		authRequires = append(authRequires, c.From+"/"+fromAddr)
-	} else {
-		authRequires = append(authRequires, fromAddr)
-	}
-	return authRequires, nil
-}
-
 // GenAuthRequire get auth require aks from file
 func (c *CommTrans) GenAuthRequire(filename string) ([]string, error) {
 	var addrs []string
@@ -641,555 +288,11 @@ func printTx(tx *pb.Transaction) error {
 	return nil
 }
 
-// GenTxInputsWithMergeUTXO generate tx with merge utxo
-func (c *CommTrans) GenTxInputsWithMergeUTXO(ctx context.Context) ([]*pb.TxInput, *pb.TxOutput, error) {
-	var fromAddr string
-	var err error
-	if c.From != "" {
-		fromAddr = c.From
-	} else {
-		fromAddr, err = readAddress(c.Keys)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
-	utxoInput := &pb.UtxoInput{
-		Bcname:   c.ChainName,
-		Address:  fromAddr,
-		NeedLock: true,
-	}
-
-	utxoOutputs, err := c.XchainClient.SelectUTXOBySize(ctx, utxoInput)
-	if err != nil {
-		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, err)
-	}
-	if utxoOutputs.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, utxoOutputs.Header.Error)
-	}
-
-	// 组装txInputs
-	var txInputs []*pb.TxInput
-	var txOutput *pb.TxOutput
-	for _, utxo := range utxoOutputs.UtxoList {
-		txInput := &pb.TxInput{
-			RefTxid:   utxo.RefTxid,
-			RefOffset: utxo.RefOffset,
-			FromAddr:  utxo.ToAddr,
-			Amount:    utxo.Amount,
-		}
-		txInputs = append(txInputs, txInput)
-	}
-
-	utxoTotal, ok := big.NewInt(0).SetString(utxoOutputs.TotalSelected, 10)
-	if !ok {
-		return nil, nil, ErrSelectUtxo
-	}
-	txOutput = &pb.TxOutput{
-		ToAddr: []byte(fromAddr),
-		Amount: utxoTotal.Bytes(),
-	}
-
-	return txInputs, txOutput, nil
-}
-
-func (c *CommTrans) GenPreExeWithSelectUtxoRes(ctx context.Context) (
-	*pb.PreExecWithSelectUTXOResponse, error) {
-	preExeReqs := []*pb.InvokeRequest{}
-	if c.ModuleName != "" {
-		if c.ModuleName == "xkernel" {
-			preExeReqs = append(preExeReqs, &pb.InvokeRequest{
-				ModuleName:   c.ModuleName,
-				ContractName: c.ContractName,
-				MethodName:   c.MethodName,
-				Args:         c.Args,
-			})
-		} else {
-			invokeReq := &pb.InvokeRequest{
-				ModuleName:   c.ModuleName,
-				ContractName: c.ContractName,
-				MethodName:   c.MethodName,
-				Args:         c.Args,
-			}
-			// transfer to contract
-			if c.To == c.ContractName {
-				invokeReq.Amount = c.Amount
-			}
-			preExeReqs = append(preExeReqs, invokeReq)
-		}
-	} else {
-		tmpReq, err := c.GetInvokeRequestFromDesc()
-		if err != nil {
-			return nil, fmt.Errorf("Get pb.InvokeRPCRequest error:%s", err)
-		}
-		if tmpReq != nil {
-			preExeReqs = append(preExeReqs, tmpReq)
-		}
-	}
-
-	preExeRPCReq := &pb.InvokeRPCRequest{
-		Bcname: c.ChainName,
-		Header: &pb.Header{
-			Logid: utils.GenLogId(),
-		},
-		Requests: preExeReqs,
-	}
-
-	initiator, err := c.genInitiator()
-	if err != nil {
-		return nil, fmt.Errorf("Get initiator error: %s", err.Error())
-	}
-
-	preExeRPCReq.Initiator = initiator
-	if !c.IsQuick {
-		preExeRPCReq.AuthRequire, err = c.genAuthRequireQuick()
-		if err != nil {
-			return nil, fmt.Errorf("Get auth require quick error: %s", err.Error())
-		}
-	} else {
-		preExeRPCReq.AuthRequire, err = c.GenAuthRequire(c.MultiAddrs)
-		if err != nil {
-			return nil, fmt.Errorf("Get auth require error: %s", err.Error())
-		}
-	}
-	extraAmount := int64(c.CliConf.ComplianceCheck.ComplianceCheckEndorseServiceFee)
-	if c.Fee != "" && c.Fee != "0" {
-		fee, err := strconv.ParseInt(c.Fee, 10, 64)
-		if err != nil {
-			return nil, fmt.Errorf("Invalid Fee: %s", c.Fee)
-		}
-		extraAmount += fee
-	}
-	preExeRPCReq.AuthRequire = append(preExeRPCReq.AuthRequire, c.CliConf.ComplianceCheck.ComplianceCheckEndorseServiceAddr)
-	preSelUTXOReq := &pb.PreExecWithSelectUTXORequest{
-		Bcname:      c.ChainName,
-		Address:     initiator,
-		TotalAmount: extraAmount,
-		Request:     preExeRPCReq,
-	}
-
-	// preExe
-	preExecWithSelectUTXOResponse, err := c.XchainClient.PreExecWithSelectUTXO(ctx, preSelUTXOReq)
-	if err != nil {
-		return nil, err
-	}
-
-	gasUsed := preExecWithSelectUTXOResponse.GetResponse().GetGasUsed()
-	fmt.Printf("The gas you cousume is: %v\n", gasUsed)
-	if gasUsed > 0 {
-		if c.Fee != "" && c.Fee != "0" {
-			fee, _ := strconv.ParseInt(c.Fee, 10, 64)
-			if fee < gasUsed {
-				return nil, errors.New("Fee not enough")
-			}
-		} else {
-			return nil, errors.New("You need add fee")
-		}
-		fmt.Printf("The fee you pay is: %v\n", c.Fee)
-	} else if c.Fee != "" && c.Fee != "0" && gasUsed <= 0 {
-		fmt.Printf("The fee you pay is: %v\n", c.Fee)
-	}
-
-	return preExecWithSelectUTXOResponse, nil
-}
-
-func (c *CommTrans) GenCompleteTxAndPost(ctx context.Context, preExeResp *pb.PreExecWithSelectUTXOResponse) error {
-	complianceCheckTx, err := c.GenComplianceCheckTx(preExeResp.GetUtxoOutput())
-	if err != nil {
-		fmt.Printf("GenCompleteTxAndPost GenComplianceCheckTx failed, err: %v", err)
-		return err
-	}
-	fmt.Printf("ComplianceCheck txid: %v\n", hex.EncodeToString(complianceCheckTx.Txid))
-
-	tx, err := c.GenRealTx(preExeResp, complianceCheckTx)
-	if err != nil {
-		fmt.Printf("GenRealTx failed, err: %v", err)
-		return err
-	}
-	endorserSign, err := c.ComplianceCheck(tx, complianceCheckTx)
-	if err != nil {
-		return err
-	}
-	tx.AuthRequireSigns = append(tx.AuthRequireSigns, endorserSign)
-	tx.Txid, _ = common.MakeTxId(tx)
-
-	txid, err := c.postTx(ctx, tx)
-	if err != nil {
-		return err
-	}
-	fmt.Printf("Tx id: %s\n", txid)
-
-	return nil
-}
-
-func (c *CommTrans) GenRealTx(response *pb.PreExecWithSelectUTXOResponse,
-	complianceCheckTx *pb.Transaction) (*pb.Transaction, error) {
-	utxolist := []*pb.Utxo{}
-	totalSelected := big.NewInt(0)
-	initiator, err := c.genInitiator()
-	if err != nil {
-		return nil, err
-	}
-	for index, txOutput := range complianceCheckTx.TxOutputs {
-		if string(txOutput.ToAddr) == initiator {
-			utxo := &pb.Utxo{
-				Amount:    txOutput.Amount,
-				ToAddr:    txOutput.ToAddr,
-				RefTxid:   complianceCheckTx.Txid,
-				RefOffset: int32(index),
-			}
-			utxolist = append(utxolist, utxo)
-			utxoAmount := big.NewInt(0).SetBytes(utxo.Amount)
-			totalSelected.Add(totalSelected, utxoAmount)
-		}
-	}
-	utxoOutput := &pb.UtxoOutput{
-		UtxoList:      utxolist,
-		TotalSelected: totalSelected.String(),
-	}
-
-	totalNeed := big.NewInt(0)
-
-	// no need to double check
-	amount, ok := big.NewInt(0).SetString("0", 10)
-	if !ok {
-		return nil, ErrInvalidAmount
-	}
-	fee, ok := big.NewInt(0).SetString(c.Fee, 10)
-	if !ok {
-		return nil, ErrInvalidAmount
-	}
-	amount.Add(amount, fee)
-	totalNeed.Add(totalNeed, amount)
-
-	selfAmount := totalSelected.Sub(totalSelected, totalNeed)
-	txOutputs, err := c.GenerateMultiTxOutputs(selfAmount.String(), c.Fee)
-	if err != nil {
-		fmt.Printf("GenRealTx GenerateTxOutput failed.")
-		return nil, fmt.Errorf("GenRealTx GenerateTxOutput err: %v", err)
-	}
-
-	txInputs, err := c.GeneratePureTxInputs(utxoOutput)
-	if err != nil {
-		fmt.Printf("GenRealTx GenerateTxInput failed.")
-		return nil, fmt.Errorf("GenRealTx GenerateTxInput err: %v", err)
-	}
-
-	tx := &pb.Transaction{
-		Version:   utxo.TxVersion,
-		Coinbase:  false,
-		Timestamp: time.Now().UnixNano(),
-		TxInputs:  txInputs,
-		TxOutputs: txOutputs,
-		Initiator: initiator,
-		Nonce:     utils.GenNonce(),
-	}
-
-	if response.Response.GetUtxoInputs() != nil {
-		tx.TxInputs = append(tx.TxInputs, response.GetResponse().GetUtxoInputs()...)
-	}
-	if response.Response.GetUtxoOutputs() != nil {
-		tx.TxOutputs = append(tx.TxOutputs, response.GetResponse().GetUtxoOutputs()...)
-	}
-
-	desc, _ := c.GetDesc()
-	tx.Desc = desc
-	tx.TxInputsExt = response.GetResponse().GetInputs()
-	tx.TxOutputsExt = response.GetResponse().GetOutputs()
-	tx.ContractRequests = response.GetResponse().GetRequests()
-
-	fromAddr, err := readAddress(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-	var authRequire string
-	if c.From != "" {
-		authRequire = c.From + "/" + fromAddr
-	} else {
-		authRequire = fromAddr
-	}
-	tx.AuthRequire = append(tx.AuthRequire, authRequire)
-	tx.AuthRequire = append(tx.AuthRequire, c.CliConf.ComplianceCheck.ComplianceCheckEndorseServiceAddr)
-
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
-	if err != nil {
-		return nil, errors.New("Create crypto client error")
-	}
-	fromPubkey, err := readPublicKey(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-	fromScrkey, err := readPrivateKey(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
-	if err != nil {
-		return nil, err
-	}
-
-	signatureInfo := &pb.SignatureInfo{
-		PublicKey: fromPubkey,
-		Sign:      signTx,
-	}
-
-	var signatureInfos []*pb.SignatureInfo
-	signatureInfos = append(signatureInfos, signatureInfo)
-
-	tx.InitiatorSigns = signatureInfos
-	tx.AuthRequireSigns = signatureInfos
-
-	// make txid
-	tx.Txid, _ = common.MakeTxId(tx)
-	return tx, nil
-}
-
-func (c *CommTrans) GenerateMultiTxOutputs(selfAmount string, gasUsed string) ([]*pb.TxOutput, error) {
-	selfAddr, err := c.genInitiator()
-	if err != nil {
-		return nil, err
-	}
-	feeAmount := gasUsed
-
-	var txOutputs []*pb.TxOutput
-	txOutputSelf := new(pb.TxOutput)
-	txOutputSelf.ToAddr = []byte(selfAddr)
-	realSelfAmount, isSuccess := new(big.Int).SetString(selfAmount, 10)
-	if isSuccess != true {
-		fmt.Printf("selfAmount convert to bigint failed")
-		return nil, ErrInvalidAmount
-	}
-	txOutputSelf.Amount = realSelfAmount.Bytes()
-	txOutputs = append(txOutputs, txOutputSelf)
-	if feeAmount != "" && feeAmount != "0" {
-		realFeeAmount, isSuccess := new(big.Int).SetString(feeAmount, 10)
-		if isSuccess != true {
-			fmt.Printf("feeAmount convert to bigint failed")
-			return nil, ErrInvalidAmount
-		}
-		if realFeeAmount.Cmp(big.NewInt(0)) < 0 {
-			return nil, ErrInvalidAmount
-		}
-		txOutputFee := new(pb.TxOutput)
-		txOutputFee.ToAddr = []byte("$")
-		txOutputFee.Amount = realFeeAmount.Bytes()
-		txOutputs = append(txOutputs, txOutputFee)
-	}
-
-	return txOutputs, nil
-}
-
-func (c *CommTrans) GeneratePureTxInputs(utxoOutputs *pb.UtxoOutput) (
-	[]*pb.TxInput, error) {
-	// gen txInputs
-	var txInputs []*pb.TxInput
-	for _, utxo := range utxoOutputs.UtxoList {
-		txInput := &pb.TxInput{}
-		txInput.RefTxid = utxo.RefTxid
-		txInput.RefOffset = utxo.RefOffset
-		txInput.FromAddr = utxo.ToAddr
-		txInput.Amount = utxo.Amount
-		txInputs = append(txInputs, txInput)
-	}
-
-	return txInputs, nil
-}
-
-func (c *CommTrans) ComplianceCheck(tx *pb.Transaction, fee *pb.Transaction) (
-	*pb.SignatureInfo, error) {
-	txStatus := &pb.TxStatus{
-		Bcname: c.ChainName,
-		Tx:     tx,
-	}
-
-	requestData, err := json.Marshal(txStatus)
-	if err != nil {
-		fmt.Printf("json encode txStatus failed: %v", err)
-		return nil, err
-	}
-
-	endorserRequest := &pb.EndorserRequest{
-		RequestName: "ComplianceCheck",
-		BcName:      c.ChainName,
-		Fee:         fee,
-		RequestData: requestData,
-	}
-
-	conn, err := grpc.Dial(c.CliConf.EndorseServiceHost, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
-	if err != nil {
-		fmt.Printf("ComplianceCheck connect EndorseServiceHost err: %v", err)
-		return nil, err
-	}
-	defer conn.Close()
-	ctx, cancel := context.WithTimeout(context.Background(), 15000*time.Millisecond)
-	defer cancel()
-
-	client := pb.NewXendorserClient(conn)
-	endorserResponse, err := client.EndorserCall(ctx, endorserRequest)
-	if err != nil {
-		fmt.Printf("EndorserCall failed and err is: %v", err)
-		return nil, fmt.Errorf("EndorserCall error! Response is: %v", err)
-	}
-
-	return endorserResponse.GetEndorserSign(), nil
-}
-
+// GenComplianceCheckTx 生成支付合规检查费用的交易
 func (c *CommTrans) GenComplianceCheckTx(utxoOutput *pb.UtxoOutput) (*pb.Transaction, error) {
-	totalNeed := new(big.Int).SetInt64(int64(c.CliConf.ComplianceCheck.ComplianceCheckEndorseServiceFee))
-	txInputs, deltaTxOutput, err := c.GenerateTxInput(utxoOutput, totalNeed)
-	if err != nil {
-		fmt.Printf("GenerateComplianceTx GenerateTxInput failed.")
-		return nil, fmt.Errorf("GenerateComplianceTx GenerateTxInput err: %v", err)
-	}
-
-	checkAmount := strconv.Itoa(c.CliConf.ComplianceCheck.ComplianceCheckEndorseServiceFee)
-	txOutputs, err := c.GenerateTxOutput(c.CliConf.ComplianceCheck.ComplianceCheckEndorseFeeAddr, checkAmount, "0")
-	if err != nil {
-		fmt.Printf("GenerateComplianceTx GenerateTxOutput failed.")
-		return nil, fmt.Errorf("GenerateComplianceTx GenerateTxOutput err: %v", err)
-	}
-	if deltaTxOutput != nil {
-		txOutputs = append(txOutputs, deltaTxOutput)
-	}
-	// populates fields
-	tx := &pb.Transaction{
-		Desc:      []byte(""),
-		Version:   utxo.TxVersion,
-		Coinbase:  false,
-		Timestamp: time.Now().UnixNano(),
-		TxInputs:  txInputs,
-		TxOutputs: txOutputs,
-		Nonce:     utils.GenNonce(),
-	}
-	initiator, err := c.genInitiator()
+	req, err := c.request()
 	if err != nil {
 		return nil, err
 	}
-	tx.Initiator = initiator
-
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.CryptoType)
-	if err != nil {
-		return nil, errors.New("Create crypto client error")
-	}
-	fromPubkey, err := readPublicKey(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-	fromScrkey, err := readPrivateKey(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-	fromAddr, err := readAddress(c.Keys)
-	if err != nil {
-		return nil, err
-	}
-
-	var authRequire string
-	if c.From != "" {
-		authRequire = c.From + "/" + fromAddr
-	} else {
-		authRequire = fromAddr
-	}
-	tx.AuthRequire = append(tx.AuthRequire, authRequire)
-
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
-	if err != nil {
-		return nil, err
-	}
-
-	signatureInfo := &pb.SignatureInfo{
-		PublicKey: fromPubkey,
-		Sign:      signTx,
-	}
-
-	var signatureInfos []*pb.SignatureInfo
-	signatureInfos = append(signatureInfos, signatureInfo)
-
-	tx.InitiatorSigns = signatureInfos
-	tx.AuthRequireSigns = signatureInfos
-
-	// make txid
-	tx.Txid, _ = common.MakeTxId(tx)
-	return tx, nil
-}
-
-func (c *CommTrans) GenerateTxInput(utxoOutputs *pb.UtxoOutput, totalNeed *big.Int) (
-	[]*pb.TxInput, *pb.TxOutput, error) {
-	var txInputs []*pb.TxInput
-	var txOutput *pb.TxOutput
-	for _, utxo := range utxoOutputs.UtxoList {
-		txInput := &pb.TxInput{}
-		txInput.RefTxid = utxo.RefTxid
-		txInput.RefOffset = utxo.RefOffset
-		txInput.FromAddr = utxo.ToAddr
-		txInput.Amount = utxo.Amount
-		txInputs = append(txInputs, txInput)
-	}
-
-	utxoTotal, ok := big.NewInt(0).SetString(utxoOutputs.TotalSelected, 10)
-	if !ok {
-		return nil, nil, fmt.Errorf("GenerateTxInput totalSelected err: %v", ok)
-	}
-
-	var fromAddr string
-	var err error
-	if c.From != "" {
-		fromAddr = c.From
-	} else {
-		fromAddr, err = readAddress(c.Keys)
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-	// input > output, generate output-input to me
-	if utxoTotal.Cmp(totalNeed) > 0 {
-		delta := utxoTotal.Sub(utxoTotal, totalNeed)
-		txOutput = &pb.TxOutput{
-			ToAddr: []byte(fromAddr),
-			Amount: delta.Bytes(),
-		}
-	}
-
-	return txInputs, txOutput, nil
-}
-
-func (xc *CommTrans) GenerateTxOutput(to, amount, fee string) ([]*pb.TxOutput, error) {
-	accounts := []*pb.TxDataAccount{}
-	if to != "" {
-		account := &pb.TxDataAccount{
-			Address:      to,
-			Amount:       amount,
-			FrozenHeight: 0,
-		}
-		accounts = append(accounts, account)
-	}
-	if fee != "0" {
-		feeAccount := &pb.TxDataAccount{
-			Address: "$",
-			Amount:  fee,
-		}
-		accounts = append(accounts, feeAccount)
-	}
-
-	bigZero := big.NewInt(0)
-	txOutputs := []*pb.TxOutput{}
-	for _, acc := range accounts {
-		amount, ok := big.NewInt(0).SetString(acc.Amount, 10)
-		if !ok {
-			return nil, ErrInvalidAmount
-		}
-		cmpRes := amount.Cmp(bigZero)
-		if cmpRes < 0 {
-			return nil, errors.New("Invalid negative number")
-		} else if cmpRes == 0 {
-			continue
-		}
-		txOutput := &pb.TxOutput{}
-		txOutput.Amount = amount.Bytes()
-		txOutput.ToAddr = []byte(acc.Address)
-		txOutput.FrozenHeight = acc.FrozenHeight
-		txOutputs = append(txOutputs, txOutput)
-	}
-
-	return txOutputs, nil
+	return c.Client.ComplianceFeeTx(req, c.CliConf.SDKComplianceCheck(), utxoOutput)
 }
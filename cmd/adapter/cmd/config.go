@@ -6,6 +6,8 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/viper"
+
+	"github.com/xuperchain/xuperos/sdk"
 )
 
 type CliConfig struct {
@@ -15,15 +17,40 @@ type CliConfig struct {
 	Crypto             string                `yaml:"crypto,omitempty"`
 	TLS                TLSOptions            `yaml:"tls,omitempty"`
 	EndorseServiceHost string                `yaml:"endorseServiceHost,omitempty"`
+	EndorseServiceTLS  TLSOptions            `yaml:"endorseServiceTLS,omitempty"`
 	ComplianceCheck    ComplianceCheckConfig `yaml:"complianceCheck,omitempty"`
 	MinNewChainAmount  string                `yaml:"minNewChainAmount,omitempty"`
+	AdminHost          string                `yaml:"adminHost,omitempty"`
+	AdminToken         string                `yaml:"adminToken,omitempty"`
+	// 请求压缩算法，为空时不压缩，节点需要支持该算法
+	Compress string `yaml:"compress,omitempty"`
+	// 接收消息的大小上限，单位：字节
+	MaxRecvMsgSize int `yaml:"maxRecvMsgSize,omitempty"`
 }
 
-// TLSOptions TLS part
+// TLSOptions TLS part，cert为证书目录，caFile、certFile、keyFile单独指定文件，优先于cert目录下的文件
+// caFile为空时使用系统根证书，certFile和keyFile为空时不提供客户端证书
 type TLSOptions struct {
-	Cert   string `yaml:"cert,omitempty"`
-	Server string `yaml:"server,omitempty"`
-	Enable bool   `yaml:"enable,omitempty"`
+	Cert     string `yaml:"cert,omitempty"`
+	CaFile   string `yaml:"caFile,omitempty"`
+	CertFile string `yaml:"certFile,omitempty"`
+	KeyFile  string `yaml:"keyFile,omitempty"`
+	Server   string `yaml:"server,omitempty"`
+	Enable   bool   `yaml:"enable,omitempty"`
+}
+
+// SDKConfig 转为sdk的TLS配置，未开启时返回nil
+func (o TLSOptions) SDKConfig() *sdk.TLSConfig {
+	if !o.Enable {
+		return nil
+	}
+	return &sdk.TLSConfig{
+		CAFile:     o.CaFile,
+		CertFile:   o.CertFile,
+		KeyFile:    o.KeyFile,
+		CertDir:    o.Cert,
+		ServerName: o.Server,
+	}
 }
 
 // ComplianceCheckConfig: config of xendorser service control
@@ -39,6 +66,17 @@ type ComplianceCheckConfig struct {
 	ComplianceCheckEndorseServiceAddr string `yaml:"complianceCheckEndorseServiceAddr,omitempty"`
 }
 
+// SDKComplianceCheck 转为sdk的合规检查配置
+func (nc *CliConfig) SDKComplianceCheck() *sdk.ComplianceCheckConfig {
+	return &sdk.ComplianceCheckConfig{
+		EndorserHost: nc.EndorseServiceHost,
+		Fee:          int64(nc.ComplianceCheck.ComplianceCheckEndorseServiceFee),
+		FeeAddr:      nc.ComplianceCheck.ComplianceCheckEndorseFeeAddr,
+		ServiceAddr:  nc.ComplianceCheck.ComplianceCheckEndorseServiceAddr,
+		TLS:          nc.EndorseServiceTLS.SDKConfig(),
+	}
+}
+
 // NewNodeConfig new a NodeConfig instance
 func NewCliConfig() *CliConfig {
 	xendorserConfig := &CliConfig{}
@@ -92,4 +130,8 @@ func (nc *CliConfig) setDefaultConf() {
 		ComplianceCheckEndorseServiceAddr: "jknGxa6eyum1JrATWvSJKW3thJ9GKHA9n",
 	}
 	nc.MinNewChainAmount = "100"
+	nc.AdminHost = "127.0.0.1:36401"
+	nc.AdminToken = ""
+	nc.Compress = ""
+	nc.MaxRecvMsgSize = 128 << 20
 }
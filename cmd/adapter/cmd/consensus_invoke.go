@@ -92,7 +92,7 @@ func (c *ConsensusInvokeCommand) invoke(ctx context.Context) error {
 		IsQuick:      c.isMulti,
 		ChainName:    c.cli.RootOptions.Name,
 		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
+		Client:       c.cli.Client(),
 		CryptoType:   c.cli.RootOptions.Crypto,
 		CliConf:      c.cli.CliConf,
 		Fee:          c.fee,
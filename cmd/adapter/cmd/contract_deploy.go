@@ -82,7 +82,7 @@ func (c *ContractDeployCommand) deploy(ctx context.Context, codepath string) err
 		IsQuick:      c.isMulti,
 		ChainName:    c.cli.RootOptions.Name,
 		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
+		Client:       c.cli.Client(),
 		CryptoType:   c.cli.RootOptions.Crypto,
 		CliConf:      c.cli.CliConf,
 	}
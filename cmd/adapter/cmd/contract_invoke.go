@@ -88,7 +88,7 @@ func (c *ContractInvokeCommand) invoke(ctx context.Context, codeName string) err
 		Output:       c.output,
 		ChainName:    c.cli.RootOptions.Name,
 		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
+		Client:       c.cli.Client(),
 		CryptoType:   c.cli.RootOptions.Crypto,
 		DebugTx:      c.debug,
 		CliConf:      c.cli.CliConf,
@@ -74,8 +74,8 @@ func (c *ContractQueryCommand) query(ctx context.Context, codeName string) error
 		Keys:         c.cli.RootOptions.Keys,
 		MultiAddrs:   c.multiAddrs,
 
-		ChainName:    c.cli.RootOptions.Name,
-		XchainClient: c.cli.XchainClient(),
+		ChainName: c.cli.RootOptions.Name,
+		Client:    c.cli.Client(),
 	}
 
 	// generate preExe params
@@ -68,7 +68,7 @@ func (c *ContractUpgradeCommand) upgrade(ctx context.Context, codepath string) e
 		IsQuick:      c.isMulti,
 		ChainName:    c.cli.RootOptions.Name,
 		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
+		Client:       c.cli.Client(),
 		CryptoType:   c.cli.RootOptions.Crypto,
 		CliConf:      c.cli.CliConf,
 	}
@@ -5,6 +5,7 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -13,6 +14,8 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
 
+	"github.com/xuperchain/xupercore/lib/utils"
+	sutils "github.com/xuperchain/xuperos/common/utils"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 )
 
@@ -21,8 +24,9 @@ type MultisigCheckCommand struct {
 	cli *Cli
 	cmd *cobra.Command
 
-	input  string
-	output string
+	input    string
+	output   string
+	validate bool
 }
 
 // NewMultisigCheckCommand multisig check init method
@@ -30,9 +34,18 @@ func NewMultisigCheckCommand(cli *Cli) *cobra.Command {
 	c := &MultisigCheckCommand{}
 	c.cli = cli
 	c.cmd = &cobra.Command{
-		Use:   "check",
+		Use:   "check [initSigns authSigns]",
 		Short: "Check the raw transaction generated by the command of multisig gen.",
+		Long: `./xchain-cli multisig check --input ./tx.out [--validate [arg1 arg2]]
+If validate is set, the transaction is verified by the node without being posted:
+	arg1: Initiator signature array, separated with commas;
+	arg2: AuthRequire signature array, separated with commas.
+If args are empty, the signatures already in the transaction are used.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.TODO()
+			if c.validate {
+				return c.validateTx(ctx, args)
+			}
 			return c.check()
 		},
 	}
@@ -43,6 +56,7 @@ func NewMultisigCheckCommand(cli *Cli) *cobra.Command {
 func (c *MultisigCheckCommand) addFlags() {
 	c.cmd.Flags().StringVarP(&c.input, "input", "i", "./tx.out", "Serialized transaction data file.")
 	c.cmd.Flags().StringVarP(&c.output, "output", "o", "./visualtx.out", "Readable transaction data file.")
+	c.cmd.Flags().BoolVar(&c.validate, "validate", false, "Verify the signed transaction by the node without posting it.")
 }
 
 // check 命令的主入口
@@ -71,3 +85,60 @@ func (c *MultisigCheckCommand) check() error {
 
 	return nil
 }
+
+// validateTx 组装签名后提交节点预校验，不会上链
+func (c *MultisigCheckCommand) validateTx(ctx context.Context, args []string) error {
+	data, err := ioutil.ReadFile(c.input)
+	if err != nil {
+		return err
+	}
+	tx := &pb.Transaction{}
+	err = proto.Unmarshal(data, tx)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 1 {
+		return fmt.Errorf("Args error, need both initiator and auth require signatures")
+	}
+	if len(args) >= 2 {
+		tx.InitiatorSigns, err = getSigns(args[0])
+		if err != nil {
+			return err
+		}
+		tx.AuthRequireSigns, err = getSigns(args[1])
+		if err != nil {
+			return err
+		}
+		tx.Txid, err = sutils.MakeTxId(tx)
+		if err != nil {
+			return errors.New("MakeTxDigesthash txid error")
+		}
+	}
+
+	txStatus := &pb.TxStatus{
+		Bcname: c.cli.RootOptions.Name,
+		Tx:     tx,
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+		Txid: tx.Txid,
+	}
+	reply, err := c.cli.XchainClient().ValidateTx(ctx, txStatus)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return fmt.Errorf("Failed to validate tx:%s, logid:%s", reply.Header.Error.String(), reply.Header.Logid)
+	}
+
+	output, err := json.MarshalIndent(FromPBValidateTxResponse(reply), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	if !reply.GetValid() {
+		return fmt.Errorf("tx validate failed, %d check(s) not passed", len(reply.GetFailures()))
+	}
+	return nil
+}
@@ -114,10 +114,10 @@ func (c *MultisigGenCommand) generateTx(ctx context.Context) error {
 		IsPrint:      true,
 		IsQuick:      true,
 
-		ChainName:    c.cli.RootOptions.Name,
-		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
-		CliConf:      c.cli.CliConf,
+		ChainName: c.cli.RootOptions.Name,
+		Keys:      c.cli.RootOptions.Keys,
+		Client:    c.cli.Client(),
+		CliConf:   c.cli.CliConf,
 	}
 
 	if c.args != "" {
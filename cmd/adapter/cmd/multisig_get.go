@@ -10,10 +10,8 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc"
 
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
-	"github.com/xuperchain/xupercore/lib/utils"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 )
 
@@ -44,11 +42,7 @@ func NewGetComplianceCheckSignCommand(cli *Cli) *cobra.Command {
 }
 
 func (c *GetComplianceCheckSignCommand) initXendorserClient() error {
-	conn, err := grpc.Dial(c.cli.RootOptions.Host, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
-	if err != nil {
-		return err
-	}
-	c.xendorserclient = pb.NewXendorserClient(conn)
+	c.xendorserclient = pb.NewXendorserClient(c.cli.Client().Conn())
 	return nil
 }
 
@@ -82,7 +76,7 @@ func (c *GetComplianceCheckSignCommand) get(ctx context.Context) error {
 		Output:       c.output,
 		ChainName:    c.cli.RootOptions.Name,
 		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
+		Client:       c.cli.Client(),
 		CryptoType:   c.cli.RootOptions.Crypto,
 		CliConf:      c.cli.CliConf,
 	}
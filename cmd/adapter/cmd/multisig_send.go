@@ -6,7 +6,6 @@ package cmd
 
 import (
 	"context"
-	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -17,9 +16,8 @@ import (
 	"github.com/spf13/cobra"
 
 	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
-	"github.com/xuperchain/xupercore/lib/utils"
+	sutils "github.com/xuperchain/xuperos/common/utils"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
-	"github.com/xuperchain/xuperos/service/adapter/common"
 )
 
 // MultisigSendCommand multisig send struct
@@ -80,19 +78,19 @@ func (c *MultisigSendCommand) send(ctx context.Context, initPath string, authPat
 		return errors.New("Fail to Unmarshal proto")
 	}
 
-	signs, err := c.getSigns(initPath)
+	signs, err := getSigns(initPath)
 	if err != nil {
 		return err
 	}
 	tx.InitiatorSigns = signs
 
-	signAuths, err := c.getSigns(authPath)
+	signAuths, err := getSigns(authPath)
 	if err != nil {
 		return err
 	}
 	tx.AuthRequireSigns = signAuths
 
-	tx.Txid, err = common.MakeTxId(tx)
+	tx.Txid, err = sutils.MakeTxId(tx)
 	if err != nil {
 		return errors.New("MakeTxDigesthash txid error")
 	}
@@ -166,7 +164,7 @@ func (c *MultisigSendCommand) sendXuper(ctx context.Context, signs string) error
 		Signature:  finalsign,
 	}
 
-	tx.Txid, err = common.MakeTxId(tx)
+	tx.Txid, err = sutils.MakeTxId(tx)
 	if err != nil {
 		return errors.New("MakeTxDigesthash txid error")
 	}
@@ -181,7 +179,7 @@ func (c *MultisigSendCommand) sendXuper(ctx context.Context, signs string) error
 }
 
 // getSigns 读文件，填充pb.SignatureInfo
-func (c *MultisigSendCommand) getSigns(path string) ([]*pb.SignatureInfo, error) {
+func getSigns(path string) ([]*pb.SignatureInfo, error) {
 	signs := []*pb.SignatureInfo{}
 	for _, file := range strings.Split(path, ",") {
 		buf, err := ioutil.ReadFile(file)
@@ -202,25 +200,5 @@ func (c *MultisigSendCommand) getSigns(path string) ([]*pb.SignatureInfo, error)
 }
 
 func (c *MultisigSendCommand) sendTx(ctx context.Context, tx *pb.Transaction) (string, error) {
-	txStatus := &pb.TxStatus{
-		Bcname: c.cli.RootOptions.Name,
-		Status: pb.TransactionStatus_UNCONFIRM,
-		Tx:     tx,
-		Header: &pb.Header{
-			Logid: utils.GenLogId(),
-		},
-		Txid: tx.Txid,
-	}
-
-	//reply, err := c.cli.XchainClient().Send(ctx, txStatus)
-	reply, err := c.cli.XchainClient().PostTx(ctx, txStatus)
-	if err != nil {
-		return "", err
-	}
-
-	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
-		return "", fmt.Errorf("Failed to post tx:%s, logid:%s", reply.Header.Error.String(), reply.Header.Logid)
-	}
-
-	return hex.EncodeToString(txStatus.Txid), nil
+	return c.cli.Client().PostTx(ctx, tx)
 }
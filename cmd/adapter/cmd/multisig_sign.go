@@ -14,8 +14,9 @@ import (
 	"github.com/spf13/cobra"
 
 	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+	sutils "github.com/xuperchain/xuperos/common/utils"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
-	"github.com/xuperchain/xuperos/service/adapter/common"
+	"github.com/xuperchain/xuperos/sdk"
 )
 
 // MultisigSignCommand multisig sign struct
@@ -90,7 +91,7 @@ func (c *MultisigSignCommand) sign() error {
 		if err != nil {
 			return err
 		}
-		digestHash, err := common.MakeTxDigestHash(tx)
+		digestHash, err := sutils.MakeTxDigestHash(tx)
 		if err != nil {
 			return err
 		}
@@ -131,22 +132,15 @@ func (c *MultisigSignCommand) sign() error {
 
 // GetSignTx use privatekey to get sign
 func (c *MultisigSignCommand) genSignTx(tx *pb.Transaction) ([]byte, error) {
-	// create crypto client
-	cryptoClient, err := crypto_client.CreateCryptoClient(c.cli.RootOptions.Crypto)
-	if err != nil {
-		return nil, errors.New("Create crypto client error")
-	}
-	fromScrkey, err := readPrivateKey(c.cli.RootOptions.Keys)
+	account, err := sdk.LoadAccount(c.cli.RootOptions.Keys)
 	if err != nil {
 		return nil, err
 	}
-
-	signTx, err := common.ComputeTxSign(cryptoClient, tx, []byte(fromScrkey))
+	signInfo, err := c.cli.Client().Sign(account, tx)
 	if err != nil {
 		return nil, err
 	}
-
-	return signTx, nil
+	return signInfo.GetSign(), nil
 }
 
 // genSignFile output to file
@@ -16,12 +16,13 @@ type NetURLCommand struct {
 func NewNetURLCommand(cli *Cli) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "netURL",
-		Short: "Operate a netURL: gen|get|preview|convert.",
+		Short: "Operate a netURL: gen|get|preview|convert|peers.",
 	}
 	cmd.AddCommand(NewNetURLGenCommand(cli))
 	cmd.AddCommand(NewNetURLGetCommand(cli))
 	cmd.AddCommand(NewNetURLPreviewCommand(cli))
 	cmd.AddCommand(NewNetURLConvertCommand(cli))
+	cmd.AddCommand(NewNetURLPeersCommand(cli))
 	return cmd
 }
 
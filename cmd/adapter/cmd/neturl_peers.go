@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2021. Baidu Inc. All Rights Reserved.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// NetURLPeersCommand peers diagnostic cmd
+type NetURLPeersCommand struct {
+	cli *Cli
+	cmd *cobra.Command
+
+	json bool
+}
+
+// NewNetURLPeersCommand new peers diagnostic cmd
+func NewNetURLPeersCommand(cli *Cli) *cobra.Command {
+	n := new(NetURLPeersCommand)
+	n.cli = cli
+	n.cmd = &cobra.Command{
+		Use:   "peers",
+		Short: "Show connected peers with chain heights, direction and latency",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return n.printPeers(context.TODO())
+		},
+	}
+	n.cmd.Flags().BoolVar(&n.json, "json", false, "Output in json format")
+	return n.cmd
+}
+
+func (n *NetURLPeersCommand) printPeers(ctx context.Context) error {
+	client := n.cli.XchainClient()
+	req := &pb.CommonIn{
+		Header: &pb.Header{
+			Logid: utils.GenLogId(),
+		},
+	}
+	reply, err := client.GetPeerDetails(ctx, req)
+	if err != nil {
+		return err
+	}
+	if reply.Header.Error != pb.XChainErrorEnum_SUCCESS {
+		return errors.New(reply.Header.Error.String())
+	}
+
+	if n.json {
+		output, err := json.MarshalIndent(reply, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(output))
+		return nil
+	}
+
+	// 以本节点的链为列，显示各节点高度及与本节点的高度差
+	local := reply.GetLocal()
+	localHeights := make(map[string]int64, len(local.GetChains()))
+	header := []string{"ID", "ADDRESS", "ACCOUNT", "DIRECTION", "LATENCY", "LAST_MSG", "PROBED"}
+	for _, chain := range local.GetChains() {
+		localHeights[chain.GetBcname()] = chain.GetHeight()
+		header = append(header, fmt.Sprintf("%s(%d)", chain.GetBcname(), chain.GetHeight()))
+	}
+	header = append(header, "ERROR")
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, peer := range reply.GetPeers() {
+		row := []string{
+			peer.GetId(),
+			peer.GetAddress(),
+			orDash(peer.GetAccount()),
+			peer.GetDirection().String(),
+			fmt.Sprintf("%dms", peer.GetLatency()),
+			formatLastMsg(peer.GetLastMsgTime()),
+			formatLastMsg(peer.GetProbeTime()),
+		}
+		heights := make(map[string]int64, len(peer.GetChains()))
+		for _, chain := range peer.GetChains() {
+			heights[chain.GetBcname()] = chain.GetHeight()
+		}
+		for _, chain := range local.GetChains() {
+			height, ok := heights[chain.GetBcname()]
+			if !ok {
+				row = append(row, "-")
+				continue
+			}
+			row = append(row, fmt.Sprintf("%d(%+d)", height, height-localHeights[chain.GetBcname()]))
+		}
+		row = append(row, orDash(peer.GetError()))
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	return w.Flush()
+}
+
+// 毫秒时间戳距现在的时间
+func formatLastMsg(msec int64) string {
+	if msec <= 0 {
+		return "-"
+	}
+	ago := time.Since(time.Unix(0, msec*int64(time.Millisecond))).Truncate(time.Second)
+	return ago.String() + " ago"
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
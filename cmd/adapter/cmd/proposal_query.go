@@ -64,8 +64,8 @@ func (c *ProposalQueryCommand) query(ctx context.Context) error {
 		Keys:         c.cli.RootOptions.Keys,
 		MultiAddrs:   c.multiAddrs,
 
-		ChainName:    c.cli.RootOptions.Name,
-		XchainClient: c.cli.XchainClient(),
+		ChainName: c.cli.RootOptions.Name,
+		Client:    c.cli.Client(),
 	}
 
 	if c.proposalID == "" {
@@ -57,11 +57,11 @@ func (c *ProposalThawCommand) thawProposal(ctx context.Context) error {
 
 		IsQuick: false,
 
-		ChainName:    c.cli.RootOptions.Name,
-		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
-		CryptoType:   c.cli.RootOptions.Crypto,
-		CliConf:      c.cli.CliConf,
+		ChainName:  c.cli.RootOptions.Name,
+		Keys:       c.cli.RootOptions.Keys,
+		Client:     c.cli.Client(),
+		CryptoType: c.cli.RootOptions.Crypto,
+		CliConf:    c.cli.CliConf,
 	}
 
 	var err error
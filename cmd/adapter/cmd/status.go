@@ -9,10 +9,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xuperos/common/metrics"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 )
 
@@ -25,6 +31,7 @@ type StatusCommand struct {
 	utxo   bool
 	branch bool
 	peers  bool
+	speeds bool
 }
 
 // NewStatusCommand new status cmd
@@ -48,6 +55,7 @@ func (s *StatusCommand) addFlags() {
 	s.cmd.Flags().BoolVarP(&s.utxo, "utxo", "U", false, "Get utxo info")
 	s.cmd.Flags().BoolVarP(&s.branch, "branch", "B", false, "Get branch info")
 	s.cmd.Flags().BoolVarP(&s.peers, "peers", "P", false, "Get peers info")
+	s.cmd.Flags().BoolVarP(&s.speeds, "speeds", "S", false, "Get tps/bps speeds info")
 }
 
 func (s *StatusCommand) printXchainStatus(ctx context.Context) error {
@@ -87,6 +95,8 @@ func (s *StatusCommand) convertToFlag() pb.ViewOption {
 		return pb.ViewOption_BRANCHINFO
 	} else if s.peers {
 		return pb.ViewOption_PEERS
+	} else if s.speeds {
+		return pb.ViewOption_SPEEDS
 	} else {
 		return pb.ViewOption_NONE
 	}
@@ -158,10 +168,43 @@ func (s *StatusCommand) extractSpecificInfo(status *SystemStatus) bool {
 		}
 		fmt.Println(string(output))
 		handled = true
+	} else if s.speeds {
+		s.printSpeeds(status.Speeds)
+		handled = true
 	}
 	return handled
 }
 
+// 按链输出速率表格
+func (s *StatusCommand) printSpeeds(speeds *pb.Speeds) {
+	rates := []string{metrics.SpeedTps, metrics.SpeedBps, metrics.SpeedSubmitTps, metrics.SpeedRejectTps}
+	counts := []string{metrics.CountSubmitTxs, metrics.CountConfirmTxs, metrics.CountRejectTxs, metrics.CountBlocks}
+	names := append(rates, counts...)
+	bcNames := make([]string, 0, len(speeds.GetBcSpeeds()))
+	for bcName := range speeds.GetBcSpeeds() {
+		bcNames = append(bcNames, bcName)
+	}
+	sort.Strings(bcNames)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "bcname\t"+strings.Join(names, "\t"))
+	printRow := func(name string, speed map[string]float64) {
+		row := []string{name}
+		for _, n := range rates {
+			row = append(row, strconv.FormatFloat(speed[n], 'f', 2, 64))
+		}
+		for _, n := range counts {
+			row = append(row, strconv.FormatFloat(speed[n], 'f', 0, 64))
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	for _, bcName := range bcNames {
+		printRow(bcName, speeds.GetBcSpeeds()[bcName].GetBcSpeed())
+	}
+	printRow("(sum)", speeds.GetSumSpeeds())
+	w.Flush()
+}
+
 func init() {
 	AddCommand(NewStatusCommand)
 }
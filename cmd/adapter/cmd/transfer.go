@@ -5,7 +5,6 @@
 package cmd
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -16,17 +15,18 @@ import (
 
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/sdk"
 )
 
 var (
 	// ErrInvalidAmount error
-	ErrInvalidAmount = errors.New("Invalid amount number")
+	ErrInvalidAmount = sdk.ErrInvalidAmount
 	// ErrNegativeAmount error
-	ErrNegativeAmount = errors.New("Amount in transaction can not be negative number")
+	ErrNegativeAmount = sdk.ErrNegativeAmount
 	// ErrPutTx error
 	ErrPutTx = errors.New("Put tx error")
 	// ErrSelectUtxo error
-	ErrSelectUtxo = errors.New("Select utxo error")
+	ErrSelectUtxo = sdk.ErrSelectUtxo
 )
 
 // TransferOptions transfer cmd options
@@ -91,25 +91,16 @@ func (t *TransferCommand) addFlags() {
 	t.cmd.Flags().BoolVar(&t.debug, "debug", false, "debug print tx instead of posting")
 }
 
-func readKeys(file string) (string, error) {
-	buf, err := ioutil.ReadFile(file)
-	if err != nil {
-		return "", err
-	}
-	buf = bytes.TrimSpace(buf)
-	return string(buf), nil
-}
-
 func readAddress(keypath string) (string, error) {
-	return readKeys(filepath.Join(keypath, "address"))
+	return sdk.ReadKeyFile(filepath.Join(keypath, sdk.AddressFile))
 }
 
 func readPublicKey(keypath string) (string, error) {
-	return readKeys(filepath.Join(keypath, "public.key"))
+	return sdk.ReadKeyFile(filepath.Join(keypath, sdk.PublicKeyFile))
 }
 
 func readPrivateKey(keypath string) (string, error) {
-	return readKeys(filepath.Join(keypath, "private.key"))
+	return sdk.ReadKeyFile(filepath.Join(keypath, sdk.PrivateKeyFile))
 }
 
 type invokeRequestWraper struct {
@@ -118,13 +109,6 @@ type invokeRequestWraper struct {
 	Args map[string]string `json:"args,omitempty"`
 }
 
-func newFeeAccount(fee string) *pb.TxDataAccount {
-	return &pb.TxDataAccount{
-		Address: utxo.FeePlaceholder,
-		Amount:  fee,
-	}
-}
-
 func (t *TransferCommand) getDesc() ([]byte, error) {
 	if t.descfile == "" {
 		return []byte("transfer from console"), nil
@@ -10,6 +10,7 @@ import (
 	"math/big"
 
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/service/adapter/common"
 )
 
 // HexID bytes
@@ -482,49 +483,56 @@ type ContractDesc struct {
 	Trigger TriggerDesc `json:"trigger"`
 }
 
-// FilteredBlock pb.FilteredBlock
-type FilteredBlock struct {
-	Bcname      string                 `json:"bcname,omitempty"`
-	Blockid     string                 `json:"blockid,omitempty"`
-	BlockHeight int64                  `json:"block_height,omitempty"`
-	Txs         []*FilteredTransaction `json:"txs,omitempty"`
+// TxEvent pb.TxEvent
+type TxEvent struct {
+	Bcname      string                  `json:"bcname,omitempty"`
+	Blockid     string                  `json:"blockid,omitempty"`
+	BlockHeight int64                   `json:"block_height,omitempty"`
+	Txid        string                  `json:"txid,omitempty"`
+	Events      []*common.ContractEvent `json:"events,omitempty"`
 }
 
-// FilteredTransaction pb.FilteredTransaction
-type FilteredTransaction struct {
-	Txid   string           `json:"txid,omitempty"`
-	Events []*ContractEvent `json:"events,omitempty"`
+// FromTxEventPB convert pb.TxEvent to TxEvent
+func FromTxEventPB(pbevent *pb.TxEvent) *TxEvent {
+	event := &TxEvent{
+		Bcname:      pbevent.Bcname,
+		Blockid:     pbevent.Blockid,
+		BlockHeight: pbevent.BlockHeight,
+		Txid:        pbevent.Txid,
+		Events:      common.FromContractEventsPB(pbevent.Events),
+	}
+	return event
 }
 
-// ContractEvent pb.ContractEvent
-type ContractEvent struct {
-	Contract string `json:"contract,omitempty"`
-	Name     string `json:"name,omitempty"`
-	Body     string `json:"body,omitempty"`
+// TxCheckFailure pb.TxCheckFailure
+type TxCheckFailure struct {
+	Check  string `json:"check"`
+	Index  int32  `json:"index"`
+	Reason string `json:"reason"`
 }
 
-// FromFilteredBlockPB convert pb.FilteredBlock to FilteredBlock
-func FromFilteredBlockPB(pbblock *pb.FilteredBlock) *FilteredBlock {
-	block := &FilteredBlock{
-		Bcname:      pbblock.Bcname,
-		Blockid:     pbblock.Blockid,
-		BlockHeight: pbblock.BlockHeight,
-		Txs:         make([]*FilteredTransaction, 0, len(pbblock.Txs)),
-	}
+// ValidateTxResult pb.ValidateTxResponse
+type ValidateTxResult struct {
+	Bcname   string            `json:"bcname"`
+	Txid     HexID             `json:"txid"`
+	Valid    bool              `json:"valid"`
+	Failures []*TxCheckFailure `json:"failures,omitempty"`
+}
 
-	for _, pbtx := range pbblock.Txs {
-		tx := &FilteredTransaction{
-			Txid:   pbtx.Txid,
-			Events: make([]*ContractEvent, 0, len(pbtx.Events)),
-		}
-		for _, pbevent := range pbtx.Events {
-			tx.Events = append(tx.Events, &ContractEvent{
-				Contract: pbevent.Contract,
-				Name:     pbevent.Name,
-				Body:     string(pbevent.Body),
-			})
-		}
-		block.Txs = append(block.Txs, tx)
+// FromPBValidateTxResponse convert pb.ValidateTxResponse to ValidateTxResult
+func FromPBValidateTxResponse(resp *pb.ValidateTxResponse) *ValidateTxResult {
+	result := &ValidateTxResult{
+		Bcname:   resp.GetBcname(),
+		Txid:     resp.GetTxid(),
+		Valid:    resp.GetValid(),
+		Failures: make([]*TxCheckFailure, 0, len(resp.GetFailures())),
+	}
+	for _, failure := range resp.GetFailures() {
+		result.Failures = append(result.Failures, &TxCheckFailure{
+			Check:  failure.GetCheck().String(),
+			Index:  failure.GetIndex(),
+			Reason: failure.GetReason(),
+		})
 	}
-	return block
+	return result
 }
@@ -6,15 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/spf13/cobra"
 
-	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
 	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
-	"github.com/xuperchain/xupercore/lib/utils"
-	"github.com/xuperchain/xuperos/common/xupospb/pb"
-	"github.com/xuperchain/xuperos/service/adapter/common"
+
+	"github.com/xuperchain/xuperos/sdk"
 )
 
 // MergeUtxoCommand necessary parameter for merge utxo
@@ -53,80 +50,46 @@ func (c *MergeUtxoCommand) mergeUtxo(ctx context.Context) error {
 		return errors.New("accountPath can not be null because account is an Account name")
 	}
 
-	initAk, _ := readAddress(c.cli.RootOptions.Keys)
-	if c.account == "" {
-		c.account = initAk
+	initiator, err := sdk.LoadAccount(c.cli.RootOptions.Keys)
+	if err != nil {
+		return err
 	}
-
-	tx := &pb.Transaction{
-		Version:   utxo.TxVersion,
-		Coinbase:  false,
-		Nonce:     utils.GenNonce(),
-		Timestamp: time.Now().UnixNano(),
-		Initiator: initAk,
+	if c.account == "" {
+		c.account = initiator.Address
 	}
 
-	ct := &CommTrans{
-		FrozenHeight: 0,
-		Version:      utxo.TxVersion,
-		From:         c.account,
-		Args:         make(map[string][]byte),
-		IsQuick:      false,
-		ChainName:    c.cli.RootOptions.Name,
-		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
-		CryptoType:   c.cli.RootOptions.Crypto,
+	// 按数量选取零散utxo并全部转回，锁定避免和并发的交易选中同一utxo
+	req := &sdk.Request{
+		Initiator:   initiator,
+		AKInitiator: true,
+		MergeUtxo:   true,
+		LockUtxo:    true,
 	}
-
-	txInputs, txOutput, err := ct.GenTxInputsWithMergeUTXO(context.Background())
-	tx.TxInputs = txInputs
-	// validation check
-	if len(tx.TxInputs) == 0 {
-		return errors.New("not enough available utxo to merge")
+	if c.account != initiator.Address {
+		req.ContractAccount = c.account
 	}
-
-	txOutputs := []*pb.TxOutput{}
-	txOutputs = append(txOutputs, txOutput)
-	tx.TxOutputs = txOutputs
-
-	tx.AuthRequire, err = genAuthRequire(c.account, c.accountPath)
+	req.AuthRequire, err = genAuthRequire(c.account, c.accountPath)
 	if err != nil {
 		return errors.New("genAuthRequire error")
 	}
 
-	// preExe
-	preExeRPCReq := &pb.InvokeRPCRequest{
-		Bcname:   c.cli.RootOptions.Name,
-		Requests: []*pb.InvokeRequest{},
-		Header: &pb.Header{
-			Logid: utils.GenLogId(),
-		},
-		Initiator:   initAk,
-		AuthRequire: tx.AuthRequire,
+	ct := &CommTrans{
+		ChainName:  c.cli.RootOptions.Name,
+		Keys:       c.cli.RootOptions.Keys,
+		Client:     c.cli.Client(),
+		CryptoType: c.cli.RootOptions.Crypto,
 	}
-	preExeRes, err := ct.XchainClient.PreExec(context.Background(), preExeRPCReq)
+	tx, _, err := ct.Client.BuildTx(ctx, req)
 	if err != nil {
 		return err
 	}
-	tx.ContractRequests = preExeRes.GetResponse().GetRequests()
-	tx.TxInputsExt = preExeRes.GetResponse().GetInputs()
-	tx.TxOutputsExt = preExeRes.GetResponse().GetOutputs()
-
-	tx.InitiatorSigns, err = ct.genInitSign(tx)
-	if err != nil {
-		return err
-	}
-	tx.AuthRequireSigns, err = ct.genAuthRequireSignsFromPath(tx, c.accountPath)
-	if err != nil {
-		return err
+	// validation check
+	if len(tx.TxInputs) == 0 {
+		return errors.New("not enough available utxo to merge")
 	}
 
-	// calculate txid
-	tx.Txid, err = common.MakeTxId(tx)
-	if err != nil {
-		return err
-	}
-	txid, err := ct.postTx(context.Background(), tx)
+	// 签名并提交
+	txid, err := ct.signAndPost(ctx, tx, c.accountPath)
 	if err != nil {
 		return err
 	}
@@ -7,15 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
-	"time"
 
 	"github.com/spf13/cobra"
 
-	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
 	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
-	"github.com/xuperchain/xupercore/lib/utils"
+
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
-	"github.com/xuperchain/xuperos/service/adapter/common"
+	"github.com/xuperchain/xuperos/sdk"
 )
 
 // SplitUtxoCommand split utxo of ak or account
@@ -65,110 +63,72 @@ func (c *SplitUtxoCommand) splitUtxo(ctx context.Context) error {
 		return errors.New("accountPath can not be null because account is an Account name")
 	}
 
-	initAk, err := readAddress(c.cli.RootOptions.Keys)
+	initiator, err := sdk.LoadAccount(c.cli.RootOptions.Keys)
+	if err != nil {
+		return err
+	}
 	if c.account == "" {
-		c.account = initAk
+		c.account = initiator.Address
 	}
 
-	if aclUtils.IsAccount(c.account) == 1 && c.account != initAk {
+	if aclUtils.IsAccount(c.account) == 1 && c.account != initiator.Address {
 		return errors.New("parse account error")
 	}
 
-	tx := &pb.Transaction{
-		Version:   utxo.TxVersion,
-		Coinbase:  false,
-		Nonce:     utils.GenNonce(),
-		Timestamp: time.Now().UnixNano(),
-		Initiator: initAk,
-	}
-
 	amount, err := c.getBalanceHelper()
 	if err != nil {
 		return err
 	}
-	ct := &CommTrans{
-		Amount:       amount,
-		FrozenHeight: 0,
-		Version:      utxo.TxVersion,
-		From:         c.account,
-		Args:         make(map[string][]byte),
-		IsQuick:      false,
-		ChainName:    c.cli.RootOptions.Name,
-		Keys:         c.cli.RootOptions.Keys,
-		XchainClient: c.cli.XchainClient(),
-		CryptoType:   c.cli.RootOptions.Crypto,
-		MultiAddrs:   c.multiAddrs,
-		Output:       c.output,
-	}
-
 	totalNeed, ok := big.NewInt(0).SetString(amount, 10)
 	if !ok {
 		return errors.New("get totalNeed error")
 	}
-
-	txInputs, txOutput, err := ct.GenTxInputs(context.Background(), totalNeed)
-	tx.TxInputs = txInputs
-
-	txOutputs, err := c.genSplitOutputs(totalNeed)
+	outputs, err := c.genSplitOutputs(totalNeed)
 	if err != nil {
 		return err
 	}
-	if txOutput != nil {
-		txOutputs = append(txOutputs, txOutput)
+
+	req := &sdk.Request{
+		Initiator:   initiator,
+		AKInitiator: true,
+		Outputs:     outputs,
+	}
+	if c.account != initiator.Address {
+		req.ContractAccount = c.account
 	}
-	tx.TxOutputs = txOutputs
 
+	ct := &CommTrans{
+		ChainName:  c.cli.RootOptions.Name,
+		Keys:       c.cli.RootOptions.Keys,
+		Client:     c.cli.Client(),
+		CryptoType: c.cli.RootOptions.Crypto,
+		MultiAddrs: c.multiAddrs,
+		Output:     c.output,
+	}
 	if c.isGenRawTx {
 		// 填充需要多重签名的addr
-		multiAddrs, err := ct.GenAuthRequire(c.multiAddrs)
+		req.AuthRequire, err = ct.GenAuthRequire(c.multiAddrs)
 		if err != nil {
 			return err
 		}
-		tx.AuthRequire = multiAddrs
 	} else {
-		tx.AuthRequire, err = genAuthRequire(c.account, c.accountPath)
+		req.AuthRequire, err = genAuthRequire(c.account, c.accountPath)
 		if err != nil {
 			return errors.New("genAuthRequire error")
 		}
 	}
 
-	// preExec
-	preExeRPCReq := &pb.InvokeRPCRequest{
-		Bcname:   c.cli.RootOptions.Name,
-		Requests: []*pb.InvokeRequest{},
-		Header: &pb.Header{
-			Logid: utils.GenLogId(),
-		},
-		Initiator:   initAk,
-		AuthRequire: tx.AuthRequire,
-	}
-	preExeRes, err := ct.XchainClient.PreExec(context.Background(), preExeRPCReq)
+	tx, _, err := ct.Client.BuildTx(ctx, req)
 	if err != nil {
 		return err
 	}
-	tx.ContractRequests = preExeRes.GetResponse().GetRequests()
-	tx.TxInputsExt = preExeRes.GetResponse().GetInputs()
-	tx.TxOutputsExt = preExeRes.GetResponse().GetOutputs()
 	if c.isGenRawTx {
 		// 直接输出原始交易内容到文件
 		return ct.GenTxFile(tx)
 	}
 
-	tx.InitiatorSigns, err = ct.genInitSign(tx)
-	if err != nil {
-		return err
-	}
-	tx.AuthRequireSigns, err = ct.genAuthRequireSignsFromPath(tx, c.accountPath)
-	if err != nil {
-		return err
-	}
-
-	// calculate txid
-	tx.Txid, err = common.MakeTxId(tx)
-	if err != nil {
-		return err
-	}
-	txid, err := ct.postTx(context.Background(), tx)
+	// 签名并提交
+	txid, err := ct.signAndPost(ctx, tx, c.accountPath)
 	fmt.Println(txid)
 	return err
 }
@@ -187,23 +147,18 @@ func (c *SplitUtxoCommand) getBalanceHelper() (string, error) {
 	return r.Bcs[0].Balance, nil
 }
 
-func (c *SplitUtxoCommand) genSplitOutputs(toralNeed *big.Int) ([]*pb.TxOutput, error) {
-	txOutputs := []*pb.TxOutput{}
-	amount := big.NewInt(0)
-	rest := toralNeed
-	if big.NewInt(c.num).Cmp(rest) == 1 {
+// 余额平均拆分为num份转给自己，余数计入最后一份
+func (c *SplitUtxoCommand) genSplitOutputs(totalNeed *big.Int) ([]*pb.TxDataAccount, error) {
+	if big.NewInt(c.num).Cmp(totalNeed) == 1 {
 		return nil, errors.New("illegal splitutxo, splitutxo <= BALANCE required")
 	}
-	amount.Div(rest, big.NewInt(c.num))
-	output := pb.TxOutput{}
-	output.Amount = amount.Bytes()
-	output.ToAddr = []byte(c.account)
+	amount := big.NewInt(0).Div(totalNeed, big.NewInt(c.num))
+	rest := big.NewInt(0).Set(totalNeed)
+	outputs := make([]*pb.TxDataAccount, 0, c.num)
 	for i := int64(1); i < c.num && rest.Cmp(amount) == 1; i++ {
-		tmpOutput := output
-		txOutputs = append(txOutputs, &tmpOutput)
+		outputs = append(outputs, &pb.TxDataAccount{Address: c.account, Amount: amount.String()})
 		rest.Sub(rest, amount)
 	}
-	output.Amount = rest.Bytes()
-	txOutputs = append(txOutputs, &output)
-	return txOutputs, nil
+	outputs = append(outputs, &pb.TxDataAccount{Address: c.account, Amount: rest.String()})
+	return outputs, nil
 }
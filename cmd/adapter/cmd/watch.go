@@ -9,16 +9,29 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/spf13/cobra"
 
+	"github.com/xuperchain/xuperos/common/sink"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/service/adapter/common"
 )
 
+// watch --type取值与订阅类型的对应关系
+var watchTypes = map[string]pb.SubscribeType{
+	"block":   pb.SubscribeType_BLOCK,
+	"tx":      pb.SubscribeType_TRANSACTION,
+	"account": pb.SubscribeType_ACCOUNT,
+	"pending": pb.SubscribeType_PENDING_TX,
+}
+
 type watchCommand struct {
 	cli *Cli
 	cmd *cobra.Command
 
+	typ         string
 	filter      string
 	oneline     bool
 	skipEmptyTx bool
+	resumeFile  string
+	startCursor string
 }
 
 func newWatchCommand(cli *Cli) *cobra.Command {
@@ -26,7 +39,13 @@ func newWatchCommand(cli *Cli) *cobra.Command {
 	c.cli = cli
 	c.cmd = &cobra.Command{
 		Use:   "watch [options]",
-		Short: "watch block event",
+		Short: "watch block, tx, account or pending tx event",
+		Example: `  xchain-cli watch -f '{"contract":"counter"}'
+  xchain-cli watch --type tx -f '{"txid":"..."}'
+  xchain-cli watch --type account -f '{"addresses":["TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY"]}'
+  xchain-cli watch --type pending -f '{"initiator":"TeyyPLpp9L7QAcxHangtcHTu7HUZ6iydY"}'
+  xchain-cli watch --type tx -f '{"contract":"counter"}' --resume-file ./watch.cursor
+  xchain-cli watch -f '{"contract":"counter"}' --start-cursor 100-2-0`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := context.TODO()
 			return c.watch(ctx)
@@ -37,15 +56,20 @@ func newWatchCommand(cli *Cli) *cobra.Command {
 }
 
 func (c *watchCommand) addFlags() {
+	c.cmd.Flags().StringVarP(&c.typ, "type", "t", "block", "event type: block, tx, account, pending")
 	c.cmd.Flags().StringVarP(&c.filter, "filter", "f", "{}", "filter options")
 	c.cmd.Flags().BoolVarP(&c.oneline, "oneline", "", false, "whether print one event one line")
 	c.cmd.Flags().BoolVarP(&c.skipEmptyTx, "skip-empty-tx", "", false, "whether print block with no tx matched")
+	c.cmd.Flags().StringVarP(&c.resumeFile, "resume-file", "", "", "file to persist the last event cursor, resume from it when exists")
+	c.cmd.Flags().StringVarP(&c.startCursor, "start-cursor", "", "", "resume after the cursor <block_height>-<tx_index>-<event_index>, ignored when resume file exists")
 }
 
 func (c *watchCommand) watch(ctx context.Context) error {
-	filter := &pb.BlockFilter{
-		Bcname: c.cli.RootOptions.Name,
+	typ, ok := watchTypes[c.typ]
+	if !ok {
+		return fmt.Errorf("unsupported event type: %s", c.typ)
 	}
+	filter := c.newFilter(typ)
 	err := json.Unmarshal([]byte(c.filter), filter)
 	if err != nil {
 		return err
@@ -53,9 +77,22 @@ func (c *watchCommand) watch(ctx context.Context) error {
 
 	buf, _ := proto.Marshal(filter)
 	request := &pb.SubscribeRequest{
-		Type:   pb.SubscribeType_BLOCK,
+		Type:   typ,
 		Filter: buf,
 	}
+	request.StartCursor, err = common.ParseCursor(c.startCursor)
+	if err != nil {
+		return err
+	}
+	if c.resumeFile != "" {
+		cursor, err := sink.LoadCursor(c.resumeFile)
+		if err != nil {
+			return err
+		}
+		if cursor != nil {
+			request.StartCursor = cursor
+		}
+	}
 
 	xclient := c.cli.EventClient()
 	stream, err := xclient.Subscribe(ctx, request)
@@ -70,26 +107,78 @@ func (c *watchCommand) watch(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		// 心跳事件只用于保活
+		if event.Heartbeat {
+			continue
+		}
+		if err := c.printEvent(typ, event.Payload); err != nil {
+			return err
+		}
+		// 事件输出后再记录游标，重连后从下一个事件开始
+		if c.resumeFile != "" && event.Cursor != nil {
+			if err := sink.SaveCursor(c.resumeFile, event.Cursor); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// 按订阅类型生成过滤器，默认使用全局指定的链
+func (c *watchCommand) newFilter(typ pb.SubscribeType) proto.Message {
+	bcname := c.cli.RootOptions.Name
+	switch typ {
+	case pb.SubscribeType_TRANSACTION:
+		return &pb.TxFilter{Bcname: bcname}
+	case pb.SubscribeType_ACCOUNT:
+		return &pb.AccountFilter{Bcname: bcname}
+	case pb.SubscribeType_PENDING_TX:
+		return &pb.PendingTxFilter{Bcname: bcname}
+	}
+	return &pb.BlockFilter{Bcname: bcname}
+}
+
+func (c *watchCommand) printEvent(typ pb.SubscribeType, payload []byte) error {
+	var output interface{}
+	switch typ {
+	case pb.SubscribeType_TRANSACTION:
+		var tx pb.TxEvent
+		if err := proto.Unmarshal(payload, &tx); err != nil {
+			return err
+		}
+		output = FromTxEventPB(&tx)
+	case pb.SubscribeType_ACCOUNT:
+		var account pb.AccountEvent
+		if err := proto.Unmarshal(payload, &account); err != nil {
+			return err
+		}
+		output = &account
+	case pb.SubscribeType_PENDING_TX:
+		var pending pb.PendingTxEvent
+		if err := proto.Unmarshal(payload, &pending); err != nil {
+			return err
+		}
+		output = &pending
+	default:
 		var block pb.FilteredBlock
-		err = proto.Unmarshal(event.Payload, &block)
-		if err != nil {
+		if err := proto.Unmarshal(payload, &block); err != nil {
 			return err
 		}
 		if len(block.GetTxs()) == 0 && c.skipEmptyTx {
-			continue
+			return nil
 		}
-		c.printBlock(&block)
+		output = common.FromFilteredBlockPB(&block)
 	}
+
+	c.print(output)
 	return nil
 }
 
-func (c *watchCommand) printBlock(pbblock *pb.FilteredBlock) {
-	block := FromFilteredBlockPB(pbblock)
+func (c *watchCommand) print(output interface{}) {
 	var buf []byte
 	if c.oneline {
-		buf, _ = json.Marshal(block)
+		buf, _ = json.Marshal(output)
 	} else {
-		buf, _ = json.MarshalIndent(block, "", "  ")
+		buf, _ = json.MarshalIndent(output, "", "  ")
 	}
 	fmt.Println(string(buf))
 }
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/sink"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 连接断开后的重连间隔
+const reconnectInterval = 3 * time.Second
+
+type EventsCmd struct {
+	BaseCmd
+}
+
+func GetEventsCmd() *EventsCmd {
+	eventsCmdIns := new(EventsCmd)
+	eventsCmdIns.Cmd = &cobra.Command{
+		Use:   "events",
+		Short: "Operate the event stream of a running node.",
+	}
+	eventsCmdIns.Cmd.AddCommand(getExportCmd())
+
+	return eventsCmdIns
+}
+
+type exportCmd struct {
+	host       string
+	bcname     string
+	filter     string
+	cursorFile string
+	keepEmpty  bool
+	sinkConf   sconf.SinkConf
+	options    map[string]string
+}
+
+func getExportCmd() *cobra.Command {
+	c := &exportCmd{
+		sinkConf: sconf.GetDefSinkConf(),
+	}
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export filtered blocks and contract events into rotating files or a registered sink.",
+		Example: `  xuperos events export --dir ./export -f '{"contract":"counter"}'
+  xuperos events export --format protobuf --rotate-size 64 --max-files 24 --cursor-file ./export.cursor`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.export()
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&c.host, "host", "H", "127.0.0.1:37101", "event service address of the node")
+	flags.StringVar(&c.bcname, "name", "xuper", "block chain name, used when the filter has no bcname")
+	flags.StringVarP(&c.filter, "filter", "f", "{}", "block filter in json, same as xchain-cli watch")
+	flags.StringVar(&c.cursorFile, "cursor-file", "./export.cursor", "file to persist the last exported cursor, resume from it when exists")
+	flags.BoolVar(&c.keepEmpty, "keep-empty", false, "export blocks with no tx matched")
+	flags.StringVar(&c.sinkConf.Type, "sink", c.sinkConf.Type, "sink type, file or a registered sink")
+	flags.StringVar(&c.sinkConf.Format, "format", c.sinkConf.Format, "file format: ndjson, protobuf (4 bytes big endian length prefixed)")
+	flags.StringVar(&c.sinkConf.Dir, "dir", c.sinkConf.Dir, "output dir of the file sink")
+	flags.StringVar(&c.sinkConf.Prefix, "prefix", c.sinkConf.Prefix, "output file name prefix")
+	flags.IntVar(&c.sinkConf.RotateSize, "rotate-size", c.sinkConf.RotateSize, "rotate the file when it reaches the size, in MB")
+	flags.IntVar(&c.sinkConf.RotateInterval, "rotate-interval", c.sinkConf.RotateInterval, "rotate the file after the interval, in seconds")
+	flags.IntVar(&c.sinkConf.MaxFiles, "max-files", c.sinkConf.MaxFiles, "number of files to keep, 0 means unlimited")
+	flags.IntVar(&c.sinkConf.MaxAge, "max-age", c.sinkConf.MaxAge, "hours to keep files, 0 means unlimited")
+	flags.StringToStringVar(&c.options, "sink-opt", nil, "options of a registered sink, key=value")
+
+	return cmd
+}
+
+func (c *exportCmd) export() error {
+	filter := new(pb.BlockFilter)
+	if err := json.Unmarshal([]byte(c.filter), filter); err != nil {
+		return fmt.Errorf("bad filter: %v", err)
+	}
+	if filter.Bcname == "" {
+		filter.Bcname = c.bcname
+	}
+	buf, err := proto.Marshal(filter)
+	if err != nil {
+		return err
+	}
+
+	c.sinkConf.Options = c.options
+	snk, err := sink.NewSink(&c.sinkConf)
+	if err != nil {
+		return err
+	}
+	exporter, err := sink.NewExporter(snk, c.cursorFile, !c.keepEmpty)
+	if err != nil {
+		snk.Close()
+		return err
+	}
+	defer exporter.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigChan
+		cancel()
+	}()
+
+	conn, err := grpc.Dial(c.host, grpc.WithInsecure(), grpc.WithMaxMsgSize(64<<20-1))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client := pb.NewEventServiceClient(conn)
+
+	// 流中断后从游标重新订阅，区块范围结束或收到退出信号时返回
+	for {
+		req := &pb.SubscribeRequest{
+			Type:        pb.SubscribeType_BLOCK,
+			Filter:      buf,
+			StartCursor: exporter.Cursor(),
+		}
+		err := c.recv(ctx, client, req, exporter)
+		if err == nil || ctx.Err() != nil {
+			return nil
+		}
+		if _, ok := err.(exportError); ok {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "subscribe interrupted, reconnect after %s: %v\n", reconnectInterval, err)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(reconnectInterval):
+		}
+	}
+}
+
+// exportError 写入sink失败，不再重连
+type exportError struct {
+	error
+}
+
+func (c *exportCmd) recv(ctx context.Context, client pb.EventServiceClient, req *pb.SubscribeRequest,
+	exporter *sink.Exporter) error {
+
+	stream, err := client.Subscribe(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := exporter.Export(ev); err != nil {
+			return exportError{err}
+		}
+	}
+}
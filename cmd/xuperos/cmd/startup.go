@@ -11,6 +11,7 @@ import (
 	"github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/lib/logs"
 	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/loglevel"
 	"github.com/xuperchain/xuperos/service"
 
 	// import要使用的内核核心组件驱动
@@ -69,6 +70,10 @@ func StartupXchain(envCfgPath string) error {
 
 	// 初始化日志
 	logs.InitLog(envConf.GenConfFilePath(envConf.LogConf), envConf.GenDirAbsPath(envConf.LogDir))
+	// 运行时调整日志级别时不能超过配置的级别
+	if err := loglevel.InitLimit(envConf.GenConfFilePath(envConf.LogConf)); err != nil {
+		return err
+	}
 
 	// 实例化区块链引擎
 	engine, err := engines.CreateBCEngine(common.BCEngineName, envConf)
@@ -88,7 +93,7 @@ func StartupXchain(envCfgPath string) error {
 	servChan := runServ(serv)
 
 	// 阻塞等待进程退出指令
-	sigChan := make(chan os.Signal)
+	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	go func() {
 		// 退出调用幂等
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/xuperchain/xuperos/cmd/xuperos/cmd"
+	"github.com/xuperchain/xuperos/common/def"
 
 	"github.com/spf13/cobra"
 )
@@ -16,6 +17,8 @@ var (
 )
 
 func main() {
+	def.Version, def.BuildTime, def.CommitID = Version, BuildTime, CommitID
+
 	rootCmd, err := NewServiceCommand()
 	if err != nil {
 		log.Fatalf("start service failed.err:%v", err)
@@ -38,6 +41,8 @@ func NewServiceCommand() (*cobra.Command, error) {
 
 	// cmd service
 	rootCmd.AddCommand(cmd.GetStartupCmd().GetCmd())
+	// cmd events
+	rootCmd.AddCommand(cmd.GetEventsCmd().GetCmd())
 	// cmd version
 	rootCmd.AddCommand(GetVersionCmd().GetCmd())
 
@@ -10,24 +10,218 @@ import (
 
 type ServConf struct {
 	// rpc server listen port
-	RpcPort            int      `yaml:"rpcPort,omitempty"`
-	AdapterRpcPort     int      `yaml:"adapterRpcPort,omitempty"`
-	AdapterGWPort      int      `yaml:"adapterGWPort,omitempty"`
-	MetricPort         int      `yaml:"metricPort,omitempty"`
-	EnableMetric       bool     `yaml:"enableMetric,omitempty"`
-	EnableTls          bool     `yaml:"enableTls,omitempty"`
-	EnableAdapter      bool     `yaml:"enableAdapter,omitempty"`
-	EnableEndorser     bool     `yaml:"enableEndorser,omitempty"`
-	EnableEvent        bool     `yaml:"enableEvent,omitempty"`
-	EndorserHosts      []string `yaml:"endorserHosts,omitempty"`
-	AdapterAllowCROS   bool     `yaml:"adapterAllowCROS,omitempty"`
-	MaxMsgSize         int      `yaml:"maxMsgSize,omitempty"`
-	ReadBufSize        int      `yaml:"readBufSize,omitempty"`
-	WriteBufSize       int      `yaml:"writeBufSize,omitempty"`
-	InitWindowSize     int32    `yaml:"initWindowSize,omitempty"`
-	InitConnWindowSize int32    `yaml:"initConnWindowSize,omitempty"`
-	TlsServerName      string   `yaml:"tlsServerName,omitempty"`
-	EventAddrMaxConn   int      `yaml:"eventAddrMaxConn,omitempty"`
+	RpcPort            int               `yaml:"rpcPort,omitempty"`
+	AdapterRpcPort     int               `yaml:"adapterRpcPort,omitempty"`
+	AdapterGWPort      int               `yaml:"adapterGWPort,omitempty"`
+	MetricPort         int               `yaml:"metricPort,omitempty"`
+	EnableMetric       bool              `yaml:"enableMetric,omitempty"`
+	EnableTls          bool              `yaml:"enableTls,omitempty"`
+	EnableAdapter      bool              `yaml:"enableAdapter,omitempty"`
+	EnableEndorser     bool              `yaml:"enableEndorser,omitempty"`
+	EnableEvent        bool              `yaml:"enableEvent,omitempty"`
+	EndorserHosts      []string          `yaml:"endorserHosts,omitempty"`
+	MaxMsgSize         int               `yaml:"maxMsgSize,omitempty"`
+	ReadBufSize        int               `yaml:"readBufSize,omitempty"`
+	WriteBufSize       int               `yaml:"writeBufSize,omitempty"`
+	InitWindowSize     int32             `yaml:"initWindowSize,omitempty"`
+	InitConnWindowSize int32             `yaml:"initConnWindowSize,omitempty"`
+	TlsServerName      string            `yaml:"tlsServerName,omitempty"`
+	EventAddrMaxConn   int               `yaml:"eventAddrMaxConn,omitempty"`
+	EventBufferSize    int               `yaml:"eventBufferSize,omitempty"`
+	EventSlowPolicy    string            `yaml:"eventSlowPolicy,omitempty"`
+	EventHeartbeat     int               `yaml:"eventHeartbeat,omitempty"`
+	SpeedWindow        int               `yaml:"speedWindow,omitempty"`
+	EnableAdmin        bool              `yaml:"enableAdmin,omitempty"`
+	AdminHost          string            `yaml:"adminHost,omitempty"`
+	AdminPort          int               `yaml:"adminPort,omitempty"`
+	AdminToken         string            `yaml:"adminToken,omitempty"`
+	EnableWebhook      bool              `yaml:"enableWebhook,omitempty"`
+	WebhookTimeout     int               `yaml:"webhookTimeout,omitempty"`
+	WebhookMaxBackoff  int               `yaml:"webhookMaxBackoff,omitempty"`
+	Webhooks           []*WebhookConf    `yaml:"webhooks,omitempty"`
+	EnableExport       bool              `yaml:"enableExport,omitempty"`
+	Export             ExportConf        `yaml:"export,omitempty"`
+	EndorserPool       EndorserPoolConf  `yaml:"endorserPool,omitempty"`
+	EndorserMode       string            `yaml:"endorserMode,omitempty"`
+	LocalEndorser      LocalEndorserConf `yaml:"localEndorser,omitempty"`
+	// 锁定utxo的签名必须带时间戳和随机数，关闭时兼容旧格式签名
+	StrictUtxoLockSign bool `yaml:"strictUtxoLockSign,omitempty"`
+	// 锁定utxo签名的有效期，单位：秒
+	UtxoLockSignWindow int `yaml:"utxoLockSignWindow,omitempty"`
+	// adapter gateway的https配置
+	GatewayTls GatewayTlsConf `yaml:"gatewayTls,omitempty"`
+	// adapter gateway的跨域策略
+	GatewayCors GatewayCorsConf `yaml:"gatewayCors,omitempty"`
+	// Deprecated: 使用gatewayCors，为true且未配置allowOrigins时允许任意来源跨域
+	AdapterAllowCROS bool `yaml:"adapterAllowCROS,omitempty"`
+	// 原生rpc服务的tls端口，0表示不开启，rpcPort保持明文
+	RpcTlsPort int `yaml:"rpcTlsPort,omitempty"`
+	// adapter rpc的tls端口，开启后adapterRpcPort保持明文；为0时enableTls使adapterRpcPort使用tls
+	AdapterTlsPort int `yaml:"adapterTlsPort,omitempty"`
+	// grpc服务的tls配置
+	Tls TlsConf `yaml:"tls,omitempty"`
+	// 以太坊JSON-RPC兼容服务
+	EnableEthRpc bool       `yaml:"enableEthRpc,omitempty"`
+	EthRpc       EthRpcConf `yaml:"ethRpc,omitempty"`
+	// 已确认区块和交易的查询缓存
+	LedgerCache LedgerCacheConf `yaml:"ledgerCache,omitempty"`
+}
+
+// LedgerCacheConf 每条链缓存的主干区块数和交易数，为0时不缓存
+type LedgerCacheConf struct {
+	BlockSize int `yaml:"blockSize,omitempty"`
+	TxSize    int `yaml:"txSize,omitempty"`
+}
+
+// EthRpcConf 以太坊JSON-RPC兼容服务，供以太坊工具访问一条链上的evm合约
+type EthRpcConf struct {
+	Host string `yaml:"host,omitempty"`
+	Port int    `yaml:"port,omitempty"`
+	// 服务的链
+	Bcname string `yaml:"bcname,omitempty"`
+	// eth_chainId返回的链id，钱包用于区分网络和交易签名
+	ChainId int64 `yaml:"chainId,omitempty"`
+	// eth_getLogs一次查询的最大区块数
+	MaxLogRange int `yaml:"maxLogRange,omitempty"`
+}
+
+// TlsConf grpc服务的tls配置，证书文件相对tls目录(env.yaml的tlsDir)
+type TlsConf struct {
+	// 客户端认证：none不要求客户端证书，optional校验客户端提供的证书，require双向认证
+	ClientAuth string `yaml:"clientAuth,omitempty"`
+	// 根证书，用于校验客户端证书，clientAuth为none时可以不配置
+	CaFile   string `yaml:"caFile,omitempty"`
+	CertFile string `yaml:"certFile,omitempty"`
+	KeyFile  string `yaml:"keyFile,omitempty"`
+	// tls版本：1.0/1.1/1.2/1.3，maxVersion为空时不限制
+	MinVersion string `yaml:"minVersion,omitempty"`
+	MaxVersion string `yaml:"maxVersion,omitempty"`
+	// 加密套件名，如TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256，为空时使用默认套件，tls1.3的套件不可配置
+	CipherSuites []string `yaml:"cipherSuites,omitempty"`
+	// 检查证书文件变化的间隔，单位：秒，0表示不重新加载
+	ReloadInterval int `yaml:"reloadInterval,omitempty"`
+}
+
+// AdapterRpcTls adapterRpcPort是否使用tls
+func (t *ServConf) AdapterRpcTls() bool {
+	return t.EnableTls && t.AdapterTlsPort <= 0
+}
+
+// GatewayTlsConf adapter gateway对外提供https，证书文件相对tls目录(env.yaml的tlsDir)
+// 访问adapter rpc的tls由enableTls控制，使用tls目录下的节点证书作为客户端证书
+type GatewayTlsConf struct {
+	EnableHttps bool   `yaml:"enableHttps,omitempty"`
+	CertFile    string `yaml:"certFile,omitempty"`
+	KeyFile     string `yaml:"keyFile,omitempty"`
+}
+
+// GatewayCorsConf adapter gateway的跨域策略，allowOrigins为空时不允许跨域
+type GatewayCorsConf struct {
+	// 允许的来源：完整来源如https://wallet.example.com，通配子域名如https://*.example.com，*表示任意来源
+	AllowOrigins []string `yaml:"allowOrigins,omitempty"`
+	AllowMethods []string `yaml:"allowMethods,omitempty"`
+	AllowHeaders []string `yaml:"allowHeaders,omitempty"`
+	// 允许携带cookie等凭证，不能和任意来源同时使用
+	AllowCredentials bool `yaml:"allowCredentials,omitempty"`
+	// 预检结果的缓存时间，单位：秒，0表示不缓存
+	MaxAge int `yaml:"maxAge,omitempty"`
+}
+
+// LocalEndorserConf endorserMode为local时由节点自身提供背书服务
+type LocalEndorserConf struct {
+	// 背书私钥目录，相对根目录，local模式必须配置且不能使用节点账户
+	KeyDir string `yaml:"keyDir,omitempty"`
+	// ComplianceCheck需要支付的最低服务费，0表示不收费
+	Fee int64 `yaml:"fee,omitempty"`
+	// 服务费收款地址，为空时使用背书地址
+	FeeAddr string `yaml:"feeAddr,omitempty"`
+	// 合规规则文件，相对配置目录，为空时拒绝ComplianceCheck
+	Rules string `yaml:"rules,omitempty"`
+	// 合规检查审计日志，相对根目录
+	AuditLog string `yaml:"auditLog,omitempty"`
+	// 每日限额的已用额度，相对根目录，重启后继续累计
+	QuotaFile string `yaml:"quotaFile,omitempty"`
+}
+
+// EndorserPoolConf 背书服务连接池，endorserHosts和endpoints中的节点共同组成连接池
+type EndorserPoolConf struct {
+	// 节点选择策略：round_robin/least_loaded
+	Policy string `yaml:"policy,omitempty"`
+	// 健康检查间隔和超时，单位：秒
+	HealthCheckInterval int `yaml:"healthCheckInterval,omitempty"`
+	HealthCheckTimeout  int `yaml:"healthCheckTimeout,omitempty"`
+	// 调用失败时换其他节点重试的次数
+	MaxRetries int `yaml:"maxRetries,omitempty"`
+	// 连续失败breakerThreshold次后熔断，breakerCooldown秒后放行一次探测请求
+	BreakerThreshold int `yaml:"breakerThreshold,omitempty"`
+	BreakerCooldown  int `yaml:"breakerCooldown,omitempty"`
+	// 需要单独配置TLS的节点
+	Endpoints []*EndorserEndpoint `yaml:"endpoints,omitempty"`
+}
+
+// EndorserEndpoint 背书节点
+type EndorserEndpoint struct {
+	Host string         `yaml:"host,omitempty"`
+	Tls  *TlsClientConf `yaml:"tls,omitempty"`
+}
+
+// TlsClientConf grpc客户端TLS配置，同时设置certFile和keyFile时使用双向认证
+// 相对路径基于根目录
+type TlsClientConf struct {
+	CaFile     string `yaml:"caFile,omitempty"`
+	CertFile   string `yaml:"certFile,omitempty"`
+	KeyFile    string `yaml:"keyFile,omitempty"`
+	ServerName string `yaml:"serverName,omitempty"`
+}
+
+// WebhookConf 事件推送订阅，匹配filter的区块以json格式POST到url
+type WebhookConf struct {
+	Name string `yaml:"name,omitempty"`
+	Url  string `yaml:"url,omitempty"`
+	// HMAC-SHA256签名密钥
+	Secret string       `yaml:"secret,omitempty"`
+	Filter *EventFilter `yaml:"filter,omitempty"`
+}
+
+// ExportConf 事件导出，匹配filter的区块写入sink
+type ExportConf struct {
+	Filter *EventFilter `yaml:"filter,omitempty"`
+	// 跳过没有匹配交易的区块
+	SkipEmpty bool `yaml:"skipEmpty,omitempty"`
+	// 导出游标文件，相对数据目录
+	CursorFile string   `yaml:"cursorFile,omitempty"`
+	Sink       SinkConf `yaml:"sink,omitempty"`
+}
+
+// SinkConf 导出目标，type为file时写入本地滚动文件，其他类型由注册的sink实现
+type SinkConf struct {
+	Type string `yaml:"type,omitempty"`
+	// 文件格式：ndjson/protobuf，ndjson的区块格式与webhook推送相同，
+	// protobuf为4字节大端长度前缀的ExportRecord
+	Format string `yaml:"format,omitempty"`
+	Dir    string `yaml:"dir,omitempty"`
+	Prefix string `yaml:"prefix,omitempty"`
+	// 单个文件大小上限，单位：MB
+	RotateSize int `yaml:"rotateSize,omitempty"`
+	// 文件滚动间隔，单位：秒
+	RotateInterval int `yaml:"rotateInterval,omitempty"`
+	// 保留的文件数和时长（小时），0表示不限制
+	MaxFiles int `yaml:"maxFiles,omitempty"`
+	MaxAge   int `yaml:"maxAge,omitempty"`
+	// 非file类型sink的自定义参数
+	Options map[string]string `yaml:"options,omitempty"`
+}
+
+// EventFilter 字段含义同BlockFilter，start为首次推送的区块高度，为空时从最新区块开始
+type EventFilter struct {
+	Bcname      string `yaml:"bcname,omitempty"`
+	Start       string `yaml:"start,omitempty"`
+	Contract    string `yaml:"contract,omitempty"`
+	EventName   string `yaml:"eventName,omitempty"`
+	Initiator   string `yaml:"initiator,omitempty"`
+	AuthRequire string `yaml:"authRequire,omitempty"`
+	FromAddr    string `yaml:"fromAddr,omitempty"`
+	ToAddr      string `yaml:"toAddr,omitempty"`
 }
 
 func LoadServConf(cfgFile string) (*ServConf, error) {
@@ -36,6 +230,9 @@ func LoadServConf(cfgFile string) (*ServConf, error) {
 	if err != nil {
 		return nil, fmt.Errorf("load server config failed.err:%s", err)
 	}
+	if err := cfg.check(); err != nil {
+		return nil, fmt.Errorf("server config error.err:%s", err)
+	}
 
 	return cfg, nil
 }
@@ -52,7 +249,6 @@ func GetDefServConf() *ServConf {
 		EnableEndorser:     false,
 		EnableEvent:        true,
 		EndorserHosts:      []string{},
-		AdapterAllowCROS:   false,
 		MaxMsgSize:         128 << 20,
 		ReadBufSize:        32 << 10,
 		WriteBufSize:       32 << 10,
@@ -60,6 +256,85 @@ func GetDefServConf() *ServConf {
 		InitConnWindowSize: 64 << 10,
 		TlsServerName:      "localhost",
 		EventAddrMaxConn:   5,
+		EventBufferSize:    1024,
+		EventSlowPolicy:    "block",
+		EventHeartbeat:     30,
+		SpeedWindow:        60,
+		EnableAdmin:        false,
+		AdminHost:          "127.0.0.1",
+		AdminPort:          38102,
+		AdminToken:         "",
+		EnableWebhook:      false,
+		WebhookTimeout:     10,
+		WebhookMaxBackoff:  300,
+		Webhooks:           []*WebhookConf{},
+		EnableExport:       false,
+		EndorserPool: EndorserPoolConf{
+			Policy:              "round_robin",
+			HealthCheckInterval: 10,
+			HealthCheckTimeout:  3,
+			MaxRetries:          2,
+			BreakerThreshold:    5,
+			BreakerCooldown:     30,
+		},
+		EndorserMode:       "proxy",
+		StrictUtxoLockSign: false,
+		UtxoLockSignWindow: 60,
+		RpcTlsPort:         0,
+		AdapterTlsPort:     0,
+		Tls: TlsConf{
+			ClientAuth:     "require",
+			CaFile:         "cert.crt",
+			CertFile:       "key.pem",
+			KeyFile:        "private.key",
+			MinVersion:     "1.2",
+			ReloadInterval: 10,
+		},
+		GatewayTls: GatewayTlsConf{
+			EnableHttps: false,
+			CertFile:    "key.pem",
+			KeyFile:     "private.key",
+		},
+		GatewayCors: GatewayCorsConf{
+			AllowOrigins: []string{},
+			AllowMethods: []string{"GET", "HEAD", "POST", "PUT", "DELETE"},
+			AllowHeaders: []string{"Content-Type", "Accept"},
+			MaxAge:       600,
+		},
+		EnableEthRpc: false,
+		EthRpc: EthRpcConf{
+			Host:        "127.0.0.1",
+			Port:        8545,
+			Bcname:      "xuper",
+			ChainId:     1337,
+			MaxLogRange: 1000,
+		},
+		LedgerCache: LedgerCacheConf{
+			BlockSize: 500,
+			TxSize:    10000,
+		},
+		LocalEndorser: LocalEndorserConf{
+			AuditLog:  "logs/compliance_audit.log",
+			QuotaFile: "data/endorser/compliance_quota.json",
+		},
+		Export: ExportConf{
+			SkipEmpty:  true,
+			CursorFile: "export/cursor.json",
+			Sink:       GetDefSinkConf(),
+		},
+	}
+}
+
+func GetDefSinkConf() SinkConf {
+	return SinkConf{
+		Type:           "file",
+		Format:         "ndjson",
+		Dir:            "./data/export",
+		Prefix:         "events",
+		RotateSize:     128,
+		RotateInterval: 3600,
+		MaxFiles:       168,
+		MaxAge:         0,
 	}
 }
 
@@ -81,3 +356,11 @@ func (t *ServConf) loadConf(cfgFile string) error {
 
 	return nil
 }
+
+// 检查配置取值，不合法的取值在启动时报错
+func (t *ServConf) check() error {
+	if t.UtxoLockSignWindow <= 0 {
+		return fmt.Errorf("utxoLockSignWindow must be positive: %d", t.UtxoLockSignWindow)
+	}
+	return nil
+}
@@ -21,3 +21,16 @@ func getConfFile() string {
 	dir := utils.GetCurFileDir()
 	return filepath.Join(dir, "mock/server.yaml")
 }
+
+func TestServConfCheck(t *testing.T) {
+	cfg := GetDefServConf()
+	if err := cfg.check(); err != nil {
+		t.Fatal(err)
+	}
+	for _, window := range []int{0, -1} {
+		cfg.UtxoLockSignWindow = window
+		if err := cfg.check(); err == nil {
+			t.Errorf("utxoLockSignWindow %d should be invalid", window)
+		}
+	}
+}
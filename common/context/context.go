@@ -10,6 +10,7 @@ import (
 	"github.com/xuperchain/xupercore/lib/timer"
 
 	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
 )
 
 const (
@@ -37,7 +38,7 @@ func NewReqCtx(engine common.Engine, reqId, clientIp string) (ReqCtx, error) {
 		return nil, fmt.Errorf("new request context failed because engine is nil")
 	}
 
-	log, err := logs.NewLogger(reqId, def.SubModName)
+	log, err := loglevel.NewLogger(reqId, def.SubModName)
 	if err != nil {
 		return nil, fmt.Errorf("new request context failed because new logger failed.err:%s", err)
 	}
@@ -3,3 +3,10 @@ package def
 const (
 	SubModName = "xuperos"
 )
+
+// 版本信息，编译时注入main包，由main包在启动时设置
+var (
+	Version   = ""
+	BuildTime = ""
+	CommitID  = ""
+)
@@ -0,0 +1,165 @@
+package loglevel
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+	lconf "github.com/xuperchain/xupercore/lib/logs/config"
+)
+
+// 运行时日志级别控制
+// 内核日志库在初始化时按log.yaml固定最低级别，这里只能在配置级别的基础上按子模块收紧输出，
+// 并且只对通过NewLogger创建的日志生效，内核模块的日志不受控制
+
+var (
+	// 子模块 => 运行时级别
+	levels   = make(map[string]logs.Lvl)
+	levelLck sync.RWMutex
+	// 通过NewLogger创建过日志的子模块
+	modules = make(map[string]bool)
+	// log.yaml配置的级别，运行时级别不能比它更详细
+	limit = logs.LvlDebug
+)
+
+var lvlNames = map[string]logs.Lvl{
+	"crit":  logs.LvlCrit,
+	"error": logs.LvlError,
+	"warn":  logs.LvlWarn,
+	"info":  logs.LvlInfo,
+	"trace": logs.LvlTrace,
+	"debug": logs.LvlDebug,
+}
+
+// InitLimit 读取log.yaml的级别作为运行时级别的上限，在logs.InitLog之后调用
+func InitLimit(cfgFile string) error {
+	conf, err := lconf.LoadLogConf(cfgFile)
+	if err != nil {
+		return err
+	}
+	lvl, ok := lvlNames[conf.Level]
+	if !ok {
+		return fmt.Errorf("unknown log level %s in %s", conf.Level, cfgFile)
+	}
+
+	levelLck.Lock()
+	defer levelLck.Unlock()
+	limit = lvl
+	return nil
+}
+
+// SetLevel 设置子模块日志级别，level为空时恢复配置级别
+// 子模块必须已创建日志，级别不能比log.yaml配置的级别更详细
+func SetLevel(subMod, level string) error {
+	if subMod == "" {
+		return fmt.Errorf("log module unset")
+	}
+
+	levelLck.Lock()
+	defer levelLck.Unlock()
+	if !modules[subMod] {
+		return fmt.Errorf("unknown log module %s, available modules: %s", subMod, moduleNames())
+	}
+	if level == "" {
+		delete(levels, subMod)
+		return nil
+	}
+	lvl, ok := lvlNames[level]
+	if !ok {
+		return fmt.Errorf("unknown log level %s", level)
+	}
+	if lvl > limit {
+		return fmt.Errorf("log level %s is more verbose than the configured level %s of log.yaml, "+
+			"change log.yaml and restart to enable it", level, lvlName(limit))
+	}
+	levels[subMod] = lvl
+	return nil
+}
+
+func moduleNames() string {
+	names := make([]string, 0, len(modules))
+	for name := range modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func lvlName(lvl logs.Lvl) string {
+	for name, v := range lvlNames {
+		if v == lvl {
+			return name
+		}
+	}
+	return ""
+}
+
+// GetLevels 返回设置过运行时级别的子模块
+func GetLevels() map[string]string {
+	levelLck.RLock()
+	defer levelLck.RUnlock()
+
+	res := make(map[string]string, len(levels))
+	for subMod, lvl := range levels {
+		res[subMod] = lvlName(lvl)
+	}
+	return res
+}
+
+func enabled(subMod string, lvl logs.Lvl) bool {
+	levelLck.RLock()
+	defer levelLck.RUnlock()
+
+	limit, ok := levels[subMod]
+	return !ok || lvl <= limit
+}
+
+// NewLogger 创建受运行时级别控制的日志实例，用法同logs.NewLogger
+func NewLogger(logId, subMod string) (logs.Logger, error) {
+	lf, err := logs.NewLogger(logId, subMod)
+	if err != nil {
+		return nil, err
+	}
+
+	levelLck.Lock()
+	modules[subMod] = true
+	levelLck.Unlock()
+	return &levelLogger{LogFitter: lf, subMod: subMod}, nil
+}
+
+type levelLogger struct {
+	*logs.LogFitter
+	subMod string
+}
+
+func (t *levelLogger) Error(msg string, ctx ...interface{}) {
+	if enabled(t.subMod, logs.LvlError) {
+		t.LogFitter.Error(msg, ctx...)
+	}
+}
+
+func (t *levelLogger) Warn(msg string, ctx ...interface{}) {
+	if enabled(t.subMod, logs.LvlWarn) {
+		t.LogFitter.Warn(msg, ctx...)
+	}
+}
+
+func (t *levelLogger) Info(msg string, ctx ...interface{}) {
+	if enabled(t.subMod, logs.LvlInfo) {
+		t.LogFitter.Info(msg, ctx...)
+	}
+}
+
+func (t *levelLogger) Trace(msg string, ctx ...interface{}) {
+	if enabled(t.subMod, logs.LvlTrace) {
+		t.LogFitter.Trace(msg, ctx...)
+	}
+}
+
+func (t *levelLogger) Debug(msg string, ctx ...interface{}) {
+	if enabled(t.subMod, logs.LvlDebug) {
+		t.LogFitter.Debug(msg, ctx...)
+	}
+}
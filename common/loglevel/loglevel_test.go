@@ -0,0 +1,55 @@
+package loglevel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+)
+
+func TestSetLevel(t *testing.T) {
+	modules["xuperos"] = true
+	limit = logs.LvlInfo
+	defer func() {
+		delete(modules, "xuperos")
+		levels = make(map[string]logs.Lvl)
+		limit = logs.LvlDebug
+	}()
+
+	cases := []struct {
+		module string
+		level  string
+		// 为空时期望成功，否则期望错误包含该内容
+		reject string
+	}{
+		{module: "xuperos", level: "warn"},
+		{module: "xuperos", level: "info"},
+		{module: "xuperos", level: ""},
+		{module: "", level: "warn", reject: "unset"},
+		{module: "p2p", level: "warn", reject: "unknown log module p2p"},
+		{module: "xuperos", level: "verbose", reject: "unknown log level"},
+		{module: "xuperos", level: "debug", reject: "more verbose than the configured level info"},
+	}
+	for _, c := range cases {
+		err := SetLevel(c.module, c.level)
+		if c.reject == "" {
+			if err != nil {
+				t.Errorf("%s %s: unexpected error %v", c.module, c.level, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.reject) {
+			t.Errorf("%s %s: expect error containing %q, got %v", c.module, c.level, c.reject, err)
+		}
+	}
+
+	if err := SetLevel("xuperos", "error"); err != nil {
+		t.Fatal(err)
+	}
+	if levels := GetLevels(); levels["xuperos"] != "error" {
+		t.Errorf("unexpected levels %v", levels)
+	}
+	if enabled("xuperos", logs.LvlWarn) || !enabled("xuperos", logs.LvlError) || !enabled("other", logs.LvlWarn) {
+		t.Error("runtime level should filter the module only")
+	}
+}
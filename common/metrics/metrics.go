@@ -0,0 +1,155 @@
+package metrics
+
+import prom "github.com/prometheus/client_golang/prometheus"
+
+const (
+	namespace = "xuperos"
+)
+
+var (
+	// TxCounter 交易计数，type取值submitted/confirmed/rejected
+	TxCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "ledger",
+			Name:      "tx_total",
+			Help:      "Total number of transactions",
+		},
+		[]string{"bcname", "type"})
+	// BlockCounter 确认区块计数
+	BlockCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "ledger",
+			Name:      "block_total",
+			Help:      "Total number of confirmed blocks",
+		},
+		[]string{"bcname"})
+	// SpeedGauge 滑动窗口速率，name取值同SystemsStatus.speeds
+	SpeedGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "ledger",
+			Name:      "speed",
+			Help:      "Sliding window rate per second",
+		},
+		[]string{"bcname", "name"})
+	// EventSubscriberGauge 事件订阅数
+	EventSubscriberGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "event",
+			Name:      "subscribers",
+			Help:      "Number of active event subscriptions",
+		},
+		[]string{"type"})
+	// EventLagHistogram 推送事件时订阅缓冲区中积压的事件数
+	EventLagHistogram = prom.NewHistogramVec(
+		prom.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "event",
+			Name:      "subscriber_lag",
+			Help:      "Number of events buffered for a subscriber when sending",
+			Buckets:   prom.ExponentialBuckets(1, 4, 8),
+		},
+		[]string{"type"})
+	// EventDropCounter 慢订阅者丢弃的事件数，policy取值drop_oldest/disconnect
+	EventDropCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "event",
+			Name:      "dropped_total",
+			Help:      "Total number of events dropped for slow subscribers",
+		},
+		[]string{"type", "policy"})
+	// WebhookCounter webhook推送次数，result取值delivered/failed
+	WebhookCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "webhook",
+			Name:      "deliveries_total",
+			Help:      "Total number of webhook delivery attempts",
+		},
+		[]string{"webhook", "result"})
+	// EndorserCallCounter 背书节点调用次数，result取值ok/failed/rejected/canceled
+	EndorserCallCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "endorser",
+			Name:      "calls_total",
+			Help:      "Total number of endorser calls per host",
+		},
+		[]string{"host", "result"})
+	// EndorserInflightGauge 背书节点处理中的调用数
+	EndorserInflightGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "endorser",
+			Name:      "inflight",
+			Help:      "Number of in-flight endorser calls per host",
+		},
+		[]string{"host"})
+	// EndorserHealthyGauge 背书节点健康检查结果，1健康0不健康
+	EndorserHealthyGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "endorser",
+			Name:      "healthy",
+			Help:      "Health check result per endorser host",
+		},
+		[]string{"host"})
+	// EndorserBreakerGauge 背书节点熔断状态，0关闭1熔断2半开
+	EndorserBreakerGauge = prom.NewGaugeVec(
+		prom.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "endorser",
+			Name:      "breaker_state",
+			Help:      "Circuit breaker state per endorser host, 0 closed, 1 open, 2 half-open",
+		},
+		[]string{"host"})
+	// GatewayRequestCounter 网关请求数，route为注册的路由，未知路径为other
+	GatewayRequestCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "requests_total",
+			Help:      "Total number of gateway http requests",
+		},
+		[]string{"route", "method", "code"})
+	// GatewayLatencyHistogram 网关请求耗时，单位秒
+	GatewayLatencyHistogram = prom.NewHistogramVec(
+		prom.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "gateway",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of gateway http requests in seconds",
+			Buckets:   prom.DefBuckets,
+		},
+		[]string{"route", "method"})
+	// LedgerCacheCounter 区块和交易缓存的查询数，type取值block/height/tx，result取值hit/miss
+	LedgerCacheCounter = prom.NewCounterVec(
+		prom.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "ledger",
+			Name:      "cache_requests_total",
+			Help:      "Total number of ledger cache lookups",
+		},
+		[]string{"bcname", "type", "result"})
+)
+
+func init() {
+	prom.MustRegister(TxCounter)
+	prom.MustRegister(BlockCounter)
+	prom.MustRegister(SpeedGauge)
+	prom.MustRegister(EventSubscriberGauge)
+	prom.MustRegister(EventLagHistogram)
+	prom.MustRegister(EventDropCounter)
+	prom.MustRegister(WebhookCounter)
+	prom.MustRegister(EndorserCallCounter)
+	prom.MustRegister(EndorserInflightGauge)
+	prom.MustRegister(EndorserHealthyGauge)
+	prom.MustRegister(EndorserBreakerGauge)
+	prom.MustRegister(GatewayRequestCounter)
+	prom.MustRegister(GatewayLatencyHistogram)
+	prom.MustRegister(LedgerCacheCounter)
+}
@@ -0,0 +1,206 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// SpeedEvent 速率统计事件类型
+type SpeedEvent int
+
+const (
+	// 提交交易(PostTx或eth_sendRawTransaction受理成功)
+	EventTxSubmitted SpeedEvent = iota
+	// 交易上链确认
+	EventTxConfirmed
+	// 提交交易被拒绝，包括排空期间拒绝
+	EventTxRejected
+	// 区块上链确认
+	EventBlockConfirmed
+)
+
+// SystemsStatus.speeds中各统计项名称
+const (
+	SpeedSubmitTps  = "SubmitTPS"
+	SpeedTps        = "TPS"
+	SpeedRejectTps  = "RejectTPS"
+	SpeedBps        = "BPS"
+	CountSubmitTxs  = "SubmittedTxs"
+	CountConfirmTxs = "ConfirmedTxs"
+	CountRejectTxs  = "RejectedTxs"
+	CountBlocks     = "ConfirmedBlocks"
+)
+
+// 默认滑动窗口大小(秒)
+const defSpeedWindow = 60
+
+var speedEvents = []SpeedEvent{
+	EventTxSubmitted,
+	EventTxConfirmed,
+	EventTxRejected,
+	EventBlockConfirmed,
+}
+
+// 速率名称
+func (e SpeedEvent) rateName() string {
+	switch e {
+	case EventTxSubmitted:
+		return SpeedSubmitTps
+	case EventTxConfirmed:
+		return SpeedTps
+	case EventTxRejected:
+		return SpeedRejectTps
+	default:
+		return SpeedBps
+	}
+}
+
+// 累计计数名称
+func (e SpeedEvent) countName() string {
+	switch e {
+	case EventTxSubmitted:
+		return CountSubmitTxs
+	case EventTxConfirmed:
+		return CountConfirmTxs
+	case EventTxRejected:
+		return CountRejectTxs
+	default:
+		return CountBlocks
+	}
+}
+
+// 上报prometheus计数
+func (e SpeedEvent) report(bcName string, n int64) {
+	switch e {
+	case EventTxSubmitted:
+		TxCounter.WithLabelValues(bcName, "submitted").Add(float64(n))
+	case EventTxConfirmed:
+		TxCounter.WithLabelValues(bcName, "confirmed").Add(float64(n))
+	case EventTxRejected:
+		TxCounter.WithLabelValues(bcName, "rejected").Add(float64(n))
+	default:
+		BlockCounter.WithLabelValues(bcName).Add(float64(n))
+	}
+}
+
+// 按秒分桶的滑动窗口计数器
+type meter struct {
+	buckets []int64
+	// 最新桶对应的时间(秒)
+	head  int64
+	total int64
+}
+
+func newMeter(window int64) *meter {
+	return &meter{buckets: make([]int64, window)}
+}
+
+// 将窗口推进到now，清理过期的桶
+func (m *meter) advance(now int64) {
+	if now <= m.head {
+		return
+	}
+	size := int64(len(m.buckets))
+	if now-m.head >= size {
+		for i := range m.buckets {
+			m.buckets[i] = 0
+		}
+	} else {
+		for sec := m.head + 1; sec <= now; sec++ {
+			m.buckets[sec%size] = 0
+		}
+	}
+	m.head = now
+}
+
+func (m *meter) add(now, n int64) {
+	m.advance(now)
+	m.buckets[now%int64(len(m.buckets))] += n
+	m.total += n
+}
+
+func (m *meter) rate(now int64) float64 {
+	m.advance(now)
+	var sum int64
+	for _, cnt := range m.buckets {
+		sum += cnt
+	}
+	return float64(sum) / float64(len(m.buckets))
+}
+
+// Speeds 按链统计交易和区块的滑动窗口速率
+type Speeds struct {
+	mutex  sync.Mutex
+	window int64
+	chains map[string]map[SpeedEvent]*meter
+	// 便于测试替换时间源
+	now func() int64
+}
+
+// NewSpeeds 创建速率统计，window为滑动窗口大小
+func NewSpeeds(window time.Duration) *Speeds {
+	sec := int64(window / time.Second)
+	if sec <= 0 {
+		sec = defSpeedWindow
+	}
+	return &Speeds{
+		window: sec,
+		chains: make(map[string]map[SpeedEvent]*meter),
+		now: func() int64 {
+			return time.Now().Unix()
+		},
+	}
+}
+
+// Mark 记录bcName链上发生n次ev事件
+func (s *Speeds) Mark(bcName string, ev SpeedEvent, n int64) {
+	if bcName == "" || n <= 0 {
+		return
+	}
+
+	s.mutex.Lock()
+	meters, ok := s.chains[bcName]
+	if !ok {
+		meters = make(map[SpeedEvent]*meter, len(speedEvents))
+		for _, e := range speedEvents {
+			meters[e] = newMeter(s.window)
+		}
+		s.chains[bcName] = meters
+	}
+	meters[ev].add(s.now(), n)
+	s.mutex.Unlock()
+
+	ev.report(bcName, n)
+}
+
+// Snapshot 返回全部链的汇总速率和各链速率
+func (s *Speeds) Snapshot() (map[string]float64, map[string]map[string]float64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	now := s.now()
+	sum := make(map[string]float64)
+	bcs := make(map[string]map[string]float64, len(s.chains))
+	for bcName, meters := range s.chains {
+		speed := make(map[string]float64, 2*len(meters))
+		for ev, m := range meters {
+			speed[ev.rateName()] = m.rate(now)
+			speed[ev.countName()] = float64(m.total)
+		}
+		for name, val := range speed {
+			sum[name] += val
+		}
+		bcs[bcName] = speed
+	}
+	return sum, bcs
+}
+
+// UpdateGauge 刷新prometheus速率指标
+func (s *Speeds) UpdateGauge() {
+	_, bcs := s.Snapshot()
+	for bcName, speed := range bcs {
+		for _, ev := range speedEvents {
+			SpeedGauge.WithLabelValues(bcName, ev.rateName()).Set(speed[ev.rateName()])
+		}
+	}
+}
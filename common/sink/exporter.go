@@ -0,0 +1,112 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// Exporter 将区块订阅事件写入sink并记录游标
+// 每条记录flush成功后才保存游标，进程中断后从游标继续导出，记录至少写入一次
+type Exporter struct {
+	sink       Sink
+	cursorFile string
+	skipEmpty  bool
+	cursor     *pb.EventCursor
+}
+
+// NewExporter cursorFile为空时不记录游标，skipEmpty为true时跳过没有匹配交易的区块
+func NewExporter(sink Sink, cursorFile string, skipEmpty bool) (*Exporter, error) {
+	t := &Exporter{
+		sink:       sink,
+		cursorFile: cursorFile,
+		skipEmpty:  skipEmpty,
+	}
+	if cursorFile == "" {
+		return t, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(cursorFile), 0755); err != nil {
+		return nil, err
+	}
+	cursor, err := LoadCursor(cursorFile)
+	if err != nil {
+		return nil, err
+	}
+	t.cursor = cursor
+	return t, nil
+}
+
+// Cursor 最后一条已导出事件的游标，重新订阅时作为start_cursor
+func (t *Exporter) Cursor() *pb.EventCursor {
+	return t.cursor
+}
+
+// Export 导出一条区块事件，心跳直接忽略
+func (t *Exporter) Export(ev *pb.Event) error {
+	if ev.GetHeartbeat() || ev.GetCursor() == nil {
+		return nil
+	}
+	block := new(pb.FilteredBlock)
+	if err := proto.Unmarshal(ev.GetPayload(), block); err != nil {
+		return err
+	}
+
+	if !t.skipEmpty || len(block.GetTxs()) > 0 {
+		rec := &pb.ExportRecord{
+			Cursor: ev.GetCursor(),
+			Block:  block,
+		}
+		if err := t.sink.Write(rec); err != nil {
+			return err
+		}
+		if err := t.sink.Flush(); err != nil {
+			return err
+		}
+	}
+
+	t.cursor = ev.GetCursor()
+	if t.cursorFile == "" {
+		return nil
+	}
+	return SaveCursor(t.cursorFile, t.cursor)
+}
+
+// Close 关闭sink，当前文件完成滚动
+func (t *Exporter) Close() error {
+	return t.sink.Close()
+}
+
+// LoadCursor 读取游标文件，文件不存在时返回nil
+func LoadCursor(fname string) (*pb.EventCursor, error) {
+	buf, err := ioutil.ReadFile(fname)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cursor := new(pb.EventCursor)
+	if err := json.Unmarshal(buf, cursor); err != nil {
+		return nil, fmt.Errorf("bad cursor in %s: %v", fname, err)
+	}
+	return cursor, nil
+}
+
+// SaveCursor 先写临时文件再重命名，避免进程中断时游标文件损坏
+func SaveCursor(fname string, cursor *pb.EventCursor) error {
+	buf, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	tmpName := fname + ".tmp"
+	if err := ioutil.WriteFile(tmpName, buf, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, fname)
+}
@@ -0,0 +1,285 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+const (
+	FileSinkType = "file"
+
+	FormatNDJSON   = "ndjson"
+	FormatProtobuf = "protobuf"
+
+	// 正在写入的文件后缀，滚动时去掉
+	partialExt = ".part"
+	timeLayout = "20060102T150405"
+)
+
+// fileSink 写入本地滚动文件
+// 文件名为<prefix>-<创建时间>-<序号>.<ndjson|pb>，写入中的文件带.part后缀，
+// 滚动或关闭后重命名，下游只需要处理不带.part后缀的文件
+type fileSink struct {
+	conf     sconf.SinkConf
+	ext      string
+	marshal  func(w *bufio.Writer, rec *pb.ExportRecord) error
+	maxSize  int64
+	interval time.Duration
+	maxAge   time.Duration
+
+	file     *os.File
+	counter  *countWriter
+	writer   *bufio.Writer
+	fname    string
+	openTime time.Time
+	stamp    string
+	seq      int
+}
+
+// NewFileSink 创建文件sink，上次异常退出遗留的.part文件会先完成重命名
+func NewFileSink(conf *sconf.SinkConf) (Sink, error) {
+	t := &fileSink{
+		conf:     *conf,
+		maxSize:  int64(conf.RotateSize) << 20,
+		interval: time.Duration(conf.RotateInterval) * time.Second,
+		maxAge:   time.Duration(conf.MaxAge) * time.Hour,
+	}
+	switch conf.Format {
+	case FormatNDJSON, "":
+		t.ext = ".ndjson"
+		t.marshal = writeJSON
+	case FormatProtobuf:
+		t.ext = ".pb"
+		t.marshal = writeProto
+	default:
+		return nil, fmt.Errorf("unsupported sink format %q", conf.Format)
+	}
+	if conf.Dir == "" {
+		return nil, fmt.Errorf("sink dir unset")
+	}
+	if t.conf.Prefix == "" {
+		t.conf.Prefix = "events"
+	}
+
+	if err := os.MkdirAll(conf.Dir, 0755); err != nil {
+		return nil, err
+	}
+	if err := t.recover(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *fileSink) Write(rec *pb.ExportRecord) error {
+	if t.file != nil && t.needRotate() {
+		if err := t.rotate(); err != nil {
+			return err
+		}
+	}
+	if t.file == nil {
+		if err := t.open(); err != nil {
+			return err
+		}
+	}
+	return t.marshal(t.writer, rec)
+}
+
+func (t *fileSink) Flush() error {
+	if t.file == nil {
+		return nil
+	}
+	if err := t.writer.Flush(); err != nil {
+		return err
+	}
+	if err := t.file.Sync(); err != nil {
+		return err
+	}
+	// 空闲时也按时间滚动，避免文件长时间处于写入中
+	if t.needRotate() {
+		return t.rotate()
+	}
+	return nil
+}
+
+func (t *fileSink) Close() error {
+	if t.file == nil {
+		return nil
+	}
+	return t.rotate()
+}
+
+func (t *fileSink) needRotate() bool {
+	size := t.counter.n + int64(t.writer.Buffered())
+	if t.maxSize > 0 && size >= t.maxSize {
+		return true
+	}
+	return t.interval > 0 && time.Since(t.openTime) >= t.interval
+}
+
+func (t *fileSink) open() error {
+	now := time.Now()
+	// 同一秒内多次滚动时序号递增，保证按名称排序即按创建顺序排序
+	stamp := now.Format(timeLayout)
+	if stamp != t.stamp {
+		t.stamp, t.seq = stamp, 0
+	}
+	var name string
+	for name == "" || t.exists(name) {
+		name = fmt.Sprintf("%s-%s-%03d%s", t.conf.Prefix, stamp, t.seq, t.ext)
+		t.seq++
+	}
+
+	fname := filepath.Join(t.conf.Dir, name+partialExt)
+	file, err := os.OpenFile(fname, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	t.file = file
+	t.counter = &countWriter{w: file}
+	t.writer = bufio.NewWriterSize(t.counter, 64<<10)
+	t.fname = fname
+	t.openTime = now
+	return nil
+}
+
+// 关闭当前文件并去掉.part后缀，随后清理过期文件
+func (t *fileSink) rotate() error {
+	if err := t.writer.Flush(); err != nil {
+		return err
+	}
+	if err := t.file.Sync(); err != nil {
+		return err
+	}
+	if err := t.file.Close(); err != nil {
+		return err
+	}
+	t.file = nil
+	t.writer = nil
+	if err := os.Rename(t.fname, strings.TrimSuffix(t.fname, partialExt)); err != nil {
+		return err
+	}
+	return t.retain()
+}
+
+func (t *fileSink) recover() error {
+	files, err := ioutil.ReadDir(t.conf.Dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		name := f.Name()
+		if !t.owns(strings.TrimSuffix(name, partialExt)) || !strings.HasSuffix(name, partialExt) {
+			continue
+		}
+		fname := filepath.Join(t.conf.Dir, name)
+		if f.Size() == 0 {
+			os.Remove(fname)
+			continue
+		}
+		if err := os.Rename(fname, strings.TrimSuffix(fname, partialExt)); err != nil {
+			return err
+		}
+	}
+	return t.retain()
+}
+
+// 按文件数和时长清理已完成的文件，文件名包含创建时间，按名称排序即按时间排序
+func (t *fileSink) retain() error {
+	if t.conf.MaxFiles <= 0 && t.maxAge <= 0 {
+		return nil
+	}
+	files, err := ioutil.ReadDir(t.conf.Dir)
+	if err != nil {
+		return err
+	}
+	done := make([]os.FileInfo, 0, len(files))
+	for _, f := range files {
+		if !f.IsDir() && t.owns(f.Name()) {
+			done = append(done, f)
+		}
+	}
+	sort.Slice(done, func(i, j int) bool {
+		return done[i].Name() < done[j].Name()
+	})
+
+	for i, f := range done {
+		expired := t.maxAge > 0 && time.Since(f.ModTime()) > t.maxAge
+		exceeded := t.conf.MaxFiles > 0 && len(done)-i > t.conf.MaxFiles
+		if !expired && !exceeded {
+			continue
+		}
+		if err := os.Remove(filepath.Join(t.conf.Dir, f.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *fileSink) owns(name string) bool {
+	return strings.HasPrefix(name, t.conf.Prefix+"-") && strings.HasSuffix(name, t.ext)
+}
+
+func (t *fileSink) exists(name string) bool {
+	for _, fname := range []string{name, name + partialExt} {
+		if _, err := os.Stat(filepath.Join(t.conf.Dir, fname)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// countWriter 统计已写入文件的字节数
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (t *countWriter) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	t.n += int64(n)
+	return n, err
+}
+
+// jsonRecord json格式的ExportRecord，区块与xchain-cli watch、网关订阅和webhook推送格式相同
+type jsonRecord struct {
+	Cursor *pb.EventCursor     `json:"cursor"`
+	Block  *acom.FilteredBlock `json:"block"`
+}
+
+// 每行一条记录，json.Encoder写入后自带换行
+func writeJSON(w *bufio.Writer, rec *pb.ExportRecord) error {
+	return json.NewEncoder(w).Encode(&jsonRecord{
+		Cursor: rec.GetCursor(),
+		Block:  acom.FromFilteredBlockPB(rec.GetBlock()),
+	})
+}
+
+// 4字节大端长度前缀加ExportRecord序列化数据
+func writeProto(w *bufio.Writer, rec *pb.ExportRecord) error {
+	buf, err := proto.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	var head [4]byte
+	binary.BigEndian.PutUint32(head[:], uint32(len(buf)))
+	if _, err := w.Write(head[:]); err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
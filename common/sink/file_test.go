@@ -0,0 +1,156 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+func newRecord(height int64) *pb.ExportRecord {
+	return &pb.ExportRecord{
+		Cursor: &pb.EventCursor{BlockHeight: height},
+		Block: &pb.FilteredBlock{
+			Bcname:      "xuper",
+			BlockHeight: height,
+			Txs: []*pb.FilteredTransaction{{
+				Txid:   "tx",
+				Events: []*pb.ContractEvent{{Contract: "counter", Name: "increase", Body: []byte(`{"key":1}`)}},
+			}},
+		},
+	}
+}
+
+func listFiles(t *testing.T, dir string) []string {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, f := range files {
+		names = append(names, f.Name())
+	}
+	return names
+}
+
+func TestFileSinkRotate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	conf := sconf.GetDefSinkConf()
+	conf.Dir = dir
+	conf.Format = FormatProtobuf
+	conf.MaxFiles = 2
+	snk, err := NewSink(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 每条记录后立即触发滚动
+	snk.(*fileSink).maxSize = 1
+
+	for i := int64(0); i < 5; i++ {
+		if err := snk.Write(newRecord(i)); err != nil {
+			t.Fatal(err)
+		}
+		if err := snk.Flush(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := snk.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	names := listFiles(t, dir)
+	if len(names) != 2 {
+		t.Fatalf("expect 2 files kept, got %v", names)
+	}
+	// 保留最新的文件，每个文件一条记录
+	last := names[len(names)-1]
+	if strings.HasSuffix(last, partialExt) {
+		t.Fatalf("file %s not finished", last)
+	}
+	f, err := os.Open(filepath.Join(dir, last))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	var head [4]byte
+	if _, err := io.ReadFull(r, head[:]); err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, binary.BigEndian.Uint32(head[:]))
+	if _, err := io.ReadFull(r, buf); err != nil {
+		t.Fatal(err)
+	}
+	rec := new(pb.ExportRecord)
+	if err := proto.Unmarshal(buf, rec); err != nil {
+		t.Fatal(err)
+	}
+	if rec.GetCursor().GetBlockHeight() != 4 {
+		t.Fatalf("expect last record height 4, got %d", rec.GetCursor().GetBlockHeight())
+	}
+	if _, err := r.ReadByte(); err != io.EOF {
+		t.Fatal("expect one record per file")
+	}
+}
+
+func TestFileSinkRecover(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sink")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	conf := sconf.GetDefSinkConf()
+	conf.Dir = dir
+	snk, err := NewSink(&conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := snk.Write(newRecord(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := snk.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	// 模拟异常退出，未关闭的文件在下次启动时完成
+	names := listFiles(t, dir)
+	if len(names) != 1 || !strings.HasSuffix(names[0], ".ndjson"+partialExt) {
+		t.Fatalf("expect one partial file, got %v", names)
+	}
+	if _, err := NewSink(&conf); err != nil {
+		t.Fatal(err)
+	}
+	names = listFiles(t, dir)
+	if len(names) != 1 || !strings.HasSuffix(names[0], ".ndjson") {
+		t.Fatalf("expect partial file recovered, got %v", names)
+	}
+	// 区块与webhook推送格式相同，事件内容按字符串输出
+	buf, _ := ioutil.ReadFile(filepath.Join(dir, names[0]))
+	line := new(struct {
+		Cursor *pb.EventCursor     `json:"cursor"`
+		Block  *acom.FilteredBlock `json:"block"`
+	})
+	if err := json.Unmarshal(buf, line); err != nil || !strings.HasSuffix(string(buf), "}\n") {
+		t.Fatalf("unexpected ndjson line %s", buf)
+	}
+	if line.Cursor.GetBlockHeight() != 1 || line.Block.BlockHeight != 1 ||
+		line.Block.Txs[0].Events[0].Body != `{"key":1}` {
+		t.Fatalf("unexpected ndjson record %s", buf)
+	}
+}
@@ -0,0 +1,53 @@
+package sink
+
+import (
+	"fmt"
+	"sync"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// Sink 事件导出目标，Flush返回成功后写入的记录需要已经持久化，导出游标随后前进
+type Sink interface {
+	Write(rec *pb.ExportRecord) error
+	Flush() error
+	Close() error
+}
+
+// NewSinkFunc 根据配置创建sink
+type NewSinkFunc func(conf *sconf.SinkConf) (Sink, error)
+
+var (
+	mutex    sync.RWMutex
+	registry = make(map[string]NewSinkFunc)
+)
+
+// Register 注册sink类型，kafka、nats等外部实现通过init注册后即可在配置中使用
+func Register(typ string, f NewSinkFunc) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if f == nil {
+		panic("sink: register nil func")
+	}
+	if _, ok := registry[typ]; ok {
+		panic("sink: register duplicate type " + typ)
+	}
+	registry[typ] = f
+}
+
+// NewSink 按配置的type创建sink
+func NewSink(conf *sconf.SinkConf) (Sink, error) {
+	mutex.RLock()
+	f, ok := registry[conf.Type]
+	mutex.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("sink type %q not registered", conf.Type)
+	}
+	return f(conf)
+}
+
+func init() {
+	Register(FileSinkType, NewFileSink)
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	crypto_base "github.com/xuperchain/xupercore/lib/crypto/client/base"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 为了完全兼容老版本pb结构，转换交易结构
+func TxToXledger(tx *pb.Transaction) *xldgpb.Transaction {
+	if tx == nil {
+		return nil
+	}
+
+	prtBuf, err := proto.Marshal(tx)
+	if err != nil {
+		return nil
+	}
+
+	var newTx xldgpb.Transaction
+	err = proto.Unmarshal(prtBuf, &newTx)
+	if err != nil {
+		return nil
+	}
+
+	return &newTx
+}
+
+// 为了完全兼容老版本pb结构，转换交易结构
+func TxToXchain(tx *xldgpb.Transaction) *pb.Transaction {
+	if tx == nil {
+		return nil
+	}
+
+	prtBuf, err := proto.Marshal(tx)
+	if err != nil {
+		return nil
+	}
+
+	var newTx pb.Transaction
+	err = proto.Unmarshal(prtBuf, &newTx)
+	if err != nil {
+		return nil
+	}
+
+	return &newTx
+}
+
+// 适配原结构计算txid
+func MakeTxId(tx *pb.Transaction) ([]byte, error) {
+	// 转化结构
+	xldgTx := TxToXledger(tx)
+	if xldgTx == nil {
+		return nil, fmt.Errorf("tx convert fail")
+	}
+	// 计算txid
+	txId, err := txhash.MakeTransactionID(xldgTx)
+	if err != nil {
+		return nil, err
+	}
+	return txId, nil
+}
+
+// 适配原结构签名
+func ComputeTxSign(cryptoClient crypto_base.CryptoClient, tx *pb.Transaction, jsonSK []byte) ([]byte, error) {
+	// 转换结构
+	xldgTx := TxToXledger(tx)
+	if xldgTx == nil {
+		return nil, fmt.Errorf("tx convert fail")
+	}
+	txSign, err := txhash.ProcessSignTx(cryptoClient, xldgTx, jsonSK)
+	if err != nil {
+		return nil, err
+	}
+	return txSign, nil
+}
+
+func MakeTxDigestHash(tx *pb.Transaction) ([]byte, error) {
+	// 转换结构
+	xldgTx := TxToXledger(tx)
+	if xldgTx == nil {
+		return nil, fmt.Errorf("tx convert fail")
+	}
+	digestHash, err := txhash.MakeTxDigestHash(xldgTx)
+	if err != nil {
+		return nil, err
+	}
+	return digestHash, nil
+}
@@ -0,0 +1,1841 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: admin.proto
+
+package xupospb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ChainStatus int32
+
+const (
+	ChainStatus_CHAIN_UNLOADED ChainStatus = 0
+	ChainStatus_CHAIN_LOADED   ChainStatus = 1
+	// 已停用，存储和p2p仍在运行，重启节点后才能再次加载
+	ChainStatus_CHAIN_DISABLED ChainStatus = 2
+)
+
+var ChainStatus_name = map[int32]string{
+	0: "CHAIN_UNLOADED",
+	1: "CHAIN_LOADED",
+	2: "CHAIN_DISABLED",
+}
+
+var ChainStatus_value = map[string]int32{
+	"CHAIN_UNLOADED": 0,
+	"CHAIN_LOADED":   1,
+	"CHAIN_DISABLED": 2,
+}
+
+func (x ChainStatus) String() string {
+	return proto.EnumName(ChainStatus_name, int32(x))
+}
+
+func (ChainStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{0}
+}
+
+// 日志级别设置请求
+type SetLogLevelReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 日志子模块，如xuperos、gateway、metric、admin
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// 日志级别：crit/error/warn/info/trace/debug，为空表示恢复配置级别
+	Level                string   `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogLevelReq) Reset()         { *m = SetLogLevelReq{} }
+func (m *SetLogLevelReq) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelReq) ProtoMessage()    {}
+func (*SetLogLevelReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{0}
+}
+
+func (m *SetLogLevelReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogLevelReq.Unmarshal(m, b)
+}
+func (m *SetLogLevelReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogLevelReq.Marshal(b, m, deterministic)
+}
+func (m *SetLogLevelReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelReq.Merge(m, src)
+}
+func (m *SetLogLevelReq) XXX_Size() int {
+	return xxx_messageInfo_SetLogLevelReq.Size(m)
+}
+func (m *SetLogLevelReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelReq proto.InternalMessageInfo
+
+func (m *SetLogLevelReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *SetLogLevelReq) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *SetLogLevelReq) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+// 各子模块运行时日志级别
+type LogLevelResp struct {
+	Header               *RespHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Levels               map[string]string `protobuf:"bytes,2,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LogLevelResp) Reset()         { *m = LogLevelResp{} }
+func (m *LogLevelResp) String() string { return proto.CompactTextString(m) }
+func (*LogLevelResp) ProtoMessage()    {}
+func (*LogLevelResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{1}
+}
+
+func (m *LogLevelResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogLevelResp.Unmarshal(m, b)
+}
+func (m *LogLevelResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogLevelResp.Marshal(b, m, deterministic)
+}
+func (m *LogLevelResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogLevelResp.Merge(m, src)
+}
+func (m *LogLevelResp) XXX_Size() int {
+	return xxx_messageInfo_LogLevelResp.Size(m)
+}
+func (m *LogLevelResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogLevelResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogLevelResp proto.InternalMessageInfo
+
+func (m *LogLevelResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *LogLevelResp) GetLevels() map[string]string {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+// CPU profile请求
+type CpuProfileReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 采样时长，单位：秒
+	Seconds              int64    `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CpuProfileReq) Reset()         { *m = CpuProfileReq{} }
+func (m *CpuProfileReq) String() string { return proto.CompactTextString(m) }
+func (*CpuProfileReq) ProtoMessage()    {}
+func (*CpuProfileReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{2}
+}
+
+func (m *CpuProfileReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CpuProfileReq.Unmarshal(m, b)
+}
+func (m *CpuProfileReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CpuProfileReq.Marshal(b, m, deterministic)
+}
+func (m *CpuProfileReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CpuProfileReq.Merge(m, src)
+}
+func (m *CpuProfileReq) XXX_Size() int {
+	return xxx_messageInfo_CpuProfileReq.Size(m)
+}
+func (m *CpuProfileReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CpuProfileReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CpuProfileReq proto.InternalMessageInfo
+
+func (m *CpuProfileReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CpuProfileReq) GetSeconds() int64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
+// goroutine、heap、cpu等pprof数据
+type ProfileResp struct {
+	Header               *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Data                 []byte      `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ProfileResp) Reset()         { *m = ProfileResp{} }
+func (m *ProfileResp) String() string { return proto.CompactTextString(m) }
+func (*ProfileResp) ProtoMessage()    {}
+func (*ProfileResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{3}
+}
+
+func (m *ProfileResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProfileResp.Unmarshal(m, b)
+}
+func (m *ProfileResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProfileResp.Marshal(b, m, deterministic)
+}
+func (m *ProfileResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileResp.Merge(m, src)
+}
+func (m *ProfileResp) XXX_Size() int {
+	return xxx_messageInfo_ProfileResp.Size(m)
+}
+func (m *ProfileResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileResp proto.InternalMessageInfo
+
+func (m *ProfileResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ProfileResp) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// 节点连接请求
+type PeerReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 节点地址，p2pv1为/ip4/x/tcp/x，p2pv2为/ip4/x/tcp/x/p2p/peerid
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerReq) Reset()         { *m = PeerReq{} }
+func (m *PeerReq) String() string { return proto.CompactTextString(m) }
+func (*PeerReq) ProtoMessage()    {}
+func (*PeerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{4}
+}
+
+func (m *PeerReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerReq.Unmarshal(m, b)
+}
+func (m *PeerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerReq.Marshal(b, m, deterministic)
+}
+func (m *PeerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerReq.Merge(m, src)
+}
+func (m *PeerReq) XXX_Size() int {
+	return xxx_messageInfo_PeerReq.Size(m)
+}
+func (m *PeerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerReq proto.InternalMessageInfo
+
+func (m *PeerReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *PeerReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type PeerResp struct {
+	Header *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 连接后的节点列表
+	Peers                []string `protobuf:"bytes,2,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerResp) Reset()         { *m = PeerResp{} }
+func (m *PeerResp) String() string { return proto.CompactTextString(m) }
+func (*PeerResp) ProtoMessage()    {}
+func (*PeerResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{5}
+}
+
+func (m *PeerResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerResp.Unmarshal(m, b)
+}
+func (m *PeerResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerResp.Marshal(b, m, deterministic)
+}
+func (m *PeerResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerResp.Merge(m, src)
+}
+func (m *PeerResp) XXX_Size() int {
+	return xxx_messageInfo_PeerResp.Size(m)
+}
+func (m *PeerResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerResp proto.InternalMessageInfo
+
+func (m *PeerResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *PeerResp) GetPeers() []string {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type ChainInfo struct {
+	Name   string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status ChainStatus `protobuf:"varint,2,opt,name=status,proto3,enum=xupospb.ChainStatus" json:"status,omitempty"`
+	// 是否为根链
+	Root bool `protobuf:"varint,3,opt,name=root,proto3" json:"root,omitempty"`
+	// 已加载的链的当前高度
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
+func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ChainInfo) ProtoMessage()    {}
+func (*ChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{6}
+}
+
+func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainInfo.Unmarshal(m, b)
+}
+func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainInfo.Marshal(b, m, deterministic)
+}
+func (m *ChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfo.Merge(m, src)
+}
+func (m *ChainInfo) XXX_Size() int {
+	return xxx_messageInfo_ChainInfo.Size(m)
+}
+func (m *ChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainInfo proto.InternalMessageInfo
+
+func (m *ChainInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChainInfo) GetStatus() ChainStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ChainStatus_CHAIN_UNLOADED
+}
+
+func (m *ChainInfo) GetRoot() bool {
+	if m != nil {
+		return m.Root
+	}
+	return false
+}
+
+func (m *ChainInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type ListChainsResp struct {
+	Header               *RespHeader  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Chains               []*ChainInfo `protobuf:"bytes,2,rep,name=chains,proto3" json:"chains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListChainsResp) Reset()         { *m = ListChainsResp{} }
+func (m *ListChainsResp) String() string { return proto.CompactTextString(m) }
+func (*ListChainsResp) ProtoMessage()    {}
+func (*ListChainsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{7}
+}
+
+func (m *ListChainsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChainsResp.Unmarshal(m, b)
+}
+func (m *ListChainsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChainsResp.Marshal(b, m, deterministic)
+}
+func (m *ListChainsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChainsResp.Merge(m, src)
+}
+func (m *ListChainsResp) XXX_Size() int {
+	return xxx_messageInfo_ListChainsResp.Size(m)
+}
+func (m *ListChainsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChainsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChainsResp proto.InternalMessageInfo
+
+func (m *ListChainsResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ListChainsResp) GetChains() []*ChainInfo {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+// 创建平行链请求
+type CreateChainReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Name   string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 创世块配置，json格式
+	Genesis []byte `protobuf:"bytes,3,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	// 创建后立即加载
+	Load                 bool     `protobuf:"varint,4,opt,name=load,proto3" json:"load,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateChainReq) Reset()         { *m = CreateChainReq{} }
+func (m *CreateChainReq) String() string { return proto.CompactTextString(m) }
+func (*CreateChainReq) ProtoMessage()    {}
+func (*CreateChainReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{8}
+}
+
+func (m *CreateChainReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateChainReq.Unmarshal(m, b)
+}
+func (m *CreateChainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateChainReq.Marshal(b, m, deterministic)
+}
+func (m *CreateChainReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateChainReq.Merge(m, src)
+}
+func (m *CreateChainReq) XXX_Size() int {
+	return xxx_messageInfo_CreateChainReq.Size(m)
+}
+func (m *CreateChainReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateChainReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateChainReq proto.InternalMessageInfo
+
+func (m *CreateChainReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CreateChainReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateChainReq) GetGenesis() []byte {
+	if m != nil {
+		return m.Genesis
+	}
+	return nil
+}
+
+func (m *CreateChainReq) GetLoad() bool {
+	if m != nil {
+		return m.Load
+	}
+	return false
+}
+
+// 加载、卸载链请求
+type ChainReq struct {
+	Header               *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ChainReq) Reset()         { *m = ChainReq{} }
+func (m *ChainReq) String() string { return proto.CompactTextString(m) }
+func (*ChainReq) ProtoMessage()    {}
+func (*ChainReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{9}
+}
+
+func (m *ChainReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainReq.Unmarshal(m, b)
+}
+func (m *ChainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainReq.Marshal(b, m, deterministic)
+}
+func (m *ChainReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReq.Merge(m, src)
+}
+func (m *ChainReq) XXX_Size() int {
+	return xxx_messageInfo_ChainReq.Size(m)
+}
+func (m *ChainReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainReq proto.InternalMessageInfo
+
+func (m *ChainReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ChainReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ChainResp struct {
+	Header               *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Chain                *ChainInfo  `protobuf:"bytes,2,opt,name=chain,proto3" json:"chain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ChainResp) Reset()         { *m = ChainResp{} }
+func (m *ChainResp) String() string { return proto.CompactTextString(m) }
+func (*ChainResp) ProtoMessage()    {}
+func (*ChainResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{10}
+}
+
+func (m *ChainResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainResp.Unmarshal(m, b)
+}
+func (m *ChainResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainResp.Marshal(b, m, deterministic)
+}
+func (m *ChainResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainResp.Merge(m, src)
+}
+func (m *ChainResp) XXX_Size() int {
+	return xxx_messageInfo_ChainResp.Size(m)
+}
+func (m *ChainResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainResp proto.InternalMessageInfo
+
+func (m *ChainResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ChainResp) GetChain() *ChainInfo {
+	if m != nil {
+		return m.Chain
+	}
+	return nil
+}
+
+// 排空请求
+type DrainReq struct {
+	Header *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	// 等待处理中请求完成的超时时间，单位：秒
+	Timeout int64 `protobuf:"varint,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 恢复接收交易
+	Resume               bool     `protobuf:"varint,3,opt,name=resume,proto3" json:"resume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainReq) Reset()         { *m = DrainReq{} }
+func (m *DrainReq) String() string { return proto.CompactTextString(m) }
+func (*DrainReq) ProtoMessage()    {}
+func (*DrainReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{11}
+}
+
+func (m *DrainReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainReq.Unmarshal(m, b)
+}
+func (m *DrainReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainReq.Marshal(b, m, deterministic)
+}
+func (m *DrainReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainReq.Merge(m, src)
+}
+func (m *DrainReq) XXX_Size() int {
+	return xxx_messageInfo_DrainReq.Size(m)
+}
+func (m *DrainReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainReq proto.InternalMessageInfo
+
+func (m *DrainReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DrainReq) GetTimeout() int64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *DrainReq) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+type DrainResp struct {
+	Header   *RespHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Draining bool        `protobuf:"varint,2,opt,name=draining,proto3" json:"draining,omitempty"`
+	// 超时后仍在处理中的请求数
+	Inflight             int64    `protobuf:"varint,3,opt,name=inflight,proto3" json:"inflight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainResp) Reset()         { *m = DrainResp{} }
+func (m *DrainResp) String() string { return proto.CompactTextString(m) }
+func (*DrainResp) ProtoMessage()    {}
+func (*DrainResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{12}
+}
+
+func (m *DrainResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainResp.Unmarshal(m, b)
+}
+func (m *DrainResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainResp.Marshal(b, m, deterministic)
+}
+func (m *DrainResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainResp.Merge(m, src)
+}
+func (m *DrainResp) XXX_Size() int {
+	return xxx_messageInfo_DrainResp.Size(m)
+}
+func (m *DrainResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainResp proto.InternalMessageInfo
+
+func (m *DrainResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *DrainResp) GetDraining() bool {
+	if m != nil {
+		return m.Draining
+	}
+	return false
+}
+
+func (m *DrainResp) GetInflight() int64 {
+	if m != nil {
+		return m.Inflight
+	}
+	return 0
+}
+
+// webhook订阅过滤条件，字段含义同BlockFilter
+type WebhookFilter struct {
+	Bcname string `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// 首次推送的区块高度，为空时从最新区块开始
+	Start                string   `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Contract             string   `protobuf:"bytes,10,opt,name=contract,proto3" json:"contract,omitempty"`
+	EventName            string   `protobuf:"bytes,11,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Initiator            string   `protobuf:"bytes,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire          string   `protobuf:"bytes,13,opt,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	FromAddr             string   `protobuf:"bytes,14,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	ToAddr               string   `protobuf:"bytes,15,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhookFilter) Reset()         { *m = WebhookFilter{} }
+func (m *WebhookFilter) String() string { return proto.CompactTextString(m) }
+func (*WebhookFilter) ProtoMessage()    {}
+func (*WebhookFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{13}
+}
+
+func (m *WebhookFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookFilter.Unmarshal(m, b)
+}
+func (m *WebhookFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookFilter.Marshal(b, m, deterministic)
+}
+func (m *WebhookFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookFilter.Merge(m, src)
+}
+func (m *WebhookFilter) XXX_Size() int {
+	return xxx_messageInfo_WebhookFilter.Size(m)
+}
+func (m *WebhookFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookFilter proto.InternalMessageInfo
+
+func (m *WebhookFilter) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *WebhookFilter) GetStart() string {
+	if m != nil {
+		return m.Start
+	}
+	return ""
+}
+
+func (m *WebhookFilter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *WebhookFilter) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *WebhookFilter) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *WebhookFilter) GetAuthRequire() string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return ""
+}
+
+func (m *WebhookFilter) GetFromAddr() string {
+	if m != nil {
+		return m.FromAddr
+	}
+	return ""
+}
+
+func (m *WebhookFilter) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+type Webhook struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Url  string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// HMAC签名密钥，查询时不返回
+	Secret               string         `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	Filter               *WebhookFilter `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{14}
+}
+
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *Webhook) GetFilter() *WebhookFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type WebhookReq struct {
+	Header               *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Webhook              *Webhook   `protobuf:"bytes,2,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WebhookReq) Reset()         { *m = WebhookReq{} }
+func (m *WebhookReq) String() string { return proto.CompactTextString(m) }
+func (*WebhookReq) ProtoMessage()    {}
+func (*WebhookReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{15}
+}
+
+func (m *WebhookReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookReq.Unmarshal(m, b)
+}
+func (m *WebhookReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookReq.Marshal(b, m, deterministic)
+}
+func (m *WebhookReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookReq.Merge(m, src)
+}
+func (m *WebhookReq) XXX_Size() int {
+	return xxx_messageInfo_WebhookReq.Size(m)
+}
+func (m *WebhookReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookReq proto.InternalMessageInfo
+
+func (m *WebhookReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *WebhookReq) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type WebhookNameReq struct {
+	Header               *ReqHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Name                 string     `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WebhookNameReq) Reset()         { *m = WebhookNameReq{} }
+func (m *WebhookNameReq) String() string { return proto.CompactTextString(m) }
+func (*WebhookNameReq) ProtoMessage()    {}
+func (*WebhookNameReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{16}
+}
+
+func (m *WebhookNameReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookNameReq.Unmarshal(m, b)
+}
+func (m *WebhookNameReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookNameReq.Marshal(b, m, deterministic)
+}
+func (m *WebhookNameReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookNameReq.Merge(m, src)
+}
+func (m *WebhookNameReq) XXX_Size() int {
+	return xxx_messageInfo_WebhookNameReq.Size(m)
+}
+func (m *WebhookNameReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookNameReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookNameReq proto.InternalMessageInfo
+
+func (m *WebhookNameReq) GetHeader() *ReqHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *WebhookNameReq) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type WebhookStatus struct {
+	Webhook *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	// 订阅来源：config/admin
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// 最后推送成功的区块高度，未推送过为-1
+	CursorHeight         int64    `protobuf:"varint,3,opt,name=cursor_height,json=cursorHeight,proto3" json:"cursor_height,omitempty"`
+	Delivered            int64    `protobuf:"varint,4,opt,name=delivered,proto3" json:"delivered,omitempty"`
+	Retries              int64    `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
+	LastError            string   `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhookStatus) Reset()         { *m = WebhookStatus{} }
+func (m *WebhookStatus) String() string { return proto.CompactTextString(m) }
+func (*WebhookStatus) ProtoMessage()    {}
+func (*WebhookStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{17}
+}
+
+func (m *WebhookStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookStatus.Unmarshal(m, b)
+}
+func (m *WebhookStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookStatus.Marshal(b, m, deterministic)
+}
+func (m *WebhookStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookStatus.Merge(m, src)
+}
+func (m *WebhookStatus) XXX_Size() int {
+	return xxx_messageInfo_WebhookStatus.Size(m)
+}
+func (m *WebhookStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookStatus proto.InternalMessageInfo
+
+func (m *WebhookStatus) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+func (m *WebhookStatus) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *WebhookStatus) GetCursorHeight() int64 {
+	if m != nil {
+		return m.CursorHeight
+	}
+	return 0
+}
+
+func (m *WebhookStatus) GetDelivered() int64 {
+	if m != nil {
+		return m.Delivered
+	}
+	return 0
+}
+
+func (m *WebhookStatus) GetRetries() int64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *WebhookStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type ListWebhooksResp struct {
+	Header               *RespHeader      `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Webhooks             []*WebhookStatus `protobuf:"bytes,2,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListWebhooksResp) Reset()         { *m = ListWebhooksResp{} }
+func (m *ListWebhooksResp) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksResp) ProtoMessage()    {}
+func (*ListWebhooksResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{18}
+}
+
+func (m *ListWebhooksResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksResp.Unmarshal(m, b)
+}
+func (m *ListWebhooksResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksResp.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksResp.Merge(m, src)
+}
+func (m *ListWebhooksResp) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksResp.Size(m)
+}
+func (m *ListWebhooksResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksResp proto.InternalMessageInfo
+
+func (m *ListWebhooksResp) GetHeader() *RespHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ListWebhooksResp) GetWebhooks() []*WebhookStatus {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("xupospb.ChainStatus", ChainStatus_name, ChainStatus_value)
+	proto.RegisterType((*SetLogLevelReq)(nil), "xupospb.SetLogLevelReq")
+	proto.RegisterType((*LogLevelResp)(nil), "xupospb.LogLevelResp")
+	proto.RegisterMapType((map[string]string)(nil), "xupospb.LogLevelResp.LevelsEntry")
+	proto.RegisterType((*CpuProfileReq)(nil), "xupospb.CpuProfileReq")
+	proto.RegisterType((*ProfileResp)(nil), "xupospb.ProfileResp")
+	proto.RegisterType((*PeerReq)(nil), "xupospb.PeerReq")
+	proto.RegisterType((*PeerResp)(nil), "xupospb.PeerResp")
+	proto.RegisterType((*ChainInfo)(nil), "xupospb.ChainInfo")
+	proto.RegisterType((*ListChainsResp)(nil), "xupospb.ListChainsResp")
+	proto.RegisterType((*CreateChainReq)(nil), "xupospb.CreateChainReq")
+	proto.RegisterType((*ChainReq)(nil), "xupospb.ChainReq")
+	proto.RegisterType((*ChainResp)(nil), "xupospb.ChainResp")
+	proto.RegisterType((*DrainReq)(nil), "xupospb.DrainReq")
+	proto.RegisterType((*DrainResp)(nil), "xupospb.DrainResp")
+	proto.RegisterType((*WebhookFilter)(nil), "xupospb.WebhookFilter")
+	proto.RegisterType((*Webhook)(nil), "xupospb.Webhook")
+	proto.RegisterType((*WebhookReq)(nil), "xupospb.WebhookReq")
+	proto.RegisterType((*WebhookNameReq)(nil), "xupospb.WebhookNameReq")
+	proto.RegisterType((*WebhookStatus)(nil), "xupospb.WebhookStatus")
+	proto.RegisterType((*ListWebhooksResp)(nil), "xupospb.ListWebhooksResp")
+}
+
+func init() { proto.RegisterFile("admin.proto", fileDescriptor_73a7fc70dcc2027c) }
+
+var fileDescriptor_73a7fc70dcc2027c = []byte{
+	// 1095 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xeb, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0xed, 0xfa, 0x76, 0xd6, 0x36, 0xee, 0x34, 0x34, 0x8b, 0x01, 0x29, 0x1d, 0xfe, 0x44,
+	0x01, 0x45, 0xc8, 0x45, 0x94, 0x54, 0x20, 0xe4, 0xc6, 0x21, 0x09, 0x32, 0x69, 0xb4, 0x51, 0xc5,
+	0x4f, 0x6b, 0xed, 0x3d, 0x89, 0x97, 0xae, 0x77, 0x9c, 0x99, 0xd9, 0xb4, 0xf9, 0xc3, 0x3b, 0xf1,
+	0x24, 0x3c, 0x09, 0xe2, 0x15, 0xd0, 0x5c, 0xf6, 0x62, 0x27, 0xa1, 0x72, 0xd4, 0x7f, 0x7b, 0x2e,
+	0xdf, 0xb9, 0xcd, 0x99, 0x73, 0x66, 0xc1, 0xf1, 0x83, 0x79, 0x18, 0xef, 0x2d, 0x38, 0x93, 0x8c,
+	0xd4, 0xdf, 0x27, 0x0b, 0x26, 0x16, 0x93, 0x5e, 0xfb, 0x7d, 0xb2, 0x40, 0xce, 0x84, 0xe1, 0xd3,
+	0x3f, 0xa0, 0x73, 0x8e, 0x72, 0xc4, 0x2e, 0x47, 0x78, 0x8d, 0x91, 0x87, 0x57, 0x64, 0x17, 0x6a,
+	0x33, 0xf4, 0x03, 0xe4, 0x6e, 0x69, 0xbb, 0xb4, 0xe3, 0xf4, 0xc9, 0x9e, 0x85, 0xee, 0x79, 0x78,
+	0x75, 0xac, 0x25, 0x9e, 0xd5, 0x20, 0x4f, 0xa1, 0x36, 0x67, 0x41, 0x12, 0xa1, 0x5b, 0xde, 0x2e,
+	0xed, 0x34, 0x3d, 0x4b, 0x91, 0x4d, 0xa8, 0x46, 0xca, 0x9e, 0x5b, 0xd1, 0x6c, 0x43, 0xd0, 0xbf,
+	0x4a, 0xd0, 0xca, 0x3d, 0x89, 0x05, 0xf9, 0x7a, 0xc5, 0xd5, 0x93, 0x82, 0x2b, 0xb1, 0x58, 0xf1,
+	0xb5, 0x0f, 0x35, 0x6d, 0x46, 0xb8, 0xe5, 0xed, 0xca, 0x8e, 0xd3, 0x7f, 0x96, 0x29, 0x17, 0x6d,
+	0xee, 0xe9, 0x2f, 0x71, 0x18, 0x4b, 0x7e, 0xe3, 0x59, 0x40, 0x6f, 0x1f, 0x9c, 0x02, 0x9b, 0x74,
+	0xa1, 0xf2, 0x16, 0x6f, 0xb4, 0xcf, 0xa6, 0xa7, 0x3e, 0x55, 0xbc, 0xd7, 0x7e, 0x94, 0xa4, 0x69,
+	0x18, 0xe2, 0x65, 0xf9, 0x87, 0x12, 0x7d, 0x03, 0xed, 0x83, 0x45, 0x72, 0xc6, 0xd9, 0x45, 0x18,
+	0xe1, 0xba, 0xe5, 0x71, 0xa1, 0x2e, 0x70, 0xca, 0xe2, 0x40, 0x68, 0xc3, 0x15, 0x2f, 0x25, 0xe9,
+	0x29, 0x38, 0x99, 0xcd, 0x75, 0x0b, 0x41, 0xe0, 0x51, 0xe0, 0x4b, 0x5f, 0x9b, 0x6c, 0x79, 0xfa,
+	0x9b, 0xbe, 0x86, 0xfa, 0x19, 0x22, 0x7f, 0x40, 0x80, 0x7e, 0x10, 0x70, 0x14, 0xc2, 0x66, 0x9e,
+	0x92, 0xf4, 0x37, 0x68, 0x18, 0x83, 0xeb, 0x46, 0xb7, 0x09, 0xd5, 0x05, 0x22, 0x37, 0xa7, 0xd4,
+	0xf4, 0x0c, 0x41, 0x6f, 0xa0, 0x79, 0x30, 0xf3, 0xc3, 0xf8, 0x24, 0xbe, 0x60, 0x2a, 0x81, 0xd8,
+	0x9f, 0xa3, 0x3d, 0x00, 0xfd, 0x4d, 0xbe, 0x81, 0x9a, 0x90, 0xbe, 0x4c, 0x4c, 0x20, 0x9d, 0xfe,
+	0x66, 0xe6, 0x43, 0xe3, 0xce, 0xb5, 0xcc, 0xb3, 0x3a, 0xca, 0x02, 0x67, 0x4c, 0xea, 0xf6, 0x6a,
+	0x78, 0xfa, 0x5b, 0xf5, 0xe2, 0x0c, 0xc3, 0xcb, 0x99, 0x74, 0x1f, 0xe9, 0x5a, 0x5b, 0x8a, 0x86,
+	0xd0, 0x19, 0x85, 0x42, 0x6a, 0x33, 0x62, 0xfd, 0x7c, 0x76, 0xa1, 0x36, 0xd5, 0x50, 0xdb, 0x76,
+	0x64, 0x39, 0x30, 0x95, 0x90, 0x67, 0x35, 0xe8, 0x9f, 0xd0, 0x39, 0xe0, 0xe8, 0x4b, 0xd4, 0xa2,
+	0x75, 0x0f, 0x23, 0x2d, 0x4b, 0xb9, 0x50, 0x16, 0x17, 0xea, 0x97, 0x18, 0xa3, 0x08, 0x85, 0xce,
+	0xb5, 0xe5, 0xa5, 0xa4, 0xd2, 0x8e, 0x98, 0x1f, 0xe8, 0x64, 0x1b, 0x9e, 0xfe, 0xa6, 0xbf, 0x42,
+	0xe3, 0x63, 0x79, 0xa6, 0x13, 0x7b, 0x62, 0xeb, 0x57, 0x6c, 0x07, 0xaa, 0xba, 0x1e, 0x6e, 0x79,
+	0xc5, 0x71, 0x5e, 0x30, 0xa3, 0x40, 0x67, 0xd0, 0x18, 0xf2, 0x07, 0xc4, 0xeb, 0x42, 0x5d, 0x86,
+	0x73, 0x64, 0x89, 0x4c, 0xef, 0x95, 0x25, 0x55, 0x13, 0x70, 0x14, 0xc9, 0x1c, 0x6d, 0x6b, 0x58,
+	0x8a, 0x2e, 0xa0, 0x39, 0xe4, 0x0f, 0xca, 0xa6, 0x07, 0x8d, 0x40, 0x21, 0xc3, 0xf8, 0x52, 0x3b,
+	0x6b, 0x78, 0x19, 0xad, 0x64, 0x61, 0x7c, 0x11, 0xe9, 0xa6, 0xab, 0xe8, 0x40, 0x32, 0x9a, 0xfe,
+	0x5b, 0x82, 0xf6, 0xef, 0x38, 0x99, 0x31, 0xf6, 0xf6, 0x97, 0x30, 0x92, 0x66, 0x58, 0x4e, 0xa6,
+	0x85, 0xc6, 0xb7, 0x94, 0xba, 0x31, 0x42, 0xfa, 0x5c, 0xa6, 0xc3, 0x47, 0x13, 0xca, 0xf6, 0x94,
+	0xc5, 0x92, 0xfb, 0x53, 0xe9, 0x82, 0x16, 0x64, 0x34, 0xf9, 0x12, 0x00, 0xaf, 0x31, 0x96, 0x63,
+	0x6d, 0xcd, 0xd1, 0xd2, 0xa6, 0xe6, 0x9c, 0x2a, 0x83, 0x5f, 0x40, 0x33, 0x8c, 0x43, 0x19, 0xfa,
+	0x92, 0x71, 0xb7, 0x65, 0xa4, 0x19, 0x83, 0x3c, 0x83, 0x96, 0x9f, 0xc8, 0xd9, 0x98, 0xe3, 0x55,
+	0x12, 0x72, 0x74, 0xdb, 0x5a, 0xc1, 0x51, 0x3c, 0xcf, 0xb0, 0xc8, 0xe7, 0xd0, 0xbc, 0xe0, 0x6c,
+	0x3e, 0x56, 0xc3, 0xc0, 0xed, 0x18, 0xe7, 0x8a, 0x31, 0x08, 0x02, 0x4e, 0xb6, 0xa0, 0x2e, 0x99,
+	0x11, 0x7d, 0x62, 0xf2, 0x90, 0x4c, 0x09, 0xe8, 0x3b, 0xa8, 0xdb, 0x84, 0xef, 0xbc, 0xe1, 0x5d,
+	0xa8, 0x24, 0x3c, 0xb2, 0x49, 0xaa, 0x4f, 0x55, 0x10, 0x81, 0x53, 0x8e, 0xd2, 0xae, 0x09, 0x4b,
+	0x91, 0x3d, 0xa8, 0x5d, 0xe8, 0x92, 0xe9, 0xe6, 0x76, 0xfa, 0x4f, 0xb3, 0xf3, 0x59, 0x2a, 0xa8,
+	0x67, 0xb5, 0x68, 0x00, 0x60, 0x05, 0xeb, 0x36, 0xd2, 0x2e, 0xd4, 0xdf, 0x19, 0xa4, 0x6d, 0xd6,
+	0xee, 0xaa, 0x2b, 0x2f, 0x55, 0xa0, 0x67, 0xd0, 0xb1, 0x3c, 0x55, 0xe4, 0x8f, 0x71, 0xc5, 0xfe,
+	0xce, 0x5b, 0xc4, 0xcc, 0xb7, 0x62, 0x3c, 0xa5, 0x0f, 0xc4, 0xa3, 0xab, 0xc7, 0x12, 0x3e, 0xcd,
+	0x76, 0xaf, 0xa1, 0xc8, 0x57, 0xd0, 0x9e, 0x26, 0x5c, 0x30, 0x3e, 0xb6, 0xe3, 0xd0, 0x74, 0x66,
+	0xcb, 0x30, 0x8f, 0x35, 0x4f, 0xb5, 0x48, 0x80, 0x51, 0x78, 0x8d, 0x1c, 0x03, 0x3b, 0x2f, 0x73,
+	0x86, 0xba, 0x5f, 0x1c, 0x25, 0x0f, 0x51, 0xb8, 0x55, 0x73, 0xbf, 0x2c, 0xa9, 0x3a, 0x2f, 0xf2,
+	0x85, 0x1c, 0x23, 0xe7, 0x8c, 0xbb, 0x35, 0xd3, 0x5b, 0x8a, 0x73, 0xa8, 0x18, 0x54, 0x40, 0x57,
+	0xcd, 0x5a, 0x1b, 0xeb, 0x03, 0xa6, 0x6d, 0x1f, 0x1a, 0x36, 0xbf, 0x74, 0xde, 0xde, 0x3a, 0x7c,
+	0xbb, 0x0a, 0x32, 0xbd, 0xdd, 0x23, 0x70, 0x0a, 0x3b, 0x82, 0x10, 0xe8, 0x1c, 0x1c, 0x0f, 0x4e,
+	0x4e, 0xc7, 0x6f, 0x4e, 0x47, 0xaf, 0x07, 0xc3, 0xc3, 0x61, 0x77, 0x83, 0x74, 0xa1, 0x65, 0x78,
+	0x96, 0x53, 0xca, 0xb5, 0x86, 0x27, 0xe7, 0x83, 0x57, 0xa3, 0xc3, 0x61, 0xb7, 0xdc, 0xff, 0xa7,
+	0x06, 0xd5, 0x81, 0x7a, 0x33, 0x91, 0x9f, 0xc1, 0x29, 0xbc, 0x8a, 0xc8, 0x56, 0x16, 0xc3, 0xf2,
+	0x5b, 0xa9, 0xf7, 0xe9, 0x9d, 0x6f, 0x10, 0xba, 0x41, 0xf6, 0xa1, 0x75, 0x94, 0xab, 0x0a, 0x92,
+	0x9f, 0xe3, 0x2b, 0x5f, 0xe0, 0xff, 0x42, 0x5f, 0x42, 0x67, 0x98, 0xcc, 0x17, 0x47, 0x8c, 0xb3,
+	0x44, 0x86, 0x31, 0xde, 0x05, 0xce, 0xb7, 0x63, 0xe1, 0x15, 0x41, 0x37, 0xc8, 0x0b, 0x70, 0x8e,
+	0xd1, 0x5f, 0x58, 0xe6, 0x1a, 0xc0, 0x1f, 0x01, 0xf2, 0x67, 0x0e, 0xc9, 0x6b, 0xbe, 0xf4, 0xf6,
+	0xb9, 0x17, 0xfd, 0x2d, 0xd4, 0x07, 0x41, 0xa0, 0xde, 0x0b, 0x05, 0x97, 0xf6, 0x3d, 0xd2, 0x7b,
+	0xbc, 0xc2, 0xd1, 0x88, 0xe7, 0x00, 0x1e, 0xce, 0xd9, 0x35, 0xae, 0x03, 0xda, 0x07, 0xc8, 0x37,
+	0xf9, 0x1d, 0xc9, 0xe5, 0xc7, 0xb4, 0xbc, 0xf0, 0x75, 0x7e, 0x4e, 0x61, 0x33, 0x17, 0x0e, 0x74,
+	0x79, 0x5f, 0xf7, 0x56, 0x96, 0x95, 0x45, 0x7f, 0x07, 0xcd, 0x11, 0xf3, 0x03, 0x83, 0x7d, 0xbc,
+	0xaa, 0x72, 0x1f, 0xea, 0x05, 0xb4, 0x86, 0xa1, 0xf0, 0x27, 0x11, 0xae, 0x09, 0xfc, 0x1e, 0x60,
+	0x10, 0x04, 0xe9, 0x2c, 0x7d, 0x72, 0x6b, 0x04, 0x2c, 0xd5, 0xc7, 0x24, 0xaf, 0x71, 0x3f, 0x41,
+	0xdb, 0x14, 0x35, 0x85, 0x6e, 0xad, 0x42, 0xed, 0xe4, 0xba, 0x0f, 0xde, 0x2a, 0x5e, 0xde, 0x3b,
+	0x0a, 0xfc, 0xd9, 0x52, 0x81, 0x8b, 0xb7, 0x5c, 0x37, 0x41, 0x75, 0xc8, 0x97, 0xf3, 0x1c, 0xf2,
+	0x5b, 0x79, 0x66, 0x5b, 0x98, 0x6e, 0x4c, 0x6a, 0xfa, 0x17, 0xe4, 0xf9, 0x7f, 0x03, 0x00, 0x85,
+	0xb8, 0x87, 0x9e, 0xa9, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AdminClient interface {
+	// 运行时调整日志级别
+	SetLogLevel(ctx context.Context, in *SetLogLevelReq, opts ...grpc.CallOption) (*LogLevelResp, error)
+	// 查询运行时日志级别
+	GetLogLevels(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*LogLevelResp, error)
+	// 导出全部goroutine栈
+	DumpGoroutines(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ProfileResp, error)
+	// 导出heap profile
+	HeapProfile(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ProfileResp, error)
+	// 采样CPU profile，阻塞到采样结束
+	CpuProfile(ctx context.Context, in *CpuProfileReq, opts ...grpc.CallOption) (*ProfileResp, error)
+	// 连接P2P节点
+	AddPeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*PeerResp, error)
+	// 断开P2P节点，内核网络层不支持主动断开连接，固定返回Unimplemented，
+	// 需要从network.yaml的bootNodes中删除节点后重启
+	RemovePeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*PeerResp, error)
+	// 查询数据目录下全部链及其状态
+	ListChains(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ListChainsResp, error)
+	// 根据创世块配置创建平行链
+	CreateChain(ctx context.Context, in *CreateChainReq, opts ...grpc.CallOption) (*ChainResp, error)
+	// 加载数据目录下未加载的链
+	LoadChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*ChainResp, error)
+	// 停用链，停止矿工并对各服务屏蔽。内核不支持移除链，存储和p2p消息处理仍在运行，
+	// 重启节点后才能再次加载
+	DisableChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*ChainResp, error)
+	// 添加webhook订阅
+	AddWebhook(ctx context.Context, in *WebhookReq, opts ...grpc.CallOption) (*BaseResp, error)
+	// 删除admin接口添加的webhook订阅
+	RemoveWebhook(ctx context.Context, in *WebhookNameReq, opts ...grpc.CallOption) (*BaseResp, error)
+	// 查询webhook订阅及推送状态
+	ListWebhooks(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ListWebhooksResp, error)
+	// 停止接收交易并等待处理中的请求完成
+	Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) SetLogLevel(ctx context.Context, in *SetLogLevelReq, opts ...grpc.CallOption) (*LogLevelResp, error) {
+	out := new(LogLevelResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetLogLevels(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*LogLevelResp, error) {
+	out := new(LogLevelResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/GetLogLevels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DumpGoroutines(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ProfileResp, error) {
+	out := new(ProfileResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/DumpGoroutines", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) HeapProfile(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ProfileResp, error) {
+	out := new(ProfileResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/HeapProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CpuProfile(ctx context.Context, in *CpuProfileReq, opts ...grpc.CallOption) (*ProfileResp, error) {
+	out := new(ProfileResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/CpuProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddPeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*PeerResp, error) {
+	out := new(PeerResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/AddPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RemovePeer(ctx context.Context, in *PeerReq, opts ...grpc.CallOption) (*PeerResp, error) {
+	out := new(PeerResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/RemovePeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListChains(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ListChainsResp, error) {
+	out := new(ListChainsResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/ListChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CreateChain(ctx context.Context, in *CreateChainReq, opts ...grpc.CallOption) (*ChainResp, error) {
+	out := new(ChainResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/CreateChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) LoadChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*ChainResp, error) {
+	out := new(ChainResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/LoadChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DisableChain(ctx context.Context, in *ChainReq, opts ...grpc.CallOption) (*ChainResp, error) {
+	out := new(ChainResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/DisableChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddWebhook(ctx context.Context, in *WebhookReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/AddWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RemoveWebhook(ctx context.Context, in *WebhookNameReq, opts ...grpc.CallOption) (*BaseResp, error) {
+	out := new(BaseResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/RemoveWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListWebhooks(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*ListWebhooksResp, error) {
+	out := new(ListWebhooksResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) Drain(ctx context.Context, in *DrainReq, opts ...grpc.CallOption) (*DrainResp, error) {
+	out := new(DrainResp)
+	err := c.cc.Invoke(ctx, "/xupospb.Admin/Drain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+type AdminServer interface {
+	// 运行时调整日志级别
+	SetLogLevel(context.Context, *SetLogLevelReq) (*LogLevelResp, error)
+	// 查询运行时日志级别
+	GetLogLevels(context.Context, *BaseReq) (*LogLevelResp, error)
+	// 导出全部goroutine栈
+	DumpGoroutines(context.Context, *BaseReq) (*ProfileResp, error)
+	// 导出heap profile
+	HeapProfile(context.Context, *BaseReq) (*ProfileResp, error)
+	// 采样CPU profile，阻塞到采样结束
+	CpuProfile(context.Context, *CpuProfileReq) (*ProfileResp, error)
+	// 连接P2P节点
+	AddPeer(context.Context, *PeerReq) (*PeerResp, error)
+	// 断开P2P节点，内核网络层不支持主动断开连接，固定返回Unimplemented，
+	// 需要从network.yaml的bootNodes中删除节点后重启
+	RemovePeer(context.Context, *PeerReq) (*PeerResp, error)
+	// 查询数据目录下全部链及其状态
+	ListChains(context.Context, *BaseReq) (*ListChainsResp, error)
+	// 根据创世块配置创建平行链
+	CreateChain(context.Context, *CreateChainReq) (*ChainResp, error)
+	// 加载数据目录下未加载的链
+	LoadChain(context.Context, *ChainReq) (*ChainResp, error)
+	// 停用链，停止矿工并对各服务屏蔽。内核不支持移除链，存储和p2p消息处理仍在运行，
+	// 重启节点后才能再次加载
+	DisableChain(context.Context, *ChainReq) (*ChainResp, error)
+	// 添加webhook订阅
+	AddWebhook(context.Context, *WebhookReq) (*BaseResp, error)
+	// 删除admin接口添加的webhook订阅
+	RemoveWebhook(context.Context, *WebhookNameReq) (*BaseResp, error)
+	// 查询webhook订阅及推送状态
+	ListWebhooks(context.Context, *BaseReq) (*ListWebhooksResp, error)
+	// 停止接收交易并等待处理中的请求完成
+	Drain(context.Context, *DrainReq) (*DrainResp, error)
+}
+
+// UnimplementedAdminServer can be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (*UnimplementedAdminServer) SetLogLevel(ctx context.Context, req *SetLogLevelReq) (*LogLevelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedAdminServer) GetLogLevels(ctx context.Context, req *BaseReq) (*LogLevelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogLevels not implemented")
+}
+func (*UnimplementedAdminServer) DumpGoroutines(ctx context.Context, req *BaseReq) (*ProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DumpGoroutines not implemented")
+}
+func (*UnimplementedAdminServer) HeapProfile(ctx context.Context, req *BaseReq) (*ProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeapProfile not implemented")
+}
+func (*UnimplementedAdminServer) CpuProfile(ctx context.Context, req *CpuProfileReq) (*ProfileResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CpuProfile not implemented")
+}
+func (*UnimplementedAdminServer) AddPeer(ctx context.Context, req *PeerReq) (*PeerResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeer not implemented")
+}
+func (*UnimplementedAdminServer) RemovePeer(ctx context.Context, req *PeerReq) (*PeerResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeer not implemented")
+}
+func (*UnimplementedAdminServer) ListChains(ctx context.Context, req *BaseReq) (*ListChainsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChains not implemented")
+}
+func (*UnimplementedAdminServer) CreateChain(ctx context.Context, req *CreateChainReq) (*ChainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChain not implemented")
+}
+func (*UnimplementedAdminServer) LoadChain(ctx context.Context, req *ChainReq) (*ChainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadChain not implemented")
+}
+func (*UnimplementedAdminServer) DisableChain(ctx context.Context, req *ChainReq) (*ChainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableChain not implemented")
+}
+func (*UnimplementedAdminServer) AddWebhook(ctx context.Context, req *WebhookReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
+}
+func (*UnimplementedAdminServer) RemoveWebhook(ctx context.Context, req *WebhookNameReq) (*BaseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (*UnimplementedAdminServer) ListWebhooks(ctx context.Context, req *BaseReq) (*ListWebhooksResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (*UnimplementedAdminServer) Drain(ctx context.Context, req *DrainReq) (*DrainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Drain not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/SetLogLevel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetLogLevel(ctx, req.(*SetLogLevelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetLogLevels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetLogLevels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/GetLogLevels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetLogLevels(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DumpGoroutines_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DumpGoroutines(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/DumpGoroutines",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DumpGoroutines(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_HeapProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).HeapProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/HeapProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).HeapProfile(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CpuProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CpuProfileReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CpuProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/CpuProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CpuProfile(ctx, req.(*CpuProfileReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/AddPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddPeer(ctx, req.(*PeerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RemovePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PeerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RemovePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/RemovePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RemovePeer(ctx, req.(*PeerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/ListChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListChains(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CreateChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CreateChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/CreateChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CreateChain(ctx, req.(*CreateChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_LoadChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).LoadChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/LoadChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).LoadChain(ctx, req.(*ChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DisableChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DisableChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/DisableChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DisableChain(ctx, req.(*ChainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/AddWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddWebhook(ctx, req.(*WebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookNameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RemoveWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/RemoveWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RemoveWebhook(ctx, req.(*WebhookNameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/ListWebhooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListWebhooks(ctx, req.(*BaseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/xupospb.Admin/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Drain(ctx, req.(*DrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Admin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "xupospb.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Admin_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetLogLevels",
+			Handler:    _Admin_GetLogLevels_Handler,
+		},
+		{
+			MethodName: "DumpGoroutines",
+			Handler:    _Admin_DumpGoroutines_Handler,
+		},
+		{
+			MethodName: "HeapProfile",
+			Handler:    _Admin_HeapProfile_Handler,
+		},
+		{
+			MethodName: "CpuProfile",
+			Handler:    _Admin_CpuProfile_Handler,
+		},
+		{
+			MethodName: "AddPeer",
+			Handler:    _Admin_AddPeer_Handler,
+		},
+		{
+			MethodName: "RemovePeer",
+			Handler:    _Admin_RemovePeer_Handler,
+		},
+		{
+			MethodName: "ListChains",
+			Handler:    _Admin_ListChains_Handler,
+		},
+		{
+			MethodName: "CreateChain",
+			Handler:    _Admin_CreateChain_Handler,
+		},
+		{
+			MethodName: "LoadChain",
+			Handler:    _Admin_LoadChain_Handler,
+		},
+		{
+			MethodName: "DisableChain",
+			Handler:    _Admin_DisableChain_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _Admin_AddWebhook_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _Admin_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _Admin_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _Admin_Drain_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
@@ -5,4 +5,8 @@
 # go install github.com/golang/protobuf/protoc-gen-go
 # go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway
 
-protoc -I ./ -I ../../../ --go_opt=paths=source_relative --go_out=plugins=grpc:./ ./xuperos.proto
+protoc -I ./ -I ../../../ -I ./pb/googleapis \
+    --go_opt=paths=source_relative \
+    --go_out=plugins=grpc:./ \
+    --grpc-gateway_out=logtostderr=true,paths=source_relative:./ \
+    ./xuperos.proto ./admin.proto
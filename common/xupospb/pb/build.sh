@@ -4,9 +4,20 @@
 # export GO111MODULES=on
 # go install github.com/golang/protobuf/protoc-gen-go
 # go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway
+# go install github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger
 
 protoc -I ./ ./*.proto \
     -I ./googleapis \
     --go_opt=paths=source_relative \
     --go_out=plugins=grpc:./ \
     --grpc-gateway_out=logtostderr=true:./
+
+# openapi of the gateway http bindings, embedded into the gateway by go generate
+protoc -I ./ -I ./googleapis \
+    --swagger_out=logtostderr=true,allow_merge=true,merge_file_name=xchain:./ \
+    ./xchain.proto ./xendorser.proto
+# 不同package的proto不能合并，原生XuperOS服务的文档单独生成，go generate时合并
+protoc -I ../ -I ./googleapis \
+    --swagger_out=logtostderr=true,allow_merge=true,merge_file_name=xuperos:../ \
+    ../xuperos.proto
+(cd ../../../service/adapter/gateway && go generate)
@@ -29,14 +29,26 @@ type SubscribeType int32
 const (
 	// 区块事件，payload为BlockFilter
 	SubscribeType_BLOCK SubscribeType = 0
+	// 单笔交易及其合约事件，filter为TxFilter，payload为TxEvent
+	SubscribeType_TRANSACTION SubscribeType = 1
+	// 账户余额变动，filter为AccountFilter，payload为AccountEvent
+	SubscribeType_ACCOUNT SubscribeType = 2
+	// 交易进入交易池，filter为PendingTxFilter，payload为PendingTxEvent
+	SubscribeType_PENDING_TX SubscribeType = 3
 )
 
 var SubscribeType_name = map[int32]string{
 	0: "BLOCK",
+	1: "TRANSACTION",
+	2: "ACCOUNT",
+	3: "PENDING_TX",
 }
 
 var SubscribeType_value = map[string]int32{
-	"BLOCK": 0,
+	"BLOCK":       0,
+	"TRANSACTION": 1,
+	"ACCOUNT":     2,
+	"PENDING_TX":  3,
 }
 
 func (x SubscribeType) String() string {
@@ -48,11 +60,13 @@ func (SubscribeType) EnumDescriptor() ([]byte, []int) {
 }
 
 type SubscribeRequest struct {
-	Type                 SubscribeType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.SubscribeType" json:"type,omitempty"`
-	Filter               []byte        `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Type   SubscribeType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.SubscribeType" json:"type,omitempty"`
+	Filter []byte        `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// 断点续订，只推送游标大于start_cursor的事件
+	StartCursor          *EventCursor `protobuf:"bytes,3,opt,name=start_cursor,json=startCursor,proto3" json:"start_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *SubscribeRequest) Reset()         { *m = SubscribeRequest{} }
@@ -94,8 +108,75 @@ func (m *SubscribeRequest) GetFilter() []byte {
 	return nil
 }
 
+func (m *SubscribeRequest) GetStartCursor() *EventCursor {
+	if m != nil {
+		return m.StartCursor
+	}
+	return nil
+}
+
+// 事件游标，按区块高度、交易序号、事件序号单调递增
+type EventCursor struct {
+	BlockHeight          int64    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	TxIndex              int32    `protobuf:"varint,2,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	EventIndex           int32    `protobuf:"varint,3,opt,name=event_index,json=eventIndex,proto3" json:"event_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventCursor) Reset()         { *m = EventCursor{} }
+func (m *EventCursor) String() string { return proto.CompactTextString(m) }
+func (*EventCursor) ProtoMessage()    {}
+func (*EventCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{1}
+}
+
+func (m *EventCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventCursor.Unmarshal(m, b)
+}
+func (m *EventCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventCursor.Marshal(b, m, deterministic)
+}
+func (m *EventCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCursor.Merge(m, src)
+}
+func (m *EventCursor) XXX_Size() int {
+	return xxx_messageInfo_EventCursor.Size(m)
+}
+func (m *EventCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCursor proto.InternalMessageInfo
+
+func (m *EventCursor) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *EventCursor) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EventCursor) GetEventIndex() int32 {
+	if m != nil {
+		return m.EventIndex
+	}
+	return 0
+}
+
 type Event struct {
-	Payload              []byte   `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// 交易池事件没有游标
+	Cursor *EventCursor `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 空闲时的心跳事件，payload为空，cursor为最后推送的事件游标
+	Heartbeat            bool     `protobuf:"varint,3,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -105,7 +186,7 @@ func (m *Event) Reset()         { *m = Event{} }
 func (m *Event) String() string { return proto.CompactTextString(m) }
 func (*Event) ProtoMessage()    {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{1}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{2}
 }
 
 func (m *Event) XXX_Unmarshal(b []byte) error {
@@ -133,6 +214,20 @@ func (m *Event) GetPayload() []byte {
 	return nil
 }
 
+func (m *Event) GetCursor() *EventCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *Event) GetHeartbeat() bool {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return false
+}
+
 type BlockRange struct {
 	Start                string   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
 	End                  string   `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
@@ -145,7 +240,7 @@ func (m *BlockRange) Reset()         { *m = BlockRange{} }
 func (m *BlockRange) String() string { return proto.CompactTextString(m) }
 func (*BlockRange) ProtoMessage()    {}
 func (*BlockRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{2}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{3}
 }
 
 func (m *BlockRange) XXX_Unmarshal(b []byte) error {
@@ -200,7 +295,7 @@ func (m *BlockFilter) Reset()         { *m = BlockFilter{} }
 func (m *BlockFilter) String() string { return proto.CompactTextString(m) }
 func (*BlockFilter) ProtoMessage()    {}
 func (*BlockFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{3}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{4}
 }
 
 func (m *BlockFilter) XXX_Unmarshal(b []byte) error {
@@ -303,7 +398,7 @@ func (m *FilteredTransaction) Reset()         { *m = FilteredTransaction{} }
 func (m *FilteredTransaction) String() string { return proto.CompactTextString(m) }
 func (*FilteredTransaction) ProtoMessage()    {}
 func (*FilteredTransaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{4}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{5}
 }
 
 func (m *FilteredTransaction) XXX_Unmarshal(b []byte) error {
@@ -352,7 +447,7 @@ func (m *FilteredBlock) Reset()         { *m = FilteredBlock{} }
 func (m *FilteredBlock) String() string { return proto.CompactTextString(m) }
 func (*FilteredBlock) ProtoMessage()    {}
 func (*FilteredBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d17a9d3f0ddf27e, []int{5}
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{6}
 }
 
 func (m *FilteredBlock) XXX_Unmarshal(b []byte) error {
@@ -401,52 +496,667 @@ func (m *FilteredBlock) GetTxs() []*FilteredTransaction {
 	return nil
 }
 
+type TxFilter struct {
+	Bcname string      `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Range  *BlockRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	// 指定交易id时只推送该交易，交易上链后结束订阅
+	Txid                 string   `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	ExcludeTxEvent       bool     `protobuf:"varint,4,opt,name=exclude_tx_event,json=excludeTxEvent,proto3" json:"exclude_tx_event,omitempty"`
+	Contract             string   `protobuf:"bytes,10,opt,name=contract,proto3" json:"contract,omitempty"`
+	EventName            string   `protobuf:"bytes,11,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Initiator            string   `protobuf:"bytes,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire          string   `protobuf:"bytes,13,opt,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	FromAddr             string   `protobuf:"bytes,14,opt,name=from_addr,json=fromAddr,proto3" json:"from_addr,omitempty"`
+	ToAddr               string   `protobuf:"bytes,15,opt,name=to_addr,json=toAddr,proto3" json:"to_addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxFilter) Reset()         { *m = TxFilter{} }
+func (m *TxFilter) String() string { return proto.CompactTextString(m) }
+func (*TxFilter) ProtoMessage()    {}
+func (*TxFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{7}
+}
+
+func (m *TxFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxFilter.Unmarshal(m, b)
+}
+func (m *TxFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxFilter.Marshal(b, m, deterministic)
+}
+func (m *TxFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFilter.Merge(m, src)
+}
+func (m *TxFilter) XXX_Size() int {
+	return xxx_messageInfo_TxFilter.Size(m)
+}
+func (m *TxFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFilter proto.InternalMessageInfo
+
+func (m *TxFilter) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxFilter) GetRange() *BlockRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *TxFilter) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *TxFilter) GetExcludeTxEvent() bool {
+	if m != nil {
+		return m.ExcludeTxEvent
+	}
+	return false
+}
+
+func (m *TxFilter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *TxFilter) GetEventName() string {
+	if m != nil {
+		return m.EventName
+	}
+	return ""
+}
+
+func (m *TxFilter) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *TxFilter) GetAuthRequire() string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return ""
+}
+
+func (m *TxFilter) GetFromAddr() string {
+	if m != nil {
+		return m.FromAddr
+	}
+	return ""
+}
+
+func (m *TxFilter) GetToAddr() string {
+	if m != nil {
+		return m.ToAddr
+	}
+	return ""
+}
+
+type TxEvent struct {
+	Bcname               string           `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blockid              string           `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	BlockHeight          int64            `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Txid                 string           `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	Events               []*ContractEvent `protobuf:"bytes,5,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TxEvent) Reset()         { *m = TxEvent{} }
+func (m *TxEvent) String() string { return proto.CompactTextString(m) }
+func (*TxEvent) ProtoMessage()    {}
+func (*TxEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{8}
+}
+
+func (m *TxEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxEvent.Unmarshal(m, b)
+}
+func (m *TxEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxEvent.Marshal(b, m, deterministic)
+}
+func (m *TxEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxEvent.Merge(m, src)
+}
+func (m *TxEvent) XXX_Size() int {
+	return xxx_messageInfo_TxEvent.Size(m)
+}
+func (m *TxEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxEvent proto.InternalMessageInfo
+
+func (m *TxEvent) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *TxEvent) GetBlockid() string {
+	if m != nil {
+		return m.Blockid
+	}
+	return ""
+}
+
+func (m *TxEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *TxEvent) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *TxEvent) GetEvents() []*ContractEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type AccountFilter struct {
+	Bcname string      `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Range  *BlockRange `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	// 关注的地址或合约账户，必填
+	Addresses            []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountFilter) Reset()         { *m = AccountFilter{} }
+func (m *AccountFilter) String() string { return proto.CompactTextString(m) }
+func (*AccountFilter) ProtoMessage()    {}
+func (*AccountFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{9}
+}
+
+func (m *AccountFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountFilter.Unmarshal(m, b)
+}
+func (m *AccountFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountFilter.Marshal(b, m, deterministic)
+}
+func (m *AccountFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFilter.Merge(m, src)
+}
+func (m *AccountFilter) XXX_Size() int {
+	return xxx_messageInfo_AccountFilter.Size(m)
+}
+func (m *AccountFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountFilter proto.InternalMessageInfo
+
+func (m *AccountFilter) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *AccountFilter) GetRange() *BlockRange {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *AccountFilter) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+// utxo变动，debit时为被花费的utxo，credit时为新产生的utxo
+type UtxoChange struct {
+	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Offset               int32    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Amount               string   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UtxoChange) Reset()         { *m = UtxoChange{} }
+func (m *UtxoChange) String() string { return proto.CompactTextString(m) }
+func (*UtxoChange) ProtoMessage()    {}
+func (*UtxoChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{10}
+}
+
+func (m *UtxoChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UtxoChange.Unmarshal(m, b)
+}
+func (m *UtxoChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UtxoChange.Marshal(b, m, deterministic)
+}
+func (m *UtxoChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtxoChange.Merge(m, src)
+}
+func (m *UtxoChange) XXX_Size() int {
+	return xxx_messageInfo_UtxoChange.Size(m)
+}
+func (m *UtxoChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtxoChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtxoChange proto.InternalMessageInfo
+
+func (m *UtxoChange) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *UtxoChange) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *UtxoChange) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type AccountEvent struct {
+	Bcname      string        `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Blockid     string        `protobuf:"bytes,2,opt,name=blockid,proto3" json:"blockid,omitempty"`
+	BlockHeight int64         `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Txid        string        `protobuf:"bytes,4,opt,name=txid,proto3" json:"txid,omitempty"`
+	Address     string        `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Debits      []*UtxoChange `protobuf:"bytes,6,rep,name=debits,proto3" json:"debits,omitempty"`
+	Credits     []*UtxoChange `protobuf:"bytes,7,rep,name=credits,proto3" json:"credits,omitempty"`
+	// 余额变化量，credits总额减debits总额
+	Delta                string   `protobuf:"bytes,8,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AccountEvent) Reset()         { *m = AccountEvent{} }
+func (m *AccountEvent) String() string { return proto.CompactTextString(m) }
+func (*AccountEvent) ProtoMessage()    {}
+func (*AccountEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{11}
+}
+
+func (m *AccountEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccountEvent.Unmarshal(m, b)
+}
+func (m *AccountEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccountEvent.Marshal(b, m, deterministic)
+}
+func (m *AccountEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountEvent.Merge(m, src)
+}
+func (m *AccountEvent) XXX_Size() int {
+	return xxx_messageInfo_AccountEvent.Size(m)
+}
+func (m *AccountEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountEvent proto.InternalMessageInfo
+
+func (m *AccountEvent) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *AccountEvent) GetBlockid() string {
+	if m != nil {
+		return m.Blockid
+	}
+	return ""
+}
+
+func (m *AccountEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *AccountEvent) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *AccountEvent) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountEvent) GetDebits() []*UtxoChange {
+	if m != nil {
+		return m.Debits
+	}
+	return nil
+}
+
+func (m *AccountEvent) GetCredits() []*UtxoChange {
+	if m != nil {
+		return m.Credits
+	}
+	return nil
+}
+
+func (m *AccountEvent) GetDelta() string {
+	if m != nil {
+		return m.Delta
+	}
+	return ""
+}
+
+type PendingTxFilter struct {
+	Bcname               string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Contract             string   `protobuf:"bytes,10,opt,name=contract,proto3" json:"contract,omitempty"`
+	Initiator            string   `protobuf:"bytes,12,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire          string   `protobuf:"bytes,13,opt,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTxFilter) Reset()         { *m = PendingTxFilter{} }
+func (m *PendingTxFilter) String() string { return proto.CompactTextString(m) }
+func (*PendingTxFilter) ProtoMessage()    {}
+func (*PendingTxFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{12}
+}
+
+func (m *PendingTxFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxFilter.Unmarshal(m, b)
+}
+func (m *PendingTxFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxFilter.Marshal(b, m, deterministic)
+}
+func (m *PendingTxFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxFilter.Merge(m, src)
+}
+func (m *PendingTxFilter) XXX_Size() int {
+	return xxx_messageInfo_PendingTxFilter.Size(m)
+}
+func (m *PendingTxFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxFilter proto.InternalMessageInfo
+
+func (m *PendingTxFilter) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *PendingTxFilter) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PendingTxFilter) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *PendingTxFilter) GetAuthRequire() string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return ""
+}
+
+type PendingTxEvent struct {
+	Bcname      string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid        string   `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Initiator   string   `protobuf:"bytes,3,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	AuthRequire []string `protobuf:"bytes,4,rep,name=auth_require,json=authRequire,proto3" json:"auth_require,omitempty"`
+	Contracts   []string `protobuf:"bytes,5,rep,name=contracts,proto3" json:"contracts,omitempty"`
+	// 交易时间戳，单位：纳秒
+	Timestamp            int64    `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingTxEvent) Reset()         { *m = PendingTxEvent{} }
+func (m *PendingTxEvent) String() string { return proto.CompactTextString(m) }
+func (*PendingTxEvent) ProtoMessage()    {}
+func (*PendingTxEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{13}
+}
+
+func (m *PendingTxEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingTxEvent.Unmarshal(m, b)
+}
+func (m *PendingTxEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingTxEvent.Marshal(b, m, deterministic)
+}
+func (m *PendingTxEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTxEvent.Merge(m, src)
+}
+func (m *PendingTxEvent) XXX_Size() int {
+	return xxx_messageInfo_PendingTxEvent.Size(m)
+}
+func (m *PendingTxEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTxEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTxEvent proto.InternalMessageInfo
+
+func (m *PendingTxEvent) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *PendingTxEvent) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+func (m *PendingTxEvent) GetInitiator() string {
+	if m != nil {
+		return m.Initiator
+	}
+	return ""
+}
+
+func (m *PendingTxEvent) GetAuthRequire() []string {
+	if m != nil {
+		return m.AuthRequire
+	}
+	return nil
+}
+
+func (m *PendingTxEvent) GetContracts() []string {
+	if m != nil {
+		return m.Contracts
+	}
+	return nil
+}
+
+func (m *PendingTxEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// 事件导出记录，protobuf格式文件中每条记录前有4字节大端长度
+type ExportRecord struct {
+	Cursor               *EventCursor   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Block                *FilteredBlock `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExportRecord) Reset()         { *m = ExportRecord{} }
+func (m *ExportRecord) String() string { return proto.CompactTextString(m) }
+func (*ExportRecord) ProtoMessage()    {}
+func (*ExportRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d17a9d3f0ddf27e, []int{14}
+}
+
+func (m *ExportRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRecord.Unmarshal(m, b)
+}
+func (m *ExportRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRecord.Marshal(b, m, deterministic)
+}
+func (m *ExportRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRecord.Merge(m, src)
+}
+func (m *ExportRecord) XXX_Size() int {
+	return xxx_messageInfo_ExportRecord.Size(m)
+}
+func (m *ExportRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRecord proto.InternalMessageInfo
+
+func (m *ExportRecord) GetCursor() *EventCursor {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *ExportRecord) GetBlock() *FilteredBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("pb.SubscribeType", SubscribeType_name, SubscribeType_value)
 	proto.RegisterType((*SubscribeRequest)(nil), "pb.SubscribeRequest")
+	proto.RegisterType((*EventCursor)(nil), "pb.EventCursor")
 	proto.RegisterType((*Event)(nil), "pb.Event")
 	proto.RegisterType((*BlockRange)(nil), "pb.BlockRange")
 	proto.RegisterType((*BlockFilter)(nil), "pb.BlockFilter")
 	proto.RegisterType((*FilteredTransaction)(nil), "pb.FilteredTransaction")
 	proto.RegisterType((*FilteredBlock)(nil), "pb.FilteredBlock")
+	proto.RegisterType((*TxFilter)(nil), "pb.TxFilter")
+	proto.RegisterType((*TxEvent)(nil), "pb.TxEvent")
+	proto.RegisterType((*AccountFilter)(nil), "pb.AccountFilter")
+	proto.RegisterType((*UtxoChange)(nil), "pb.UtxoChange")
+	proto.RegisterType((*AccountEvent)(nil), "pb.AccountEvent")
+	proto.RegisterType((*PendingTxFilter)(nil), "pb.PendingTxFilter")
+	proto.RegisterType((*PendingTxEvent)(nil), "pb.PendingTxEvent")
+	proto.RegisterType((*ExportRecord)(nil), "pb.ExportRecord")
 }
 
 func init() { proto.RegisterFile("event.proto", fileDescriptor_2d17a9d3f0ddf27e) }
 
 var fileDescriptor_2d17a9d3f0ddf27e = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xdf, 0x6f, 0xd3, 0x30,
-	0x10, 0x26, 0x4d, 0x7f, 0x2c, 0x97, 0xb4, 0x14, 0x33, 0x31, 0xab, 0x80, 0xd4, 0x45, 0x20, 0x65,
-	0x3c, 0x54, 0xa8, 0xf0, 0x8c, 0xc4, 0x26, 0x10, 0x12, 0x08, 0x84, 0xd7, 0xf7, 0xc8, 0x49, 0xbc,
-	0xd5, 0xa2, 0x8b, 0x33, 0xc7, 0x99, 0xd2, 0xbf, 0x82, 0xff, 0x8a, 0xbf, 0x0b, 0xf9, 0x9c, 0x66,
-	0x9a, 0x04, 0x6f, 0xbe, 0xef, 0xfb, 0xee, 0xee, 0xbb, 0xb3, 0x0d, 0xa1, 0xb8, 0x13, 0xa5, 0x59,
-	0x55, 0x5a, 0x19, 0x45, 0x06, 0x55, 0xb6, 0x88, 0xda, 0x7c, 0xcb, 0x65, 0xe9, 0x90, 0xf8, 0x27,
-	0xcc, 0x2f, 0x9b, 0xac, 0xce, 0xb5, 0xcc, 0x04, 0x13, 0xb7, 0x8d, 0xa8, 0x0d, 0x79, 0x0d, 0x43,
-	0xb3, 0xaf, 0x04, 0xf5, 0x96, 0x5e, 0x32, 0x5b, 0x3f, 0x59, 0x55, 0xd9, 0xaa, 0xd7, 0x6c, 0xf6,
-	0x95, 0x60, 0x48, 0x93, 0x67, 0x30, 0xbe, 0x92, 0x3b, 0x23, 0x34, 0x1d, 0x2c, 0xbd, 0x24, 0x62,
-	0x5d, 0x14, 0x9f, 0xc2, 0xe8, 0x93, 0xed, 0x49, 0x28, 0x4c, 0x2a, 0xbe, 0xdf, 0x29, 0x5e, 0x60,
-	0xa9, 0x88, 0x1d, 0xc2, 0xf8, 0x3d, 0xc0, 0xf9, 0x4e, 0xe5, 0xbf, 0x18, 0x2f, 0xaf, 0x05, 0x39,
-	0x86, 0x51, 0x6d, 0xb8, 0x36, 0xa8, 0x0a, 0x98, 0x0b, 0xc8, 0x1c, 0x7c, 0x51, 0x16, 0x58, 0x3b,
-	0x60, 0xf6, 0x18, 0xff, 0x19, 0x40, 0x88, 0x69, 0x9f, 0xb1, 0x91, 0x35, 0x90, 0xe5, 0x25, 0xbf,
-	0x11, 0x5d, 0x62, 0x17, 0x91, 0x57, 0x30, 0xd2, 0xb6, 0x30, 0xe6, 0x86, 0xeb, 0x99, 0x1d, 0xe0,
-	0xbe, 0x1d, 0x73, 0x24, 0x79, 0x09, 0x20, 0xda, 0x7c, 0xd7, 0x14, 0x22, 0x35, 0x2d, 0xf5, 0x97,
-	0x5e, 0x72, 0xc4, 0x82, 0x0e, 0xd9, 0xb4, 0x24, 0x81, 0xf9, 0x3d, 0x9d, 0xe2, 0x12, 0xe9, 0x10,
-	0x45, 0xb3, 0x5e, 0xe4, 0xc6, 0x5c, 0xc0, 0x51, 0xae, 0x4a, 0xa3, 0x79, 0x6e, 0x28, 0xa0, 0x91,
-	0x3e, 0xc6, 0x26, 0x56, 0x94, 0xa2, 0xcd, 0x10, 0xd9, 0x00, 0x91, 0xef, 0xd6, 0xe9, 0x0b, 0x08,
-	0x64, 0x29, 0x8d, 0xe4, 0x46, 0x69, 0x1a, 0x39, 0xb6, 0x07, 0xc8, 0x29, 0x44, 0xbc, 0x31, 0xdb,
-	0x54, 0x8b, 0xdb, 0x46, 0x6a, 0x41, 0xa7, 0x28, 0x08, 0x2d, 0xc6, 0x1c, 0x44, 0x9e, 0x43, 0x70,
-	0xa5, 0xd5, 0x4d, 0xca, 0x8b, 0x42, 0xd3, 0x99, 0x6b, 0x6e, 0x81, 0x8f, 0x45, 0xa1, 0xc9, 0x09,
-	0x4c, 0x8c, 0x72, 0xd4, 0x63, 0xb7, 0x20, 0xa3, 0x2c, 0x11, 0x6f, 0xe0, 0xa9, 0x5b, 0xa1, 0x28,
-	0x36, 0x9a, 0x97, 0x35, 0xcf, 0x8d, 0x54, 0x25, 0x21, 0x30, 0x34, 0xad, 0x2c, 0xba, 0x6d, 0xe2,
-	0x99, 0x9c, 0xc1, 0x18, 0xed, 0xd6, 0x74, 0xb0, 0xf4, 0x93, 0xd0, 0xbd, 0x86, 0x8b, 0x6e, 0x3c,
-	0x9c, 0x9f, 0x75, 0x82, 0xf8, 0xb7, 0x07, 0xd3, 0x43, 0x59, 0x5c, 0xf7, 0x7f, 0x2f, 0x88, 0xc2,
-	0x24, 0xb3, 0x02, 0x79, 0xb8, 0xde, 0x43, 0x68, 0x47, 0xc6, 0x63, 0xba, 0x15, 0xf2, 0x7a, 0x6b,
-	0xf0, 0x5a, 0x7c, 0x16, 0x22, 0xf6, 0x05, 0x21, 0x72, 0x06, 0xbe, 0x69, 0x6b, 0x3a, 0x44, 0x3b,
-	0x27, 0xd6, 0xce, 0x3f, 0x66, 0x61, 0x56, 0xf3, 0x66, 0x01, 0xd3, 0x07, 0x0f, 0x97, 0x04, 0x30,
-	0x3a, 0xff, 0xf6, 0xe3, 0xe2, 0xeb, 0xfc, 0xd1, 0xfa, 0x03, 0x44, 0x68, 0xff, 0x52, 0xe8, 0x3b,
-	0x99, 0x0b, 0xb2, 0x82, 0xa0, 0xd7, 0x92, 0xe3, 0x07, 0x6f, 0xbe, 0xfb, 0x17, 0x8b, 0xc0, 0xa2,
-	0x98, 0xf4, 0xd6, 0xcb, 0xc6, 0xf8, 0x7f, 0xde, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x74, 0x59,
-	0xa4, 0xba, 0x60, 0x03, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x71, 0x62, 0xc7, 0xc7, 0x6e, 0x9a, 0x1d, 0x56, 0xdd, 0xa1, 0x14, 0x11, 0x2c, 0x60,
+	0xb3, 0x5c, 0x54, 0xa8, 0x70, 0x8d, 0x94, 0x0d, 0x85, 0xad, 0x40, 0x69, 0x35, 0xf5, 0x4a, 0xdc,
+	0x05, 0xff, 0x4c, 0x1b, 0x6b, 0x13, 0x3b, 0x3b, 0x9e, 0xac, 0xdc, 0x07, 0x80, 0x5b, 0x9e, 0x80,
+	0xf7, 0x80, 0x17, 0xe0, 0x9d, 0xb8, 0x43, 0x73, 0x66, 0x9c, 0xa4, 0x6c, 0x68, 0x2f, 0x58, 0xb8,
+	0xd8, 0xbb, 0x9c, 0xef, 0x3b, 0x99, 0xf3, 0x9d, 0x6f, 0xce, 0x8c, 0x07, 0x7c, 0xfe, 0x8a, 0x17,
+	0xf2, 0x78, 0x29, 0x4a, 0x59, 0x92, 0xd6, 0x32, 0x39, 0x0c, 0xea, 0x74, 0x16, 0xe7, 0x85, 0x46,
+	0xc2, 0x9f, 0x2c, 0xe8, 0x5f, 0xae, 0x92, 0x2a, 0x15, 0x79, 0xc2, 0x19, 0x7f, 0xb9, 0xe2, 0x95,
+	0x24, 0x9f, 0x40, 0x5b, 0xde, 0x2c, 0x39, 0xb5, 0x06, 0xd6, 0xb0, 0x77, 0xf2, 0xe0, 0x78, 0x99,
+	0x1c, 0xaf, 0x73, 0xa2, 0x9b, 0x25, 0x67, 0x48, 0x93, 0x03, 0x70, 0xae, 0xf2, 0xb9, 0xe4, 0x82,
+	0xb6, 0x06, 0xd6, 0x30, 0x60, 0x26, 0x22, 0x27, 0x10, 0x54, 0x32, 0x16, 0x72, 0x9a, 0xae, 0x44,
+	0x55, 0x0a, 0x6a, 0x0f, 0xac, 0xa1, 0x7f, 0xb2, 0xaf, 0x96, 0x39, 0x55, 0x62, 0xc6, 0x08, 0x33,
+	0x1f, 0x93, 0x74, 0x10, 0xce, 0xc1, 0xdf, 0xe2, 0xc8, 0x47, 0x10, 0x24, 0xf3, 0x32, 0x7d, 0x31,
+	0x9d, 0xf1, 0xfc, 0x7a, 0x26, 0x51, 0x89, 0xcd, 0x7c, 0xc4, 0x9e, 0x21, 0x44, 0xde, 0x83, 0xae,
+	0xac, 0xa7, 0x79, 0x91, 0xf1, 0x1a, 0xeb, 0x77, 0x98, 0x2b, 0xeb, 0x33, 0x15, 0x92, 0x0f, 0x4d,
+	0xd7, 0x86, 0xb5, 0x91, 0x05, 0x84, 0x30, 0x21, 0x9c, 0x41, 0x07, 0xab, 0x11, 0x0a, 0xee, 0x32,
+	0xbe, 0x99, 0x97, 0x71, 0x86, 0x25, 0x02, 0xd6, 0x84, 0xe4, 0x31, 0x38, 0x46, 0x7e, 0x6b, 0xb7,
+	0x7c, 0x43, 0x93, 0x23, 0xf0, 0x66, 0x3c, 0x16, 0x32, 0xe1, 0xb1, 0xc4, 0x52, 0x5d, 0xb6, 0x01,
+	0xc2, 0x2f, 0x01, 0x9e, 0x2a, 0xd1, 0x2c, 0x2e, 0xae, 0x39, 0x79, 0x08, 0x1d, 0x6c, 0x1a, 0x8b,
+	0x79, 0x4c, 0x07, 0xa4, 0x0f, 0x36, 0x2f, 0x32, 0xac, 0xe3, 0x31, 0xf5, 0x33, 0xfc, 0xa3, 0x05,
+	0x3e, 0xfe, 0xed, 0x1b, 0xed, 0xe8, 0x01, 0x38, 0x49, 0x5a, 0xc4, 0x0b, 0x6e, 0xfe, 0x68, 0x22,
+	0xf2, 0x31, 0x74, 0x84, 0x5a, 0xd8, 0x68, 0xec, 0x29, 0x8d, 0x9b, 0x72, 0x4c, 0x93, 0xe4, 0x03,
+	0x00, 0x5e, 0xa7, 0xf3, 0x55, 0xc6, 0xa7, 0xb2, 0x6e, 0x24, 0x1a, 0x24, 0xaa, 0xc9, 0x10, 0xfa,
+	0x1b, 0x7a, 0x8a, 0x2e, 0xd1, 0x36, 0x26, 0xf5, 0xd6, 0x49, 0xda, 0xad, 0x43, 0xe8, 0xa6, 0x65,
+	0x21, 0x45, 0x9c, 0x4a, 0x0a, 0x28, 0x64, 0x1d, 0x63, 0x11, 0xf4, 0x1c, 0x65, 0xfa, 0xc8, 0x7a,
+	0x88, 0x4c, 0x94, 0xd2, 0x23, 0xf0, 0xf2, 0x22, 0x97, 0x79, 0x2c, 0x4b, 0x41, 0x03, 0xcd, 0xae,
+	0x01, 0xb5, 0xdd, 0xf1, 0x4a, 0xce, 0xa6, 0x82, 0xbf, 0x5c, 0xe5, 0x82, 0xd3, 0x3d, 0x4c, 0xf0,
+	0x15, 0xc6, 0x34, 0x44, 0xde, 0x07, 0xef, 0x4a, 0x94, 0x8b, 0x69, 0x9c, 0x65, 0x82, 0xf6, 0x74,
+	0x71, 0x05, 0x8c, 0xb2, 0x4c, 0x90, 0x47, 0xe0, 0xca, 0x52, 0x53, 0xfb, 0xda, 0x20, 0x59, 0x2a,
+	0x22, 0x8c, 0xe0, 0x5d, 0x6d, 0x21, 0xcf, 0x22, 0x11, 0x17, 0x55, 0x9c, 0xca, 0xbc, 0x2c, 0x08,
+	0x81, 0xb6, 0xac, 0xf3, 0xcc, 0xb8, 0x89, 0xbf, 0xc9, 0x13, 0x70, 0x50, 0x6e, 0x45, 0x5b, 0x03,
+	0x7b, 0xe8, 0xeb, 0xb1, 0x1f, 0x9b, 0xf6, 0xb0, 0x7f, 0x66, 0x12, 0xc2, 0x5f, 0x2c, 0xd8, 0x6b,
+	0x96, 0x45, 0xbb, 0xff, 0x71, 0x83, 0x28, 0xb8, 0x38, 0xb3, 0x79, 0xb3, 0xbd, 0x4d, 0xf8, 0xda,
+	0x84, 0xdb, 0xaf, 0x4f, 0xf8, 0x13, 0xb0, 0x65, 0x5d, 0xd1, 0x36, 0xca, 0x79, 0xa4, 0xe4, 0xec,
+	0xe8, 0x85, 0xa9, 0x9c, 0xf0, 0xb7, 0x16, 0x74, 0xa3, 0xfa, 0x8d, 0x4c, 0x4b, 0xe3, 0x8d, 0xbd,
+	0xe5, 0xcd, 0xdb, 0x3c, 0x22, 0xbf, 0x5a, 0xe0, 0x36, 0xea, 0xff, 0x93, 0x6d, 0x6c, 0x0c, 0x6d,
+	0xef, 0x1c, 0xb6, 0xce, 0x7d, 0xc3, 0xf6, 0x02, 0xf6, 0x46, 0x69, 0x5a, 0xae, 0x0a, 0xf9, 0x46,
+	0xb6, 0xf7, 0x08, 0x3c, 0x65, 0x02, 0xaf, 0x2a, 0x5e, 0x51, 0x7b, 0x60, 0x2b, 0x97, 0xd7, 0x40,
+	0x78, 0x01, 0xf0, 0x5c, 0xd6, 0xe5, 0x78, 0x76, 0x6b, 0x14, 0xb6, 0x8f, 0xc9, 0x01, 0x38, 0xe5,
+	0xd5, 0x55, 0xc5, 0xa5, 0xb9, 0x74, 0x4d, 0xa4, 0xf0, 0x78, 0xa1, 0x54, 0x9a, 0xc1, 0x31, 0x51,
+	0xf8, 0xa7, 0x05, 0x81, 0xd1, 0xff, 0x3f, 0x7b, 0x4c, 0xc1, 0x35, 0x8d, 0xd1, 0x8e, 0x5e, 0xd0,
+	0x84, 0xe4, 0x53, 0x70, 0x32, 0x9e, 0xe4, 0xb2, 0xa2, 0xce, 0xc0, 0x6e, 0xac, 0xda, 0xf4, 0xcd,
+	0x0c, 0x4b, 0x86, 0xe0, 0xa6, 0x82, 0x67, 0x2a, 0xd1, 0xdd, 0x99, 0xd8, 0xd0, 0xea, 0x62, 0xcf,
+	0xf8, 0x5c, 0xc6, 0xb4, 0xab, 0x2f, 0x76, 0x0c, 0xc2, 0x9f, 0x2d, 0xd8, 0xbf, 0xe0, 0x45, 0x96,
+	0x17, 0xd7, 0xf7, 0x1e, 0xce, 0xbb, 0x0e, 0xce, 0xbf, 0x3d, 0x19, 0xe1, 0xef, 0x16, 0xf4, 0xd6,
+	0x42, 0xee, 0xde, 0x86, 0xc6, 0xc9, 0xd6, 0x96, 0x93, 0xb7, 0xea, 0xdb, 0xf7, 0xd5, 0x6f, 0x0f,
+	0xec, 0xbf, 0xd5, 0x57, 0x0b, 0x34, 0xcd, 0xe8, 0x89, 0xf7, 0xd8, 0x06, 0x50, 0xac, 0xcc, 0x17,
+	0xbc, 0x92, 0xf1, 0x62, 0x49, 0x1d, 0xdc, 0xdc, 0x0d, 0x10, 0xfe, 0x08, 0xc1, 0x69, 0xbd, 0x2c,
+	0x85, 0x64, 0x3c, 0x2d, 0xc5, 0xf6, 0x87, 0xd9, 0xba, 0xfb, 0xc3, 0xfc, 0x18, 0x3a, 0x38, 0x22,
+	0xe6, 0x3c, 0x3c, 0xd8, 0xbe, 0x40, 0xf5, 0xb9, 0xd0, 0xfc, 0x67, 0xcf, 0x60, 0xef, 0xd6, 0xf3,
+	0x86, 0x78, 0xd0, 0x79, 0xfa, 0xfd, 0xf9, 0xf8, 0xbb, 0xfe, 0x3b, 0x64, 0x1f, 0xfc, 0x88, 0x8d,
+	0x26, 0x97, 0xa3, 0x71, 0x74, 0x76, 0x3e, 0xe9, 0x5b, 0xc4, 0x07, 0x77, 0x34, 0x1e, 0x9f, 0x3f,
+	0x9f, 0x44, 0xfd, 0x16, 0xe9, 0x01, 0x5c, 0x9c, 0x4e, 0xbe, 0x3e, 0x9b, 0x7c, 0x3b, 0x8d, 0x7e,
+	0xe8, 0xdb, 0x27, 0x5f, 0x41, 0x80, 0x4a, 0x2e, 0xb9, 0x78, 0x95, 0xa7, 0x9c, 0x1c, 0x83, 0xb7,
+	0x5e, 0x99, 0x3c, 0xbc, 0xf5, 0x8e, 0x32, 0x6f, 0xad, 0x43, 0x6f, 0x2d, 0xff, 0x73, 0x2b, 0x71,
+	0xf0, 0x51, 0xf6, 0xc5, 0x5f, 0x03, 0x00, 0x5a, 0xed, 0x69, 0x8f, 0xb5, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
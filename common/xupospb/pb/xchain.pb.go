@@ -60,6 +60,7 @@ const (
 	XChainErrorEnum_COMPLIANCE_CHECK_NOT_APPROVED  XChainErrorEnum = 37
 	XChainErrorEnum_ACCOUNT_CONTRACT_STATUS_ERROR  XChainErrorEnum = 38
 	XChainErrorEnum_TX_VERIFICATION_ERROR          XChainErrorEnum = 40
+	XChainErrorEnum_BLOCK_NOT_FOUND_ERROR          XChainErrorEnum = 41
 )
 
 var XChainErrorEnum_name = map[int32]string{
@@ -95,6 +96,7 @@ var XChainErrorEnum_name = map[int32]string{
 	37: "COMPLIANCE_CHECK_NOT_APPROVED",
 	38: "ACCOUNT_CONTRACT_STATUS_ERROR",
 	40: "TX_VERIFICATION_ERROR",
+	41: "BLOCK_NOT_FOUND_ERROR",
 }
 
 var XChainErrorEnum_value = map[string]int32{
@@ -130,6 +132,7 @@ var XChainErrorEnum_value = map[string]int32{
 	"COMPLIANCE_CHECK_NOT_APPROVED":  37,
 	"ACCOUNT_CONTRACT_STATUS_ERROR":  38,
 	"TX_VERIFICATION_ERROR":          40,
+	"BLOCK_NOT_FOUND_ERROR":          41,
 }
 
 func (x XChainErrorEnum) String() string {
@@ -198,6 +201,8 @@ const (
 	ViewOption_BRANCHINFO ViewOption = 3
 	// Peers flag: Get Peers Info
 	ViewOption_PEERS ViewOption = 4
+	// Speeds flag: Get TPS/BPS Info
+	ViewOption_SPEEDS ViewOption = 5
 )
 
 var ViewOption_name = map[int32]string{
@@ -206,6 +211,7 @@ var ViewOption_name = map[int32]string{
 	2: "UTXOINFO",
 	3: "BRANCHINFO",
 	4: "PEERS",
+	5: "SPEEDS",
 }
 
 var ViewOption_value = map[string]int32{
@@ -214,6 +220,7 @@ var ViewOption_value = map[string]int32{
 	"UTXOINFO":   2,
 	"BRANCHINFO": 3,
 	"PEERS":      4,
+	"SPEEDS":     5,
 }
 
 func (x ViewOption) String() string {
@@ -224,6 +231,81 @@ func (ViewOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_db0991b9525664ca, []int{2}
 }
 
+// TxCheckType is the verification step of ValidateTx
+type TxCheckType int32
+
+const (
+	// Transaction format, version and txid
+	TxCheckType_TX_FORMAT TxCheckType = 0
+	// Initiator, auth_require and XuperSign signatures
+	TxCheckType_TX_SIGNATURE TxCheckType = 1
+	// Account ACL of initiator and utxo inputs
+	TxCheckType_TX_PERMISSION TxCheckType = 2
+	// Utxo inputs are unspent and match the referred outputs
+	TxCheckType_TX_UTXO TxCheckType = 3
+	// Versions of the read set match the latest state
+	TxCheckType_TX_READ_SET TxCheckType = 4
+	// Full verification performed by PostTx
+	TxCheckType_TX_VERIFY TxCheckType = 5
+)
+
+var TxCheckType_name = map[int32]string{
+	0: "TX_FORMAT",
+	1: "TX_SIGNATURE",
+	2: "TX_PERMISSION",
+	3: "TX_UTXO",
+	4: "TX_READ_SET",
+	5: "TX_VERIFY",
+}
+
+var TxCheckType_value = map[string]int32{
+	"TX_FORMAT":     0,
+	"TX_SIGNATURE":  1,
+	"TX_PERMISSION": 2,
+	"TX_UTXO":       3,
+	"TX_READ_SET":   4,
+	"TX_VERIFY":     5,
+}
+
+func (x TxCheckType) String() string {
+	return proto.EnumName(TxCheckType_name, int32(x))
+}
+
+func (TxCheckType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{3}
+}
+
+// PeerDirection is how the connection with the peer was set up
+type PeerDirection int32
+
+const (
+	PeerDirection_DIRECTION_UNKNOWN PeerDirection = 0
+	// connected by the remote peer
+	PeerDirection_INBOUND PeerDirection = 1
+	// dialed by this node from bootNodes/staticNodes
+	PeerDirection_OUTBOUND PeerDirection = 2
+)
+
+var PeerDirection_name = map[int32]string{
+	0: "DIRECTION_UNKNOWN",
+	1: "INBOUND",
+	2: "OUTBOUND",
+}
+
+var PeerDirection_value = map[string]int32{
+	"DIRECTION_UNKNOWN": 0,
+	"INBOUND":           1,
+	"OUTBOUND":          2,
+}
+
+func (x PeerDirection) String() string {
+	return proto.EnumName(PeerDirection_name, int32(x))
+}
+
+func (PeerDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{4}
+}
+
 // --------   Account and Permission Section --------
 type PermissionRule int32
 
@@ -262,7 +344,7 @@ func (x PermissionRule) String() string {
 }
 
 func (PermissionRule) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{3}
+	return fileDescriptor_db0991b9525664ca, []int{5}
 }
 
 type ResourceType int32
@@ -293,7 +375,7 @@ func (x ResourceType) String() string {
 }
 
 func (ResourceType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{4}
+	return fileDescriptor_db0991b9525664ca, []int{6}
 }
 
 type Block_EBlockStatus int32
@@ -324,7 +406,7 @@ func (x Block_EBlockStatus) String() string {
 }
 
 func (Block_EBlockStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{5, 0}
+	return fileDescriptor_db0991b9525664ca, []int{7, 0}
 }
 
 type Header struct {
@@ -654,6 +736,135 @@ func (m *TxStatus) GetTx() *Transaction {
 	return nil
 }
 
+// TxCheckFailure is a failed verification item of ValidateTx
+type TxCheckFailure struct {
+	Check TxCheckType `protobuf:"varint,1,opt,name=check,proto3,enum=pb.TxCheckType" json:"check,omitempty"`
+	// index of the failed input/signature, -1 if the whole tx
+	Index                int32    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TxCheckFailure) Reset()         { *m = TxCheckFailure{} }
+func (m *TxCheckFailure) String() string { return proto.CompactTextString(m) }
+func (*TxCheckFailure) ProtoMessage()    {}
+func (*TxCheckFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{4}
+}
+
+func (m *TxCheckFailure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TxCheckFailure.Unmarshal(m, b)
+}
+func (m *TxCheckFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TxCheckFailure.Marshal(b, m, deterministic)
+}
+func (m *TxCheckFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxCheckFailure.Merge(m, src)
+}
+func (m *TxCheckFailure) XXX_Size() int {
+	return xxx_messageInfo_TxCheckFailure.Size(m)
+}
+func (m *TxCheckFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxCheckFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxCheckFailure proto.InternalMessageInfo
+
+func (m *TxCheckFailure) GetCheck() TxCheckType {
+	if m != nil {
+		return m.Check
+	}
+	return TxCheckType_TX_FORMAT
+}
+
+func (m *TxCheckFailure) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *TxCheckFailure) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type ValidateTxResponse struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Txid   []byte  `protobuf:"bytes,3,opt,name=txid,proto3" json:"txid,omitempty"`
+	// true if no failure found
+	Valid                bool              `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
+	Failures             []*TxCheckFailure `protobuf:"bytes,5,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValidateTxResponse) Reset()         { *m = ValidateTxResponse{} }
+func (m *ValidateTxResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateTxResponse) ProtoMessage()    {}
+func (*ValidateTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{5}
+}
+
+func (m *ValidateTxResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateTxResponse.Unmarshal(m, b)
+}
+func (m *ValidateTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateTxResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateTxResponse.Merge(m, src)
+}
+func (m *ValidateTxResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateTxResponse.Size(m)
+}
+func (m *ValidateTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateTxResponse proto.InternalMessageInfo
+
+func (m *ValidateTxResponse) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *ValidateTxResponse) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *ValidateTxResponse) GetTxid() []byte {
+	if m != nil {
+		return m.Txid
+	}
+	return nil
+}
+
+func (m *ValidateTxResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateTxResponse) GetFailures() []*TxCheckFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
 type BatchTxs struct {
 	Header               *Header     `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Txs                  []*TxStatus `protobuf:"bytes,2,rep,name=Txs,proto3" json:"Txs,omitempty"`
@@ -666,7 +877,7 @@ func (m *BatchTxs) Reset()         { *m = BatchTxs{} }
 func (m *BatchTxs) String() string { return proto.CompactTextString(m) }
 func (*BatchTxs) ProtoMessage()    {}
 func (*BatchTxs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{4}
+	return fileDescriptor_db0991b9525664ca, []int{6}
 }
 
 func (m *BatchTxs) XXX_Unmarshal(b []byte) error {
@@ -716,7 +927,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{5}
+	return fileDescriptor_db0991b9525664ca, []int{7}
 }
 
 func (m *Block) XXX_Unmarshal(b []byte) error {
@@ -787,7 +998,7 @@ func (m *BlockID) Reset()         { *m = BlockID{} }
 func (m *BlockID) String() string { return proto.CompactTextString(m) }
 func (*BlockID) ProtoMessage()    {}
 func (*BlockID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{6}
+	return fileDescriptor_db0991b9525664ca, []int{8}
 }
 
 func (m *BlockID) XXX_Unmarshal(b []byte) error {
@@ -849,7 +1060,7 @@ func (m *BlockHeight) Reset()         { *m = BlockHeight{} }
 func (m *BlockHeight) String() string { return proto.CompactTextString(m) }
 func (*BlockHeight) ProtoMessage()    {}
 func (*BlockHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{7}
+	return fileDescriptor_db0991b9525664ca, []int{9}
 }
 
 func (m *BlockHeight) XXX_Unmarshal(b []byte) error {
@@ -891,6 +1102,82 @@ func (m *BlockHeight) GetHeight() int64 {
 	return 0
 }
 
+// BlocksRange height range of GetBlocksStream
+type BlocksRange struct {
+	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname string  `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	// first height, inclusive
+	StartHeight int64 `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// last height, inclusive, 0 or above the trunk height means up to the trunk
+	// height when the call starts
+	EndHeight int64 `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// if need tx content, only block headers are sent if false
+	NeedContent          bool     `protobuf:"varint,5,opt,name=need_content,json=needContent,proto3" json:"need_content,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocksRange) Reset()         { *m = BlocksRange{} }
+func (m *BlocksRange) String() string { return proto.CompactTextString(m) }
+func (*BlocksRange) ProtoMessage()    {}
+func (*BlocksRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{10}
+}
+
+func (m *BlocksRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksRange.Unmarshal(m, b)
+}
+func (m *BlocksRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksRange.Marshal(b, m, deterministic)
+}
+func (m *BlocksRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksRange.Merge(m, src)
+}
+func (m *BlocksRange) XXX_Size() int {
+	return xxx_messageInfo_BlocksRange.Size(m)
+}
+func (m *BlocksRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksRange proto.InternalMessageInfo
+
+func (m *BlocksRange) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *BlocksRange) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *BlocksRange) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BlocksRange) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *BlocksRange) GetNeedContent() bool {
+	if m != nil {
+		return m.NeedContent
+	}
+	return false
+}
+
 type CommonReply struct {
 	Header               *Header  `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -902,7 +1189,7 @@ func (m *CommonReply) Reset()         { *m = CommonReply{} }
 func (m *CommonReply) String() string { return proto.CompactTextString(m) }
 func (*CommonReply) ProtoMessage()    {}
 func (*CommonReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{8}
+	return fileDescriptor_db0991b9525664ca, []int{11}
 }
 
 func (m *CommonReply) XXX_Unmarshal(b []byte) error {
@@ -942,7 +1229,7 @@ func (m *CommonIn) Reset()         { *m = CommonIn{} }
 func (m *CommonIn) String() string { return proto.CompactTextString(m) }
 func (*CommonIn) ProtoMessage()    {}
 func (*CommonIn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{9}
+	return fileDescriptor_db0991b9525664ca, []int{12}
 }
 
 func (m *CommonIn) XXX_Unmarshal(b []byte) error {
@@ -990,7 +1277,7 @@ func (m *TokenDetail) Reset()         { *m = TokenDetail{} }
 func (m *TokenDetail) String() string { return proto.CompactTextString(m) }
 func (*TokenDetail) ProtoMessage()    {}
 func (*TokenDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{10}
+	return fileDescriptor_db0991b9525664ca, []int{13}
 }
 
 func (m *TokenDetail) XXX_Unmarshal(b []byte) error {
@@ -1045,7 +1332,7 @@ func (m *AddressStatus) Reset()         { *m = AddressStatus{} }
 func (m *AddressStatus) String() string { return proto.CompactTextString(m) }
 func (*AddressStatus) ProtoMessage()    {}
 func (*AddressStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{11}
+	return fileDescriptor_db0991b9525664ca, []int{14}
 }
 
 func (m *AddressStatus) XXX_Unmarshal(b []byte) error {
@@ -1099,7 +1386,7 @@ func (m *TokenFrozenDetail) Reset()         { *m = TokenFrozenDetail{} }
 func (m *TokenFrozenDetail) String() string { return proto.CompactTextString(m) }
 func (*TokenFrozenDetail) ProtoMessage()    {}
 func (*TokenFrozenDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{12}
+	return fileDescriptor_db0991b9525664ca, []int{15}
 }
 
 func (m *TokenFrozenDetail) XXX_Unmarshal(b []byte) error {
@@ -1147,7 +1434,7 @@ func (m *TokenFrozenDetails) Reset()         { *m = TokenFrozenDetails{} }
 func (m *TokenFrozenDetails) String() string { return proto.CompactTextString(m) }
 func (*TokenFrozenDetails) ProtoMessage()    {}
 func (*TokenFrozenDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{13}
+	return fileDescriptor_db0991b9525664ca, []int{16}
 }
 
 func (m *TokenFrozenDetails) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +1489,7 @@ func (m *AddressBalanceStatus) Reset()         { *m = AddressBalanceStatus{} }
 func (m *AddressBalanceStatus) String() string { return proto.CompactTextString(m) }
 func (*AddressBalanceStatus) ProtoMessage()    {}
 func (*AddressBalanceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{14}
+	return fileDescriptor_db0991b9525664ca, []int{17}
 }
 
 func (m *AddressBalanceStatus) XXX_Unmarshal(b []byte) error {
@@ -1265,7 +1552,7 @@ func (m *TxInput) Reset()         { *m = TxInput{} }
 func (m *TxInput) String() string { return proto.CompactTextString(m) }
 func (*TxInput) ProtoMessage()    {}
 func (*TxInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{15}
+	return fileDescriptor_db0991b9525664ca, []int{18}
 }
 
 func (m *TxInput) XXX_Unmarshal(b []byte) error {
@@ -1338,7 +1625,7 @@ func (m *TxOutput) Reset()         { *m = TxOutput{} }
 func (m *TxOutput) String() string { return proto.CompactTextString(m) }
 func (*TxOutput) ProtoMessage()    {}
 func (*TxOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{16}
+	return fileDescriptor_db0991b9525664ca, []int{19}
 }
 
 func (m *TxOutput) XXX_Unmarshal(b []byte) error {
@@ -1393,7 +1680,7 @@ func (m *XuperSignature) Reset()         { *m = XuperSignature{} }
 func (m *XuperSignature) String() string { return proto.CompactTextString(m) }
 func (*XuperSignature) ProtoMessage()    {}
 func (*XuperSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{17}
+	return fileDescriptor_db0991b9525664ca, []int{20}
 }
 
 func (m *XuperSignature) XXX_Unmarshal(b []byte) error {
@@ -1479,7 +1766,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{18}
+	return fileDescriptor_db0991b9525664ca, []int{21}
 }
 
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
@@ -1664,7 +1951,7 @@ func (m *LedgerMeta) Reset()         { *m = LedgerMeta{} }
 func (m *LedgerMeta) String() string { return proto.CompactTextString(m) }
 func (*LedgerMeta) ProtoMessage()    {}
 func (*LedgerMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{19}
+	return fileDescriptor_db0991b9525664ca, []int{22}
 }
 
 func (m *LedgerMeta) XXX_Unmarshal(b []byte) error {
@@ -1730,7 +2017,7 @@ func (m *UtxoMeta) Reset()         { *m = UtxoMeta{} }
 func (m *UtxoMeta) String() string { return proto.CompactTextString(m) }
 func (*UtxoMeta) ProtoMessage()    {}
 func (*UtxoMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{20}
+	return fileDescriptor_db0991b9525664ca, []int{23}
 }
 
 func (m *UtxoMeta) XXX_Unmarshal(b []byte) error {
@@ -1856,7 +2143,7 @@ func (m *GasPrice) Reset()         { *m = GasPrice{} }
 func (m *GasPrice) String() string { return proto.CompactTextString(m) }
 func (*GasPrice) ProtoMessage()    {}
 func (*GasPrice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{21}
+	return fileDescriptor_db0991b9525664ca, []int{24}
 }
 
 func (m *GasPrice) XXX_Unmarshal(b []byte) error {
@@ -1954,7 +2241,7 @@ func (m *InternalBlock) Reset()         { *m = InternalBlock{} }
 func (m *InternalBlock) String() string { return proto.CompactTextString(m) }
 func (*InternalBlock) ProtoMessage()    {}
 func (*InternalBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{22}
+	return fileDescriptor_db0991b9525664ca, []int{25}
 }
 
 func (m *InternalBlock) XXX_Unmarshal(b []byte) error {
@@ -2137,7 +2424,7 @@ func (m *BCStatus) Reset()         { *m = BCStatus{} }
 func (m *BCStatus) String() string { return proto.CompactTextString(m) }
 func (*BCStatus) ProtoMessage()    {}
 func (*BCStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{23}
+	return fileDescriptor_db0991b9525664ca, []int{26}
 }
 
 func (m *BCStatus) XXX_Unmarshal(b []byte) error {
@@ -2212,7 +2499,7 @@ func (m *BCTipStatus) Reset()         { *m = BCTipStatus{} }
 func (m *BCTipStatus) String() string { return proto.CompactTextString(m) }
 func (*BCTipStatus) ProtoMessage()    {}
 func (*BCTipStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{24}
+	return fileDescriptor_db0991b9525664ca, []int{27}
 }
 
 func (m *BCTipStatus) XXX_Unmarshal(b []byte) error {
@@ -2259,7 +2546,7 @@ func (m *BlockChains) Reset()         { *m = BlockChains{} }
 func (m *BlockChains) String() string { return proto.CompactTextString(m) }
 func (*BlockChains) ProtoMessage()    {}
 func (*BlockChains) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{25}
+	return fileDescriptor_db0991b9525664ca, []int{28}
 }
 
 func (m *BlockChains) XXX_Unmarshal(b []byte) error {
@@ -2306,7 +2593,7 @@ func (m *Speeds) Reset()         { *m = Speeds{} }
 func (m *Speeds) String() string { return proto.CompactTextString(m) }
 func (*Speeds) ProtoMessage()    {}
 func (*Speeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{26}
+	return fileDescriptor_db0991b9525664ca, []int{29}
 }
 
 func (m *Speeds) XXX_Unmarshal(b []byte) error {
@@ -2352,7 +2639,7 @@ func (m *BCSpeeds) Reset()         { *m = BCSpeeds{} }
 func (m *BCSpeeds) String() string { return proto.CompactTextString(m) }
 func (*BCSpeeds) ProtoMessage()    {}
 func (*BCSpeeds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{27}
+	return fileDescriptor_db0991b9525664ca, []int{30}
 }
 
 func (m *BCSpeeds) XXX_Unmarshal(b []byte) error {
@@ -2394,7 +2681,7 @@ func (m *SystemsStatus) Reset()         { *m = SystemsStatus{} }
 func (m *SystemsStatus) String() string { return proto.CompactTextString(m) }
 func (*SystemsStatus) ProtoMessage()    {}
 func (*SystemsStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{28}
+	return fileDescriptor_db0991b9525664ca, []int{31}
 }
 
 func (m *SystemsStatus) XXX_Unmarshal(b []byte) error {
@@ -2455,7 +2742,7 @@ func (m *SystemsStatusReply) Reset()         { *m = SystemsStatusReply{} }
 func (m *SystemsStatusReply) String() string { return proto.CompactTextString(m) }
 func (*SystemsStatusReply) ProtoMessage()    {}
 func (*SystemsStatusReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{29}
+	return fileDescriptor_db0991b9525664ca, []int{32}
 }
 
 func (m *SystemsStatusReply) XXX_Unmarshal(b []byte) error {
@@ -2502,7 +2789,7 @@ func (m *ConsensusStatRequest) Reset()         { *m = ConsensusStatRequest{} }
 func (m *ConsensusStatRequest) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStatRequest) ProtoMessage()    {}
 func (*ConsensusStatRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{30}
+	return fileDescriptor_db0991b9525664ca, []int{33}
 }
 
 func (m *ConsensusStatRequest) XXX_Unmarshal(b []byte) error {
@@ -2557,7 +2844,7 @@ func (m *ConsensusStatus) Reset()         { *m = ConsensusStatus{} }
 func (m *ConsensusStatus) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStatus) ProtoMessage()    {}
 func (*ConsensusStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{31}
+	return fileDescriptor_db0991b9525664ca, []int{34}
 }
 
 func (m *ConsensusStatus) XXX_Unmarshal(b []byte) error {
@@ -2626,39 +2913,259 @@ func (m *RawUrl) Reset()         { *m = RawUrl{} }
 func (m *RawUrl) String() string { return proto.CompactTextString(m) }
 func (*RawUrl) ProtoMessage()    {}
 func (*RawUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{32}
+	return fileDescriptor_db0991b9525664ca, []int{35}
+}
+
+func (m *RawUrl) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RawUrl.Unmarshal(m, b)
+}
+func (m *RawUrl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RawUrl.Marshal(b, m, deterministic)
+}
+func (m *RawUrl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawUrl.Merge(m, src)
+}
+func (m *RawUrl) XXX_Size() int {
+	return xxx_messageInfo_RawUrl.Size(m)
+}
+func (m *RawUrl) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawUrl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawUrl proto.InternalMessageInfo
+
+func (m *RawUrl) GetHeader() *Header {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RawUrl) GetRawUrl() string {
+	if m != nil {
+		return m.RawUrl
+	}
+	return ""
+}
+
+// PeerChainHeight is the chain tip reported by a peer
+type PeerChainHeight struct {
+	Bcname               string   `protobuf:"bytes,1,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Height               int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	TipBlockid           []byte   `protobuf:"bytes,3,opt,name=tip_blockid,json=tipBlockid,proto3" json:"tip_blockid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerChainHeight) Reset()         { *m = PeerChainHeight{} }
+func (m *PeerChainHeight) String() string { return proto.CompactTextString(m) }
+func (*PeerChainHeight) ProtoMessage()    {}
+func (*PeerChainHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{36}
+}
+
+func (m *PeerChainHeight) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerChainHeight.Unmarshal(m, b)
+}
+func (m *PeerChainHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerChainHeight.Marshal(b, m, deterministic)
+}
+func (m *PeerChainHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerChainHeight.Merge(m, src)
+}
+func (m *PeerChainHeight) XXX_Size() int {
+	return xxx_messageInfo_PeerChainHeight.Size(m)
+}
+func (m *PeerChainHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerChainHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerChainHeight proto.InternalMessageInfo
+
+func (m *PeerChainHeight) GetBcname() string {
+	if m != nil {
+		return m.Bcname
+	}
+	return ""
+}
+
+func (m *PeerChainHeight) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PeerChainHeight) GetTipBlockid() []byte {
+	if m != nil {
+		return m.TipBlockid
+	}
+	return nil
+}
+
+// PeerDetail is the diagnostic info of a node
+type PeerDetail struct {
+	Id        string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address   string             `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Account   string             `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Chains    []*PeerChainHeight `protobuf:"bytes,4,rep,name=chains,proto3" json:"chains,omitempty"`
+	Direction PeerDirection      `protobuf:"varint,5,opt,name=direction,proto3,enum=pb.PeerDirection" json:"direction,omitempty"`
+	// round trip time of the chain status probe, in milliseconds
+	Latency int64 `protobuf:"varint,6,opt,name=latency,proto3" json:"latency,omitempty"`
+	// unix time in milliseconds of the last message received from the peer, 0 if never
+	LastMsgTime int64 `protobuf:"varint,7,opt,name=last_msg_time,json=lastMsgTime,proto3" json:"last_msg_time,omitempty"`
+	// probe error, empty if the peer responded
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// unix time in milliseconds of the probe that chains, latency and error come from, 0 if not probed yet,
+	// peers are probed in the background periodically
+	ProbeTime            int64    `protobuf:"varint,9,opt,name=probe_time,json=probeTime,proto3" json:"probe_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerDetail) Reset()         { *m = PeerDetail{} }
+func (m *PeerDetail) String() string { return proto.CompactTextString(m) }
+func (*PeerDetail) ProtoMessage()    {}
+func (*PeerDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{37}
+}
+
+func (m *PeerDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerDetail.Unmarshal(m, b)
+}
+func (m *PeerDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerDetail.Marshal(b, m, deterministic)
+}
+func (m *PeerDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerDetail.Merge(m, src)
+}
+func (m *PeerDetail) XXX_Size() int {
+	return xxx_messageInfo_PeerDetail.Size(m)
+}
+func (m *PeerDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerDetail proto.InternalMessageInfo
+
+func (m *PeerDetail) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeerDetail) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeerDetail) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *PeerDetail) GetChains() []*PeerChainHeight {
+	if m != nil {
+		return m.Chains
+	}
+	return nil
+}
+
+func (m *PeerDetail) GetDirection() PeerDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return PeerDirection_DIRECTION_UNKNOWN
+}
+
+func (m *PeerDetail) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *PeerDetail) GetLastMsgTime() int64 {
+	if m != nil {
+		return m.LastMsgTime
+	}
+	return 0
+}
+
+func (m *PeerDetail) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *PeerDetail) GetProbeTime() int64 {
+	if m != nil {
+		return m.ProbeTime
+	}
+	return 0
+}
+
+type PeerDetailsReply struct {
+	Header               *Header       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Local                *PeerDetail   `protobuf:"bytes,2,opt,name=local,proto3" json:"local,omitempty"`
+	Peers                []*PeerDetail `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PeerDetailsReply) Reset()         { *m = PeerDetailsReply{} }
+func (m *PeerDetailsReply) String() string { return proto.CompactTextString(m) }
+func (*PeerDetailsReply) ProtoMessage()    {}
+func (*PeerDetailsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db0991b9525664ca, []int{38}
 }
 
-func (m *RawUrl) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RawUrl.Unmarshal(m, b)
+func (m *PeerDetailsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerDetailsReply.Unmarshal(m, b)
 }
-func (m *RawUrl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RawUrl.Marshal(b, m, deterministic)
+func (m *PeerDetailsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerDetailsReply.Marshal(b, m, deterministic)
 }
-func (m *RawUrl) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RawUrl.Merge(m, src)
+func (m *PeerDetailsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerDetailsReply.Merge(m, src)
 }
-func (m *RawUrl) XXX_Size() int {
-	return xxx_messageInfo_RawUrl.Size(m)
+func (m *PeerDetailsReply) XXX_Size() int {
+	return xxx_messageInfo_PeerDetailsReply.Size(m)
 }
-func (m *RawUrl) XXX_DiscardUnknown() {
-	xxx_messageInfo_RawUrl.DiscardUnknown(m)
+func (m *PeerDetailsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerDetailsReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RawUrl proto.InternalMessageInfo
+var xxx_messageInfo_PeerDetailsReply proto.InternalMessageInfo
 
-func (m *RawUrl) GetHeader() *Header {
+func (m *PeerDetailsReply) GetHeader() *Header {
 	if m != nil {
 		return m.Header
 	}
 	return nil
 }
 
-func (m *RawUrl) GetRawUrl() string {
+func (m *PeerDetailsReply) GetLocal() *PeerDetail {
 	if m != nil {
-		return m.RawUrl
+		return m.Local
 	}
-	return ""
+	return nil
+}
+
+func (m *PeerDetailsReply) GetPeers() []*PeerDetail {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
 }
 
 type Utxo struct {
@@ -2676,7 +3183,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{33}
+	return fileDescriptor_db0991b9525664ca, []int{39}
 }
 
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
@@ -2746,7 +3253,11 @@ type UtxoInput struct {
 	// userSign of input
 	UserSign []byte `protobuf:"bytes,7,opt,name=userSign,proto3" json:"userSign,omitempty"`
 	// need lock
-	NeedLock             bool     `protobuf:"varint,8,opt,name=needLock,proto3" json:"needLock,omitempty"`
+	NeedLock bool `protobuf:"varint,8,opt,name=needLock,proto3" json:"needLock,omitempty"`
+	// timestamp unix seconds when userSign is generated, signed together with nonce
+	Timestamp int64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// nonce random string, userSign can only be used once
+	Nonce                string   `protobuf:"bytes,10,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2756,7 +3267,7 @@ func (m *UtxoInput) Reset()         { *m = UtxoInput{} }
 func (m *UtxoInput) String() string { return proto.CompactTextString(m) }
 func (*UtxoInput) ProtoMessage()    {}
 func (*UtxoInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{34}
+	return fileDescriptor_db0991b9525664ca, []int{40}
 }
 
 func (m *UtxoInput) XXX_Unmarshal(b []byte) error {
@@ -2826,6 +3337,20 @@ func (m *UtxoInput) GetNeedLock() bool {
 	return false
 }
 
+func (m *UtxoInput) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *UtxoInput) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
 // UtxoOutput query results
 type UtxoOutput struct {
 	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -2844,7 +3369,7 @@ func (m *UtxoOutput) Reset()         { *m = UtxoOutput{} }
 func (m *UtxoOutput) String() string { return proto.CompactTextString(m) }
 func (*UtxoOutput) ProtoMessage()    {}
 func (*UtxoOutput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{35}
+	return fileDescriptor_db0991b9525664ca, []int{41}
 }
 
 func (m *UtxoOutput) XXX_Unmarshal(b []byte) error {
@@ -2906,7 +3431,7 @@ func (m *NativeCodeDesc) Reset()         { *m = NativeCodeDesc{} }
 func (m *NativeCodeDesc) String() string { return proto.CompactTextString(m) }
 func (*NativeCodeDesc) ProtoMessage()    {}
 func (*NativeCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{36}
+	return fileDescriptor_db0991b9525664ca, []int{42}
 }
 
 func (m *NativeCodeDesc) XXX_Unmarshal(b []byte) error {
@@ -2977,7 +3502,7 @@ func (m *WasmCodeDesc) Reset()         { *m = WasmCodeDesc{} }
 func (m *WasmCodeDesc) String() string { return proto.CompactTextString(m) }
 func (*WasmCodeDesc) ProtoMessage()    {}
 func (*WasmCodeDesc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{37}
+	return fileDescriptor_db0991b9525664ca, []int{43}
 }
 
 func (m *WasmCodeDesc) XXX_Unmarshal(b []byte) error {
@@ -3046,7 +3571,7 @@ func (m *NativeCodeStatus) Reset()         { *m = NativeCodeStatus{} }
 func (m *NativeCodeStatus) String() string { return proto.CompactTextString(m) }
 func (*NativeCodeStatus) ProtoMessage()    {}
 func (*NativeCodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{38}
+	return fileDescriptor_db0991b9525664ca, []int{44}
 }
 
 func (m *NativeCodeStatus) XXX_Unmarshal(b []byte) error {
@@ -3101,7 +3626,7 @@ func (m *DposCandidatesRequest) Reset()         { *m = DposCandidatesRequest{} }
 func (m *DposCandidatesRequest) String() string { return proto.CompactTextString(m) }
 func (*DposCandidatesRequest) ProtoMessage()    {}
 func (*DposCandidatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{39}
+	return fileDescriptor_db0991b9525664ca, []int{45}
 }
 
 func (m *DposCandidatesRequest) XXX_Unmarshal(b []byte) error {
@@ -3149,7 +3674,7 @@ func (m *DposCandidatesResponse) Reset()         { *m = DposCandidatesResponse{}
 func (m *DposCandidatesResponse) String() string { return proto.CompactTextString(m) }
 func (*DposCandidatesResponse) ProtoMessage()    {}
 func (*DposCandidatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{40}
+	return fileDescriptor_db0991b9525664ca, []int{46}
 }
 
 func (m *DposCandidatesResponse) XXX_Unmarshal(b []byte) error {
@@ -3198,7 +3723,7 @@ func (m *DposNominateRecordsRequest) Reset()         { *m = DposNominateRecordsR
 func (m *DposNominateRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposNominateRecordsRequest) ProtoMessage()    {}
 func (*DposNominateRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{41}
+	return fileDescriptor_db0991b9525664ca, []int{47}
 }
 
 func (m *DposNominateRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3253,7 +3778,7 @@ func (m *DposNominateInfo) Reset()         { *m = DposNominateInfo{} }
 func (m *DposNominateInfo) String() string { return proto.CompactTextString(m) }
 func (*DposNominateInfo) ProtoMessage()    {}
 func (*DposNominateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{42}
+	return fileDescriptor_db0991b9525664ca, []int{48}
 }
 
 func (m *DposNominateInfo) XXX_Unmarshal(b []byte) error {
@@ -3301,7 +3826,7 @@ func (m *DposNominateRecordsResponse) Reset()         { *m = DposNominateRecords
 func (m *DposNominateRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposNominateRecordsResponse) ProtoMessage()    {}
 func (*DposNominateRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{43}
+	return fileDescriptor_db0991b9525664ca, []int{49}
 }
 
 func (m *DposNominateRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3350,7 +3875,7 @@ func (m *DposNomineeRecordsRequest) Reset()         { *m = DposNomineeRecordsReq
 func (m *DposNomineeRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposNomineeRecordsRequest) ProtoMessage()    {}
 func (*DposNomineeRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{44}
+	return fileDescriptor_db0991b9525664ca, []int{50}
 }
 
 func (m *DposNomineeRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3405,7 +3930,7 @@ func (m *DposNomineeRecordsResponse) Reset()         { *m = DposNomineeRecordsRe
 func (m *DposNomineeRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposNomineeRecordsResponse) ProtoMessage()    {}
 func (*DposNomineeRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{45}
+	return fileDescriptor_db0991b9525664ca, []int{51}
 }
 
 func (m *DposNomineeRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3454,7 +3979,7 @@ func (m *DposVoteRecordsRequest) Reset()         { *m = DposVoteRecordsRequest{}
 func (m *DposVoteRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposVoteRecordsRequest) ProtoMessage()    {}
 func (*DposVoteRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{46}
+	return fileDescriptor_db0991b9525664ca, []int{52}
 }
 
 func (m *DposVoteRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3509,7 +4034,7 @@ func (m *VoteRecord) Reset()         { *m = VoteRecord{} }
 func (m *VoteRecord) String() string { return proto.CompactTextString(m) }
 func (*VoteRecord) ProtoMessage()    {}
 func (*VoteRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{47}
+	return fileDescriptor_db0991b9525664ca, []int{53}
 }
 
 func (m *VoteRecord) XXX_Unmarshal(b []byte) error {
@@ -3558,7 +4083,7 @@ func (m *DposVoteRecordsResponse) Reset()         { *m = DposVoteRecordsResponse
 func (m *DposVoteRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposVoteRecordsResponse) ProtoMessage()    {}
 func (*DposVoteRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{48}
+	return fileDescriptor_db0991b9525664ca, []int{54}
 }
 
 func (m *DposVoteRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3607,7 +4132,7 @@ func (m *DposVotedRecordsRequest) Reset()         { *m = DposVotedRecordsRequest
 func (m *DposVotedRecordsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposVotedRecordsRequest) ProtoMessage()    {}
 func (*DposVotedRecordsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{49}
+	return fileDescriptor_db0991b9525664ca, []int{55}
 }
 
 func (m *DposVotedRecordsRequest) XXX_Unmarshal(b []byte) error {
@@ -3662,7 +4187,7 @@ func (m *VotedRecord) Reset()         { *m = VotedRecord{} }
 func (m *VotedRecord) String() string { return proto.CompactTextString(m) }
 func (*VotedRecord) ProtoMessage()    {}
 func (*VotedRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{50}
+	return fileDescriptor_db0991b9525664ca, []int{56}
 }
 
 func (m *VotedRecord) XXX_Unmarshal(b []byte) error {
@@ -3711,7 +4236,7 @@ func (m *DposVotedRecordsResponse) Reset()         { *m = DposVotedRecordsRespon
 func (m *DposVotedRecordsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposVotedRecordsResponse) ProtoMessage()    {}
 func (*DposVotedRecordsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{51}
+	return fileDescriptor_db0991b9525664ca, []int{57}
 }
 
 func (m *DposVotedRecordsResponse) XXX_Unmarshal(b []byte) error {
@@ -3760,7 +4285,7 @@ func (m *DposCheckResultsRequest) Reset()         { *m = DposCheckResultsRequest
 func (m *DposCheckResultsRequest) String() string { return proto.CompactTextString(m) }
 func (*DposCheckResultsRequest) ProtoMessage()    {}
 func (*DposCheckResultsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{52}
+	return fileDescriptor_db0991b9525664ca, []int{58}
 }
 
 func (m *DposCheckResultsRequest) XXX_Unmarshal(b []byte) error {
@@ -3816,7 +4341,7 @@ func (m *DposCheckResultsResponse) Reset()         { *m = DposCheckResultsRespon
 func (m *DposCheckResultsResponse) String() string { return proto.CompactTextString(m) }
 func (*DposCheckResultsResponse) ProtoMessage()    {}
 func (*DposCheckResultsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{53}
+	return fileDescriptor_db0991b9525664ca, []int{59}
 }
 
 func (m *DposCheckResultsResponse) XXX_Unmarshal(b []byte) error {
@@ -3871,7 +4396,7 @@ func (m *DposStatusRequest) Reset()         { *m = DposStatusRequest{} }
 func (m *DposStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*DposStatusRequest) ProtoMessage()    {}
 func (*DposStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{54}
+	return fileDescriptor_db0991b9525664ca, []int{60}
 }
 
 func (m *DposStatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3919,7 +4444,7 @@ func (m *DposStatusResponse) Reset()         { *m = DposStatusResponse{} }
 func (m *DposStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*DposStatusResponse) ProtoMessage()    {}
 func (*DposStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{55}
+	return fileDescriptor_db0991b9525664ca, []int{61}
 }
 
 func (m *DposStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3969,7 +4494,7 @@ func (m *DposStatus) Reset()         { *m = DposStatus{} }
 func (m *DposStatus) String() string { return proto.CompactTextString(m) }
 func (*DposStatus) ProtoMessage()    {}
 func (*DposStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{56}
+	return fileDescriptor_db0991b9525664ca, []int{62}
 }
 
 func (m *DposStatus) XXX_Unmarshal(b []byte) error {
@@ -4040,7 +4565,7 @@ func (m *InvokeRPCRequest) Reset()         { *m = InvokeRPCRequest{} }
 func (m *InvokeRPCRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRPCRequest) ProtoMessage()    {}
 func (*InvokeRPCRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{57}
+	return fileDescriptor_db0991b9525664ca, []int{63}
 }
 
 func (m *InvokeRPCRequest) XXX_Unmarshal(b []byte) error {
@@ -4109,7 +4634,7 @@ func (m *InvokeRPCResponse) Reset()         { *m = InvokeRPCResponse{} }
 func (m *InvokeRPCResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeRPCResponse) ProtoMessage()    {}
 func (*InvokeRPCResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{58}
+	return fileDescriptor_db0991b9525664ca, []int{64}
 }
 
 func (m *InvokeRPCResponse) XXX_Unmarshal(b []byte) error {
@@ -4170,7 +4695,7 @@ func (m *InvokeRequest) Reset()         { *m = InvokeRequest{} }
 func (m *InvokeRequest) String() string { return proto.CompactTextString(m) }
 func (*InvokeRequest) ProtoMessage()    {}
 func (*InvokeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{59}
+	return fileDescriptor_db0991b9525664ca, []int{65}
 }
 
 func (m *InvokeRequest) XXX_Unmarshal(b []byte) error {
@@ -4252,7 +4777,7 @@ func (m *InvokeResponse) Reset()         { *m = InvokeResponse{} }
 func (m *InvokeResponse) String() string { return proto.CompactTextString(m) }
 func (*InvokeResponse) ProtoMessage()    {}
 func (*InvokeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{60}
+	return fileDescriptor_db0991b9525664ca, []int{66}
 }
 
 func (m *InvokeResponse) XXX_Unmarshal(b []byte) error {
@@ -4344,7 +4869,7 @@ func (m *TxInputExt) Reset()         { *m = TxInputExt{} }
 func (m *TxInputExt) String() string { return proto.CompactTextString(m) }
 func (*TxInputExt) ProtoMessage()    {}
 func (*TxInputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{61}
+	return fileDescriptor_db0991b9525664ca, []int{67}
 }
 
 func (m *TxInputExt) XXX_Unmarshal(b []byte) error {
@@ -4407,7 +4932,7 @@ func (m *TxOutputExt) Reset()         { *m = TxOutputExt{} }
 func (m *TxOutputExt) String() string { return proto.CompactTextString(m) }
 func (*TxOutputExt) ProtoMessage()    {}
 func (*TxOutputExt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{62}
+	return fileDescriptor_db0991b9525664ca, []int{68}
 }
 
 func (m *TxOutputExt) XXX_Unmarshal(b []byte) error {
@@ -4462,7 +4987,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{63}
+	return fileDescriptor_db0991b9525664ca, []int{69}
 }
 
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
@@ -4509,7 +5034,7 @@ func (m *PermissionModel) Reset()         { *m = PermissionModel{} }
 func (m *PermissionModel) String() string { return proto.CompactTextString(m) }
 func (*PermissionModel) ProtoMessage()    {}
 func (*PermissionModel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{64}
+	return fileDescriptor_db0991b9525664ca, []int{70}
 }
 
 func (m *PermissionModel) XXX_Unmarshal(b []byte) error {
@@ -4556,7 +5081,7 @@ func (m *AkSet) Reset()         { *m = AkSet{} }
 func (m *AkSet) String() string { return proto.CompactTextString(m) }
 func (*AkSet) ProtoMessage()    {}
 func (*AkSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{65}
+	return fileDescriptor_db0991b9525664ca, []int{71}
 }
 
 func (m *AkSet) XXX_Unmarshal(b []byte) error {
@@ -4596,7 +5121,7 @@ func (m *AkSets) Reset()         { *m = AkSets{} }
 func (m *AkSets) String() string { return proto.CompactTextString(m) }
 func (*AkSets) ProtoMessage()    {}
 func (*AkSets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{66}
+	return fileDescriptor_db0991b9525664ca, []int{72}
 }
 
 func (m *AkSets) XXX_Unmarshal(b []byte) error {
@@ -4645,7 +5170,7 @@ func (m *Acl) Reset()         { *m = Acl{} }
 func (m *Acl) String() string { return proto.CompactTextString(m) }
 func (*Acl) ProtoMessage()    {}
 func (*Acl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{67}
+	return fileDescriptor_db0991b9525664ca, []int{73}
 }
 
 func (m *Acl) XXX_Unmarshal(b []byte) error {
@@ -4705,7 +5230,7 @@ func (m *AclStatus) Reset()         { *m = AclStatus{} }
 func (m *AclStatus) String() string { return proto.CompactTextString(m) }
 func (*AclStatus) ProtoMessage()    {}
 func (*AclStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{68}
+	return fileDescriptor_db0991b9525664ca, []int{74}
 }
 
 func (m *AclStatus) XXX_Unmarshal(b []byte) error {
@@ -4791,7 +5316,7 @@ func (m *IdentityAuth) Reset()         { *m = IdentityAuth{} }
 func (m *IdentityAuth) String() string { return proto.CompactTextString(m) }
 func (*IdentityAuth) ProtoMessage()    {}
 func (*IdentityAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{69}
+	return fileDescriptor_db0991b9525664ca, []int{75}
 }
 
 func (m *IdentityAuth) XXX_Unmarshal(b []byte) error {
@@ -4859,7 +5384,7 @@ func (m *IdentityAuths) Reset()         { *m = IdentityAuths{} }
 func (m *IdentityAuths) String() string { return proto.CompactTextString(m) }
 func (*IdentityAuths) ProtoMessage()    {}
 func (*IdentityAuths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{70}
+	return fileDescriptor_db0991b9525664ca, []int{76}
 }
 
 func (m *IdentityAuths) XXX_Unmarshal(b []byte) error {
@@ -4899,7 +5424,7 @@ func (m *ResourceLimit) Reset()         { *m = ResourceLimit{} }
 func (m *ResourceLimit) String() string { return proto.CompactTextString(m) }
 func (*ResourceLimit) ProtoMessage()    {}
 func (*ResourceLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{71}
+	return fileDescriptor_db0991b9525664ca, []int{77}
 }
 
 func (m *ResourceLimit) XXX_Unmarshal(b []byte) error {
@@ -4947,7 +5472,7 @@ func (m *AK2AccountRequest) Reset()         { *m = AK2AccountRequest{} }
 func (m *AK2AccountRequest) String() string { return proto.CompactTextString(m) }
 func (*AK2AccountRequest) ProtoMessage()    {}
 func (*AK2AccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{72}
+	return fileDescriptor_db0991b9525664ca, []int{78}
 }
 
 func (m *AK2AccountRequest) XXX_Unmarshal(b []byte) error {
@@ -5002,7 +5527,7 @@ func (m *AK2AccountResponse) Reset()         { *m = AK2AccountResponse{} }
 func (m *AK2AccountResponse) String() string { return proto.CompactTextString(m) }
 func (*AK2AccountResponse) ProtoMessage()    {}
 func (*AK2AccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{73}
+	return fileDescriptor_db0991b9525664ca, []int{79}
 }
 
 func (m *AK2AccountResponse) XXX_Unmarshal(b []byte) error {
@@ -5058,7 +5583,7 @@ func (m *GetAccountContractsRequest) Reset()         { *m = GetAccountContractsR
 func (m *GetAccountContractsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAccountContractsRequest) ProtoMessage()    {}
 func (*GetAccountContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{74}
+	return fileDescriptor_db0991b9525664ca, []int{80}
 }
 
 func (m *GetAccountContractsRequest) XXX_Unmarshal(b []byte) error {
@@ -5113,7 +5638,7 @@ func (m *GetAccountContractsResponse) Reset()         { *m = GetAccountContracts
 func (m *GetAccountContractsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAccountContractsResponse) ProtoMessage()    {}
 func (*GetAccountContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{75}
+	return fileDescriptor_db0991b9525664ca, []int{81}
 }
 
 func (m *GetAccountContractsResponse) XXX_Unmarshal(b []byte) error {
@@ -5165,7 +5690,7 @@ func (m *ContractStatus) Reset()         { *m = ContractStatus{} }
 func (m *ContractStatus) String() string { return proto.CompactTextString(m) }
 func (*ContractStatus) ProtoMessage()    {}
 func (*ContractStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{76}
+	return fileDescriptor_db0991b9525664ca, []int{82}
 }
 
 func (m *ContractStatus) XXX_Unmarshal(b []byte) error {
@@ -5230,23 +5755,26 @@ func (m *ContractStatus) GetRuntime() string {
 
 // PreExecWithSelectUTXORequest preExec + selectUtxo for request
 type PreExecWithSelectUTXORequest struct {
-	Header               *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Bcname               string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
-	Address              string            `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	TotalAmount          int64             `protobuf:"varint,4,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
-	SignInfo             *SignatureInfo    `protobuf:"bytes,6,opt,name=signInfo,proto3" json:"signInfo,omitempty"`
-	NeedLock             bool              `protobuf:"varint,7,opt,name=needLock,proto3" json:"needLock,omitempty"`
-	Request              *InvokeRPCRequest `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Header      *Header           `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Bcname      string            `protobuf:"bytes,2,opt,name=bcname,proto3" json:"bcname,omitempty"`
+	Address     string            `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	TotalAmount int64             `protobuf:"varint,4,opt,name=totalAmount,proto3" json:"totalAmount,omitempty"`
+	SignInfo    *SignatureInfo    `protobuf:"bytes,6,opt,name=signInfo,proto3" json:"signInfo,omitempty"`
+	NeedLock    bool              `protobuf:"varint,7,opt,name=needLock,proto3" json:"needLock,omitempty"`
+	Request     *InvokeRPCRequest `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	// timestamp and nonce of signInfo, same as UtxoInput
+	Timestamp            int64    `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Nonce                string   `protobuf:"bytes,9,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PreExecWithSelectUTXORequest) Reset()         { *m = PreExecWithSelectUTXORequest{} }
 func (m *PreExecWithSelectUTXORequest) String() string { return proto.CompactTextString(m) }
 func (*PreExecWithSelectUTXORequest) ProtoMessage()    {}
 func (*PreExecWithSelectUTXORequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{77}
+	return fileDescriptor_db0991b9525664ca, []int{83}
 }
 
 func (m *PreExecWithSelectUTXORequest) XXX_Unmarshal(b []byte) error {
@@ -5316,6 +5844,20 @@ func (m *PreExecWithSelectUTXORequest) GetRequest() *InvokeRPCRequest {
 	return nil
 }
 
+func (m *PreExecWithSelectUTXORequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PreExecWithSelectUTXORequest) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
 // PreExecWithSelectUTXOResponse preExec + selectUtxo for response
 type PreExecWithSelectUTXOResponse struct {
 	Header   *Header         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -5332,7 +5874,7 @@ func (m *PreExecWithSelectUTXOResponse) Reset()         { *m = PreExecWithSelect
 func (m *PreExecWithSelectUTXOResponse) String() string { return proto.CompactTextString(m) }
 func (*PreExecWithSelectUTXOResponse) ProtoMessage()    {}
 func (*PreExecWithSelectUTXOResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{78}
+	return fileDescriptor_db0991b9525664ca, []int{84}
 }
 
 func (m *PreExecWithSelectUTXOResponse) XXX_Unmarshal(b []byte) error {
@@ -5395,7 +5937,7 @@ func (m *ContractResponse) Reset()         { *m = ContractResponse{} }
 func (m *ContractResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractResponse) ProtoMessage()    {}
 func (*ContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{79}
+	return fileDescriptor_db0991b9525664ca, []int{85}
 }
 
 func (m *ContractResponse) XXX_Unmarshal(b []byte) error {
@@ -5457,7 +5999,7 @@ func (m *ModifyBlock) Reset()         { *m = ModifyBlock{} }
 func (m *ModifyBlock) String() string { return proto.CompactTextString(m) }
 func (*ModifyBlock) ProtoMessage()    {}
 func (*ModifyBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{80}
+	return fileDescriptor_db0991b9525664ca, []int{86}
 }
 
 func (m *ModifyBlock) XXX_Unmarshal(b []byte) error {
@@ -5527,7 +6069,7 @@ func (m *HDInfo) Reset()         { *m = HDInfo{} }
 func (m *HDInfo) String() string { return proto.CompactTextString(m) }
 func (*HDInfo) ProtoMessage()    {}
 func (*HDInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{81}
+	return fileDescriptor_db0991b9525664ca, []int{87}
 }
 
 func (m *HDInfo) XXX_Unmarshal(b []byte) error {
@@ -5579,7 +6121,7 @@ func (m *UtxoRecordDetail) Reset()         { *m = UtxoRecordDetail{} }
 func (m *UtxoRecordDetail) String() string { return proto.CompactTextString(m) }
 func (*UtxoRecordDetail) ProtoMessage()    {}
 func (*UtxoRecordDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{82}
+	return fileDescriptor_db0991b9525664ca, []int{88}
 }
 
 func (m *UtxoRecordDetail) XXX_Unmarshal(b []byte) error {
@@ -5662,7 +6204,7 @@ func (m *UtxoRecord) Reset()         { *m = UtxoRecord{} }
 func (m *UtxoRecord) String() string { return proto.CompactTextString(m) }
 func (*UtxoRecord) ProtoMessage()    {}
 func (*UtxoRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{83}
+	return fileDescriptor_db0991b9525664ca, []int{89}
 }
 
 func (m *UtxoRecord) XXX_Unmarshal(b []byte) error {
@@ -5717,7 +6259,7 @@ func (m *UtxoKey) Reset()         { *m = UtxoKey{} }
 func (m *UtxoKey) String() string { return proto.CompactTextString(m) }
 func (*UtxoKey) ProtoMessage()    {}
 func (*UtxoKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{84}
+	return fileDescriptor_db0991b9525664ca, []int{90}
 }
 
 func (m *UtxoKey) XXX_Unmarshal(b []byte) error {
@@ -5771,7 +6313,7 @@ func (m *ContractStatDataRequest) Reset()         { *m = ContractStatDataRequest
 func (m *ContractStatDataRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractStatDataRequest) ProtoMessage()    {}
 func (*ContractStatDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{85}
+	return fileDescriptor_db0991b9525664ca, []int{91}
 }
 
 func (m *ContractStatDataRequest) XXX_Unmarshal(b []byte) error {
@@ -5819,7 +6361,7 @@ func (m *ContractStatDataResponse) Reset()         { *m = ContractStatDataRespon
 func (m *ContractStatDataResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractStatDataResponse) ProtoMessage()    {}
 func (*ContractStatDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{86}
+	return fileDescriptor_db0991b9525664ca, []int{92}
 }
 
 func (m *ContractStatDataResponse) XXX_Unmarshal(b []byte) error {
@@ -5873,7 +6415,7 @@ func (m *ContractStatData) Reset()         { *m = ContractStatData{} }
 func (m *ContractStatData) String() string { return proto.CompactTextString(m) }
 func (*ContractStatData) ProtoMessage()    {}
 func (*ContractStatData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{87}
+	return fileDescriptor_db0991b9525664ca, []int{93}
 }
 
 func (m *ContractStatData) XXX_Unmarshal(b []byte) error {
@@ -5923,7 +6465,7 @@ func (m *AddressContractsRequest) Reset()         { *m = AddressContractsRequest
 func (m *AddressContractsRequest) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsRequest) ProtoMessage()    {}
 func (*AddressContractsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{88}
+	return fileDescriptor_db0991b9525664ca, []int{94}
 }
 
 func (m *AddressContractsRequest) XXX_Unmarshal(b []byte) error {
@@ -5983,7 +6525,7 @@ func (m *ContractList) Reset()         { *m = ContractList{} }
 func (m *ContractList) String() string { return proto.CompactTextString(m) }
 func (*ContractList) ProtoMessage()    {}
 func (*ContractList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{89}
+	return fileDescriptor_db0991b9525664ca, []int{95}
 }
 
 func (m *ContractList) XXX_Unmarshal(b []byte) error {
@@ -6024,7 +6566,7 @@ func (m *AddressContractsResponse) Reset()         { *m = AddressContractsRespon
 func (m *AddressContractsResponse) String() string { return proto.CompactTextString(m) }
 func (*AddressContractsResponse) ProtoMessage()    {}
 func (*AddressContractsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{90}
+	return fileDescriptor_db0991b9525664ca, []int{96}
 }
 
 func (m *AddressContractsResponse) XXX_Unmarshal(b []byte) error {
@@ -6074,7 +6616,7 @@ func (m *CrossQueryRequest) Reset()         { *m = CrossQueryRequest{} }
 func (m *CrossQueryRequest) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryRequest) ProtoMessage()    {}
 func (*CrossQueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{91}
+	return fileDescriptor_db0991b9525664ca, []int{97}
 }
 
 func (m *CrossQueryRequest) XXX_Unmarshal(b []byte) error {
@@ -6141,7 +6683,7 @@ func (m *CrossQueryResponse) Reset()         { *m = CrossQueryResponse{} }
 func (m *CrossQueryResponse) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryResponse) ProtoMessage()    {}
 func (*CrossQueryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{92}
+	return fileDescriptor_db0991b9525664ca, []int{98}
 }
 
 func (m *CrossQueryResponse) XXX_Unmarshal(b []byte) error {
@@ -6181,7 +6723,7 @@ func (m *CrossChainMeta) Reset()         { *m = CrossChainMeta{} }
 func (m *CrossChainMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossChainMeta) ProtoMessage()    {}
 func (*CrossChainMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{93}
+	return fileDescriptor_db0991b9525664ca, []int{99}
 }
 
 func (m *CrossChainMeta) XXX_Unmarshal(b []byte) error {
@@ -6230,7 +6772,7 @@ func (m *CrossEndorsor) Reset()         { *m = CrossEndorsor{} }
 func (m *CrossEndorsor) String() string { return proto.CompactTextString(m) }
 func (*CrossEndorsor) ProtoMessage()    {}
 func (*CrossEndorsor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{94}
+	return fileDescriptor_db0991b9525664ca, []int{100}
 }
 
 func (m *CrossEndorsor) XXX_Unmarshal(b []byte) error {
@@ -6284,7 +6826,7 @@ func (m *CrossQueryMeta) Reset()         { *m = CrossQueryMeta{} }
 func (m *CrossQueryMeta) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryMeta) ProtoMessage()    {}
 func (*CrossQueryMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{95}
+	return fileDescriptor_db0991b9525664ca, []int{101}
 }
 
 func (m *CrossQueryMeta) XXX_Unmarshal(b []byte) error {
@@ -6332,7 +6874,7 @@ func (m *CrossQueryInfo) Reset()         { *m = CrossQueryInfo{} }
 func (m *CrossQueryInfo) String() string { return proto.CompactTextString(m) }
 func (*CrossQueryInfo) ProtoMessage()    {}
 func (*CrossQueryInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{96}
+	return fileDescriptor_db0991b9525664ca, []int{102}
 }
 
 func (m *CrossQueryInfo) XXX_Unmarshal(b []byte) error {
@@ -6387,7 +6929,7 @@ func (m *ContractEvent) Reset()         { *m = ContractEvent{} }
 func (m *ContractEvent) String() string { return proto.CompactTextString(m) }
 func (*ContractEvent) ProtoMessage()    {}
 func (*ContractEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db0991b9525664ca, []int{97}
+	return fileDescriptor_db0991b9525664ca, []int{103}
 }
 
 func (m *ContractEvent) XXX_Unmarshal(b []byte) error {
@@ -6433,6 +6975,8 @@ func init() {
 	proto.RegisterEnum("pb.XChainErrorEnum", XChainErrorEnum_name, XChainErrorEnum_value)
 	proto.RegisterEnum("pb.TransactionStatus", TransactionStatus_name, TransactionStatus_value)
 	proto.RegisterEnum("pb.ViewOption", ViewOption_name, ViewOption_value)
+	proto.RegisterEnum("pb.TxCheckType", TxCheckType_name, TxCheckType_value)
+	proto.RegisterEnum("pb.PeerDirection", PeerDirection_name, PeerDirection_value)
 	proto.RegisterEnum("pb.PermissionRule", PermissionRule_name, PermissionRule_value)
 	proto.RegisterEnum("pb.ResourceType", ResourceType_name, ResourceType_value)
 	proto.RegisterEnum("pb.Block_EBlockStatus", Block_EBlockStatus_name, Block_EBlockStatus_value)
@@ -6440,10 +6984,13 @@ func init() {
 	proto.RegisterType((*TxDataAccount)(nil), "pb.TxDataAccount")
 	proto.RegisterType((*TxData)(nil), "pb.TxData")
 	proto.RegisterType((*TxStatus)(nil), "pb.TxStatus")
+	proto.RegisterType((*TxCheckFailure)(nil), "pb.TxCheckFailure")
+	proto.RegisterType((*ValidateTxResponse)(nil), "pb.ValidateTxResponse")
 	proto.RegisterType((*BatchTxs)(nil), "pb.BatchTxs")
 	proto.RegisterType((*Block)(nil), "pb.Block")
 	proto.RegisterType((*BlockID)(nil), "pb.BlockID")
 	proto.RegisterType((*BlockHeight)(nil), "pb.BlockHeight")
+	proto.RegisterType((*BlocksRange)(nil), "pb.BlocksRange")
 	proto.RegisterType((*CommonReply)(nil), "pb.CommonReply")
 	proto.RegisterType((*CommonIn)(nil), "pb.CommonIn")
 	proto.RegisterType((*TokenDetail)(nil), "pb.TokenDetail")
@@ -6473,6 +7020,9 @@ func init() {
 	proto.RegisterType((*ConsensusStatRequest)(nil), "pb.ConsensusStatRequest")
 	proto.RegisterType((*ConsensusStatus)(nil), "pb.ConsensusStatus")
 	proto.RegisterType((*RawUrl)(nil), "pb.RawUrl")
+	proto.RegisterType((*PeerChainHeight)(nil), "pb.PeerChainHeight")
+	proto.RegisterType((*PeerDetail)(nil), "pb.PeerDetail")
+	proto.RegisterType((*PeerDetailsReply)(nil), "pb.PeerDetailsReply")
 	proto.RegisterType((*Utxo)(nil), "pb.Utxo")
 	proto.RegisterType((*UtxoInput)(nil), "pb.UtxoInput")
 	proto.RegisterType((*UtxoOutput)(nil), "pb.UtxoOutput")
@@ -6547,386 +7097,413 @@ func init() {
 func init() { proto.RegisterFile("xchain.proto", fileDescriptor_db0991b9525664ca) }
 
 var fileDescriptor_db0991b9525664ca = []byte{
-	// 6052 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xcd, 0x6f, 0x23, 0xc9,
-	0x75, 0xf8, 0x36, 0x29, 0xf1, 0xe3, 0xf1, 0x43, 0x54, 0xcd, 0x48, 0xc3, 0xa1, 0xb4, 0xf3, 0xd1,
-	0x3b, 0xbb, 0x2b, 0xcf, 0xfe, 0xac, 0xf9, 0xad, 0x6c, 0xff, 0x76, 0x31, 0xb6, 0xd7, 0x3f, 0x8a,
-	0xa2, 0x66, 0x68, 0x69, 0x48, 0x6d, 0x93, 0x9c, 0xd1, 0xc2, 0x01, 0xda, 0x2d, 0xb2, 0x24, 0xb5,
-	0x45, 0x76, 0xd3, 0xdd, 0x4d, 0x0d, 0xb5, 0x36, 0x92, 0x8d, 0x91, 0x93, 0x6f, 0x49, 0x80, 0xdc,
-	0x62, 0x04, 0x39, 0x06, 0xc8, 0x25, 0x08, 0x90, 0x43, 0x80, 0x00, 0x31, 0x02, 0x1f, 0x73, 0x09,
-	0x72, 0x48, 0xae, 0x0e, 0xf2, 0x1f, 0xe4, 0x1e, 0xd4, 0xab, 0xaa, 0xee, 0x6a, 0x7e, 0xcc, 0x8e,
-	0xbc, 0xe3, 0xbd, 0x8c, 0x58, 0xaf, 0x5e, 0xbd, 0xaa, 0xf7, 0xaa, 0xea, 0x7d, 0xd5, 0xeb, 0x81,
-	0xfc, 0xa4, 0x77, 0x6e, 0xd9, 0xce, 0xf6, 0xc8, 0x73, 0x03, 0x97, 0x24, 0x46, 0x27, 0x95, 0xcd,
-	0x33, 0xd7, 0x3d, 0x1b, 0xd0, 0x47, 0xd6, 0xc8, 0x7e, 0x64, 0x39, 0x8e, 0x1b, 0x58, 0x81, 0xed,
-	0x3a, 0x3e, 0xc7, 0xa8, 0x94, 0x10, 0x9d, 0xf6, 0x4f, 0x4e, 0x03, 0x0e, 0xd1, 0x4f, 0x21, 0xf5,
-	0x94, 0x5a, 0x7d, 0xea, 0x91, 0x9b, 0xb0, 0x3c, 0x70, 0xcf, 0xec, 0x7e, 0x59, 0xbb, 0xa7, 0x6d,
-	0x65, 0x0d, 0xde, 0x20, 0x1b, 0x90, 0x3d, 0xf5, 0xdc, 0xa1, 0xe9, 0xb8, 0x7d, 0x5a, 0x4e, 0x60,
-	0x4f, 0x86, 0x01, 0x9a, 0x6e, 0x9f, 0x92, 0x6f, 0xc0, 0x32, 0xf5, 0x3c, 0xd7, 0x2b, 0x27, 0xef,
-	0x69, 0x5b, 0xc5, 0x9d, 0x1b, 0xdb, 0xa3, 0x93, 0xed, 0xe3, 0x1a, 0x9b, 0xa2, 0xce, 0xc0, 0x75,
-	0x67, 0x3c, 0x34, 0x38, 0x86, 0x7e, 0x0a, 0x85, 0xce, 0x64, 0xcf, 0x0a, 0xac, 0x6a, 0xaf, 0xe7,
-	0x8e, 0x9d, 0x80, 0x94, 0x21, 0x6d, 0xf5, 0xfb, 0x1e, 0xf5, 0x7d, 0x31, 0xa1, 0x6c, 0x92, 0x75,
-	0x48, 0x59, 0x43, 0x86, 0x23, 0xe6, 0x13, 0x2d, 0xf2, 0x0e, 0x14, 0x4e, 0x3d, 0xf7, 0x73, 0xea,
-	0x98, 0xe7, 0xd4, 0x3e, 0x3b, 0x0f, 0x70, 0xd6, 0xa4, 0x91, 0xe7, 0xc0, 0xa7, 0x08, 0xd3, 0x7f,
-	0x9b, 0x80, 0x14, 0x9f, 0x88, 0xe8, 0x90, 0x3a, 0x47, 0xd6, 0xca, 0x85, 0x7b, 0xda, 0x56, 0x6e,
-	0x07, 0xd8, 0xf2, 0x38, 0xb3, 0x86, 0xe8, 0x21, 0x04, 0x96, 0x82, 0x89, 0xe0, 0x39, 0x6f, 0xe0,
-	0x6f, 0x36, 0xff, 0x49, 0xcf, 0xb1, 0x86, 0x92, 0x5f, 0xd1, 0x0a, 0x45, 0xc1, 0xd6, 0x89, 0x73,
-	0x0b, 0x51, 0x54, 0xfb, 0x7d, 0x8f, 0xdc, 0x85, 0x1c, 0x76, 0x8e, 0xc6, 0x27, 0x17, 0xf4, 0xaa,
-	0xbc, 0x84, 0xdd, 0xc0, 0x40, 0x47, 0x08, 0x09, 0x11, 0xfc, 0x9e, 0xc7, 0x10, 0x96, 0x23, 0x84,
-	0x36, 0x42, 0x18, 0xf9, 0xb1, 0x4f, 0x3d, 0xd3, 0xb7, 0xcf, 0x9c, 0x72, 0x11, 0xd7, 0x93, 0x61,
-	0x80, 0xb6, 0x7d, 0xe6, 0x90, 0x0f, 0x20, 0x6d, 0x71, 0xc1, 0x95, 0x53, 0xf7, 0x92, 0x5b, 0xb9,
-	0x9d, 0x55, 0xc6, 0x4c, 0x4c, 0xa2, 0x86, 0xc4, 0x60, 0x3b, 0xe9, 0xb8, 0x4e, 0x8f, 0x96, 0x33,
-	0x7c, 0x27, 0xb1, 0x41, 0x36, 0x21, 0x1b, 0xd8, 0x43, 0xea, 0x07, 0xd6, 0x70, 0x54, 0xce, 0xa2,
-	0xe8, 0x22, 0x00, 0x13, 0x44, 0x9f, 0xfa, 0xbd, 0x72, 0x9e, 0x0b, 0x82, 0xfd, 0x66, 0x5b, 0x74,
-	0x49, 0x3d, 0xdf, 0x76, 0x9d, 0xf2, 0xca, 0x3d, 0x6d, 0x6b, 0xd9, 0x90, 0x4d, 0xfd, 0x37, 0x1a,
-	0x64, 0x3a, 0x93, 0x76, 0x60, 0x05, 0x63, 0x5f, 0x91, 0xb3, 0xb6, 0x50, 0xce, 0x8b, 0x64, 0x2a,
-	0xe5, 0x9f, 0x54, 0xe4, 0xff, 0x4d, 0x48, 0xf9, 0x48, 0x19, 0xa5, 0x58, 0xdc, 0x59, 0x43, 0x56,
-	0x3d, 0xcb, 0xf1, 0xad, 0x1e, 0x3b, 0xcc, 0x7c, 0x5a, 0x43, 0x20, 0x91, 0x0a, 0x64, 0xfa, 0xb6,
-	0x1f, 0x58, 0x8c, 0xe1, 0x65, 0x64, 0x2b, 0x6c, 0x93, 0xbb, 0x90, 0x08, 0x26, 0xe5, 0x34, 0x2e,
-	0x6b, 0x65, 0x8a, 0x8c, 0x91, 0x08, 0x26, 0x7a, 0x13, 0x32, 0xbb, 0x56, 0xd0, 0x3b, 0xef, 0x4c,
-	0x5e, 0x8f, 0x8f, 0x3b, 0x90, 0xec, 0x4c, 0xfc, 0x72, 0x02, 0xf7, 0x20, 0xcf, 0xf7, 0x40, 0xac,
-	0x87, 0x75, 0xe8, 0xff, 0xa3, 0xc1, 0xf2, 0xee, 0xc0, 0xed, 0x5d, 0x7c, 0x25, 0xa9, 0x94, 0x21,
-	0x7d, 0xc2, 0x88, 0x84, 0x82, 0x91, 0x4d, 0xb2, 0x3d, 0x25, 0x9b, 0x75, 0x46, 0x15, 0x27, 0xdc,
-	0xae, 0xe3, 0x9f, 0x29, 0xe1, 0xbc, 0x0f, 0xcb, 0x38, 0x14, 0x25, 0x23, 0x4e, 0x4d, 0xc3, 0x09,
-	0xa8, 0xe7, 0x58, 0x03, 0xc4, 0x37, 0x78, 0xbf, 0xfe, 0x7d, 0xc8, 0xab, 0x04, 0x48, 0x16, 0x96,
-	0xeb, 0x86, 0xd1, 0x32, 0x4a, 0x6f, 0xb1, 0x9f, 0x1d, 0xa3, 0xdb, 0x3c, 0x28, 0x69, 0x04, 0x20,
-	0xb5, 0x6b, 0x54, 0x9b, 0xb5, 0xa7, 0xa5, 0x04, 0xc9, 0x41, 0xba, 0xd9, 0xaa, 0x1f, 0x37, 0xda,
-	0x9d, 0x52, 0x52, 0xff, 0x85, 0x06, 0x69, 0x1c, 0xde, 0xd8, 0x53, 0x38, 0x5f, 0x7a, 0x0d, 0xce,
-	0xb5, 0x45, 0x9c, 0x27, 0xe2, 0x9c, 0xdf, 0x87, 0xbc, 0x43, 0x69, 0xdf, 0xec, 0xb9, 0x4e, 0x40,
-	0x1d, 0x7e, 0xf9, 0x33, 0x46, 0x8e, 0xc1, 0x6a, 0x1c, 0xa4, 0x5b, 0x90, 0xc3, 0x35, 0x70, 0x55,
-	0xa0, 0xac, 0x23, 0x79, 0xed, 0x75, 0xac, 0xb3, 0xb1, 0xa8, 0x64, 0x12, 0x78, 0xa4, 0x44, 0x4b,
-	0xff, 0x10, 0x72, 0x35, 0x77, 0x38, 0x74, 0x1d, 0x83, 0x8e, 0x06, 0x57, 0xaf, 0xb3, 0xc9, 0xba,
-	0x09, 0x19, 0x3e, 0xa4, 0xe1, 0xbc, 0xd6, 0xa1, 0x78, 0x04, 0xb9, 0x4b, 0x9b, 0xbe, 0x34, 0xdd,
-	0x11, 0x3b, 0xa5, 0x38, 0x7f, 0x71, 0xa7, 0xc8, 0x10, 0x9f, 0xdb, 0xf4, 0x65, 0x0b, 0xa1, 0x06,
-	0x5c, 0x86, 0xbf, 0xf5, 0x9f, 0x40, 0xae, 0xe3, 0x5e, 0x50, 0x67, 0x8f, 0x06, 0x96, 0x3d, 0x78,
-	0xa5, 0x68, 0xad, 0x01, 0x5e, 0x13, 0x7e, 0xda, 0x64, 0xf3, 0x3a, 0x6a, 0x7c, 0x04, 0x85, 0x2a,
-	0x57, 0xd3, 0xd7, 0xb8, 0xfc, 0x8a, 0xaa, 0x4f, 0xc4, 0x55, 0xfd, 0x7d, 0x48, 0x9e, 0xf4, 0xfc,
-	0x72, 0x12, 0xaf, 0x13, 0xbf, 0xa0, 0x11, 0x27, 0x06, 0xeb, 0xd3, 0x1b, 0xb0, 0x8a, 0xb0, 0x7d,
-	0xd4, 0xf2, 0x82, 0x47, 0x85, 0x17, 0x2d, 0xce, 0x4b, 0x05, 0x32, 0xb6, 0xcf, 0x71, 0x71, 0xb2,
-	0x8c, 0x11, 0xb6, 0xf5, 0x2f, 0x34, 0x20, 0x33, 0xb4, 0xfc, 0x85, 0x02, 0x7b, 0x1f, 0x92, 0xc1,
-	0x69, 0x5f, 0xdc, 0xf5, 0xb5, 0x70, 0x71, 0xea, 0x60, 0x83, 0x61, 0x5c, 0x47, 0x7e, 0x5f, 0x68,
-	0x70, 0x53, 0x08, 0x70, 0x97, 0xaf, 0xf8, 0x8d, 0xc8, 0xf1, 0x21, 0x2c, 0x05, 0xa7, 0x7d, 0x29,
-	0xc8, 0xf5, 0xb9, 0x6b, 0xf5, 0x0d, 0xc4, 0xd1, 0xff, 0x52, 0x83, 0x74, 0x67, 0xd2, 0x70, 0x46,
-	0xe3, 0x80, 0xdc, 0x86, 0x8c, 0x47, 0x4f, 0x4d, 0xc5, 0x04, 0xa6, 0x3d, 0x7a, 0xda, 0x61, 0x5a,
-	0xf8, 0x6d, 0x00, 0xd6, 0xe5, 0x9e, 0x9e, 0xfa, 0x94, 0xdf, 0x82, 0x65, 0x23, 0xeb, 0xd1, 0xd3,
-	0x16, 0x02, 0xe2, 0xc6, 0x70, 0x99, 0x5b, 0xab, 0xd0, 0x18, 0x46, 0x16, 0x3c, 0x85, 0x3d, 0x0b,
-	0x2d, 0x78, 0x7a, 0x8e, 0x05, 0xff, 0x31, 0x33, 0x2d, 0xad, 0x71, 0xc0, 0xd6, 0x17, 0x11, 0xd2,
-	0x62, 0x84, 0x6e, 0x41, 0x3a, 0x70, 0xf9, 0xdc, 0x5c, 0x4d, 0xa4, 0x02, 0x17, 0x67, 0x9e, 0x99,
-	0x61, 0x69, 0xce, 0x0c, 0x2d, 0x28, 0x1e, 0x8f, 0x47, 0xdc, 0xb2, 0x5a, 0xc1, 0xd8, 0x63, 0x76,
-	0x22, 0x37, 0x1a, 0x9f, 0x0c, 0xec, 0x9e, 0x79, 0x41, 0xaf, 0x98, 0x43, 0x92, 0xdc, 0xca, 0x1b,
-	0xc0, 0x41, 0x07, 0xf4, 0xca, 0x67, 0xc6, 0xd3, 0x97, 0xd8, 0x62, 0xca, 0x08, 0xa0, 0xff, 0x6b,
-	0x0a, 0x72, 0x8a, 0x65, 0x99, 0xeb, 0x55, 0x2c, 0xd6, 0x6c, 0x5b, 0x90, 0x0d, 0x26, 0xa6, 0xcd,
-	0x36, 0x44, 0xee, 0x60, 0x8e, 0x5b, 0x16, 0xdc, 0x24, 0x23, 0x13, 0xf0, 0x1f, 0x3e, 0xf9, 0x00,
-	0x20, 0x98, 0x98, 0x2e, 0xca, 0x86, 0x59, 0x00, 0xc5, 0x08, 0x71, 0x81, 0x19, 0xd9, 0x40, 0xfc,
-	0xf2, 0x43, 0x8b, 0x9e, 0x52, 0x2c, 0x7a, 0x05, 0x32, 0x3d, 0xd7, 0x76, 0x4e, 0x2c, 0x9f, 0xa2,
-	0xec, 0x33, 0x46, 0xd8, 0xfe, 0x9d, 0xbc, 0x06, 0xc5, 0x43, 0x80, 0x98, 0x87, 0x80, 0x67, 0x75,
-	0x1c, 0xb8, 0x67, 0xd4, 0x29, 0xe7, 0x70, 0x22, 0xd9, 0x24, 0x3b, 0x50, 0x08, 0xd9, 0x35, 0xe9,
-	0x24, 0x28, 0xdf, 0x42, 0x3e, 0x8a, 0x0a, 0xcb, 0xf5, 0x49, 0x60, 0xe4, 0x24, 0xd7, 0xf5, 0x49,
-	0x40, 0xbe, 0x03, 0xc5, 0x88, 0x71, 0x1c, 0x54, 0x56, 0x54, 0x86, 0x60, 0x99, 0x8d, 0xca, 0x87,
-	0xfc, 0xb3, 0x61, 0x9f, 0xc0, 0x2a, 0x33, 0x17, 0x9e, 0xd5, 0x0b, 0x4c, 0x8f, 0xfe, 0x74, 0x4c,
-	0xfd, 0xc0, 0x2f, 0xdf, 0x8e, 0xfc, 0xa7, 0x86, 0x73, 0xe9, 0x5e, 0x50, 0x83, 0xf7, 0x18, 0x25,
-	0x89, 0x2b, 0x00, 0xb8, 0xeb, 0xb6, 0x63, 0x07, 0xb6, 0x15, 0xb8, 0x5e, 0xb9, 0x82, 0x62, 0x89,
-	0x00, 0xcc, 0x22, 0x59, 0xe3, 0xe0, 0x1c, 0x29, 0xdb, 0x1e, 0x2d, 0x6f, 0xdc, 0x4b, 0x6e, 0x65,
-	0x8d, 0x1c, 0x83, 0x19, 0x1c, 0x44, 0x1e, 0xc3, 0x4a, 0x88, 0x8f, 0x8e, 0x9d, 0x5f, 0xde, 0x8c,
-	0xa6, 0x0f, 0xcf, 0x5f, 0xc3, 0x39, 0x75, 0x8d, 0x62, 0x88, 0xc9, 0xe0, 0x3e, 0xf9, 0x01, 0x10,
-	0x95, 0xbc, 0x18, 0xfe, 0xf6, 0xa2, 0xe1, 0x25, 0x65, 0x5e, 0x4e, 0xe0, 0x9b, 0x40, 0x3c, 0xda,
-	0xa3, 0xf6, 0x25, 0xed, 0x9b, 0xd1, 0x1e, 0xde, 0xc1, 0x3d, 0x5c, 0x95, 0x3d, 0x9d, 0x70, 0x2f,
-	0x3f, 0x04, 0x98, 0xb0, 0x5b, 0xc1, 0x1d, 0xd0, 0xbb, 0xa8, 0x85, 0x08, 0xaa, 0xb2, 0xd8, 0x5d,
-	0x31, 0xb2, 0x13, 0xd9, 0x26, 0x3b, 0x90, 0x1f, 0xba, 0x7d, 0xfb, 0xf4, 0xca, 0xe4, 0x4e, 0xc6,
-	0xbd, 0xc8, 0xd1, 0x7a, 0x86, 0x70, 0xee, 0x62, 0xe4, 0x86, 0x51, 0x83, 0xbc, 0x03, 0xe9, 0xa7,
-	0x7b, 0xa6, 0xed, 0x9c, 0xba, 0xe5, 0xfb, 0x8a, 0xa6, 0xdb, 0x43, 0x26, 0x52, 0xfc, 0xaf, 0xee,
-	0x03, 0x1c, 0xd2, 0xfe, 0x19, 0xf5, 0x9e, 0xd1, 0xc0, 0x62, 0x82, 0xf6, 0x5c, 0x37, 0x30, 0xe5,
-	0xfd, 0xe1, 0xd7, 0x2a, 0xc7, 0x60, 0xbb, 0xe2, 0x0e, 0xdd, 0x85, 0x5c, 0x60, 0x8f, 0xcc, 0xf8,
-	0x0d, 0x83, 0xc0, 0x1e, 0xed, 0x46, 0xee, 0x43, 0xe0, 0x8d, 0x9d, 0x8b, 0x78, 0xec, 0x90, 0x43,
-	0x98, 0x50, 0x0b, 0xbf, 0x5c, 0x86, 0x4c, 0x37, 0x98, 0xb8, 0x38, 0xe7, 0xbb, 0x50, 0x1c, 0x58,
-	0x01, 0xf5, 0xa7, 0x67, 0x2d, 0x70, 0xa8, 0x24, 0xab, 0x43, 0x81, 0xfd, 0x62, 0x6a, 0xc3, 0x1c,
-	0xd8, 0x7e, 0x80, 0xd6, 0x22, 0x6b, 0xe4, 0x18, 0xf0, 0x80, 0x5e, 0x1d, 0xda, 0x7e, 0xc0, 0x34,
-	0xe9, 0x38, 0x98, 0xb8, 0x66, 0xe0, 0x06, 0xd6, 0x40, 0x04, 0x0e, 0x59, 0x06, 0xe9, 0x30, 0x00,
-	0xbb, 0x93, 0xd6, 0xe5, 0xd9, 0x1e, 0x1d, 0x58, 0x57, 0x42, 0x5b, 0x85, 0x6d, 0xf2, 0x7f, 0x60,
-	0x75, 0xec, 0xf4, 0x5c, 0xe7, 0xd4, 0xf6, 0x86, 0x9d, 0x49, 0x95, 0xab, 0x42, 0xee, 0xe4, 0xce,
-	0x76, 0x90, 0x07, 0x50, 0x1c, 0x5a, 0x13, 0xbe, 0x60, 0xd3, 0xb7, 0x3f, 0xa7, 0x78, 0xf7, 0x93,
-	0x46, 0x7e, 0x68, 0x4d, 0xb8, 0x6f, 0x67, 0x7f, 0x4e, 0xc9, 0xff, 0x67, 0xc7, 0xc2, 0xa7, 0xde,
-	0xa5, 0x70, 0xa6, 0xd8, 0x89, 0xf7, 0xcb, 0xe9, 0x45, 0xb7, 0x62, 0x55, 0x22, 0xd7, 0x24, 0x2e,
-	0xa3, 0x70, 0xea, 0x7a, 0x27, 0x76, 0xbf, 0x4f, 0x9d, 0x90, 0x04, 0xaa, 0x8d, 0xf9, 0x14, 0x42,
-	0x64, 0x49, 0x82, 0x7c, 0x1f, 0x36, 0x1c, 0xfa, 0xd2, 0x14, 0x01, 0x8b, 0xe9, 0x51, 0xdf, 0x1d,
-	0x7b, 0x3d, 0x6a, 0x0a, 0x65, 0xcf, 0xf5, 0x4c, 0xd9, 0xa1, 0x2f, 0x65, 0x6c, 0x23, 0x10, 0x04,
-	0xa3, 0x1f, 0xc3, 0x2d, 0xdb, 0xf3, 0x28, 0xea, 0x9a, 0x93, 0x01, 0x55, 0x9c, 0x3e, 0x54, 0x43,
-	0x49, 0x63, 0x51, 0xf7, 0xf4, 0xc8, 0xf6, 0xc0, 0xee, 0xd3, 0x17, 0xb6, 0xd3, 0x77, 0x5f, 0xa2,
-	0x9a, 0x9a, 0x1a, 0xa9, 0x74, 0x93, 0x2d, 0xc8, 0x9c, 0x59, 0xfe, 0x91, 0x67, 0xf7, 0x28, 0x06,
-	0x49, 0x42, 0xf3, 0x3e, 0x11, 0x30, 0x23, 0xec, 0x25, 0x35, 0xb8, 0x79, 0xe6, 0xb9, 0xe3, 0x91,
-	0x89, 0xc1, 0x76, 0x24, 0xa0, 0xc2, 0x22, 0x01, 0x11, 0x44, 0x47, 0x87, 0x41, 0x4a, 0x48, 0xff,
-	0x1c, 0x32, 0x92, 0x34, 0xb3, 0xd2, 0xbd, 0xd1, 0xd8, 0xf4, 0xac, 0x80, 0xbb, 0x28, 0x49, 0x23,
-	0xdd, 0x1b, 0x8d, 0x0d, 0x2b, 0xc0, 0xae, 0x21, 0x1d, 0xf2, 0x2e, 0xee, 0xa9, 0xa6, 0x87, 0x74,
-	0x88, 0x5d, 0x1b, 0x90, 0xed, 0xdb, 0xfe, 0x05, 0xef, 0x4b, 0x86, 0x81, 0xd1, 0x85, 0xec, 0x9c,
-	0x9c, 0x52, 0xca, 0x3b, 0xc5, 0xa9, 0x63, 0x00, 0xd6, 0xa9, 0xff, 0xf3, 0x32, 0x14, 0x62, 0x41,
-	0x82, 0xaa, 0xe7, 0xb5, 0xb8, 0x9e, 0x0f, 0xad, 0x06, 0xf7, 0x10, 0x84, 0xd5, 0x58, 0x1c, 0xc0,
-	0xdc, 0x86, 0xcc, 0xc8, 0xa3, 0xe6, 0xb9, 0xe5, 0x9f, 0xe3, 0xbc, 0x79, 0x23, 0x3d, 0xf2, 0xe8,
-	0x53, 0xcb, 0x3f, 0x67, 0x17, 0x61, 0xe4, 0xb9, 0x23, 0xd7, 0xa7, 0xa1, 0x47, 0x21, 0xdb, 0xcc,
-	0x98, 0xa1, 0x5a, 0x12, 0xc6, 0x8c, 0xfd, 0x66, 0xce, 0x81, 0x88, 0xb6, 0xd3, 0xdc, 0x07, 0x18,
-	0x85, 0x91, 0xf6, 0x90, 0x7a, 0x17, 0x03, 0x6a, 0x32, 0x0d, 0x81, 0xe7, 0x32, 0x6f, 0x00, 0x07,
-	0x19, 0xae, 0x1b, 0x28, 0xce, 0x7d, 0x56, 0x75, 0xee, 0xe3, 0xb6, 0x0e, 0xa6, 0x6d, 0xdd, 0xb7,
-	0x98, 0x06, 0x09, 0x6d, 0xbc, 0x5f, 0xce, 0x29, 0x16, 0x48, 0x89, 0x2a, 0x63, 0x48, 0x8c, 0xdd,
-	0x60, 0x62, 0xf2, 0xc0, 0x3d, 0xcf, 0x25, 0x17, 0x4c, 0x6a, 0x78, 0x88, 0xa3, 0x65, 0x06, 0x1e,
-	0xa5, 0xe5, 0x02, 0xf7, 0x39, 0x38, 0xa8, 0xe3, 0x51, 0x14, 0x62, 0x6f, 0xec, 0x75, 0xa8, 0x37,
-	0x2c, 0x97, 0xc4, 0xae, 0xf3, 0x26, 0xb9, 0x07, 0xb9, 0xde, 0xd8, 0xc3, 0xad, 0x69, 0x8e, 0x87,
-	0xe5, 0x55, 0xae, 0xcb, 0x14, 0x10, 0xf9, 0x01, 0xc0, 0xa9, 0x65, 0x0f, 0x98, 0xe6, 0x9f, 0xf8,
-	0x65, 0x82, 0x4b, 0xbd, 0x37, 0x13, 0xfc, 0x6d, 0xef, 0x23, 0x4e, 0x67, 0xe2, 0xd7, 0x9d, 0xc0,
-	0xbb, 0x32, 0xb2, 0xa7, 0xb2, 0x4d, 0xee, 0x00, 0x04, 0x96, 0x77, 0x46, 0x83, 0x5d, 0x3b, 0xf0,
-	0xcb, 0x37, 0x70, 0xe9, 0x0a, 0x84, 0x6c, 0x41, 0xfa, 0x87, 0x63, 0x3f, 0xb0, 0x4f, 0xaf, 0xca,
-	0x37, 0xf1, 0x5c, 0xa3, 0xfd, 0xfe, 0x74, 0xec, 0x7a, 0xe3, 0x61, 0x8d, 0x7a, 0x81, 0x21, 0xbb,
-	0x99, 0x08, 0x6c, 0xc7, 0x44, 0x45, 0x8b, 0x69, 0x8d, 0x8c, 0x91, 0xb6, 0x9d, 0x0e, 0x6b, 0xb2,
-	0x53, 0xe8, 0xd0, 0x49, 0xc0, 0x4f, 0xc3, 0x0a, 0xdf, 0x72, 0x06, 0x60, 0xc7, 0xa1, 0xf2, 0x3d,
-	0x28, 0xc6, 0x97, 0x47, 0x4a, 0x90, 0x64, 0xbb, 0xcd, 0xbd, 0x74, 0xf6, 0x93, 0x9d, 0xbe, 0x4b,
-	0x6b, 0x30, 0x96, 0x11, 0x0d, 0x6f, 0x3c, 0x4e, 0x7c, 0xac, 0xe9, 0xbf, 0xd5, 0x20, 0xb3, 0x5b,
-	0x7b, 0x03, 0x19, 0x0a, 0x1d, 0x96, 0x86, 0x34, 0xb0, 0x44, 0x0c, 0x89, 0x5c, 0x46, 0xa6, 0xc9,
-	0xc0, 0xbe, 0x28, 0xca, 0x5e, 0x7a, 0x75, 0x94, 0xcd, 0x94, 0xc8, 0x58, 0x58, 0x18, 0x11, 0x91,
-	0xa3, 0x12, 0x91, 0x56, 0xc7, 0x08, 0x7b, 0xc9, 0x03, 0x28, 0x9c, 0x78, 0x96, 0xd3, 0x3b, 0x17,
-	0x96, 0x06, 0xd3, 0x3e, 0x59, 0x23, 0x0e, 0xd4, 0xdb, 0x90, 0xdb, 0xad, 0x75, 0xec, 0xd1, 0x35,
-	0xf8, 0xbc, 0x07, 0x79, 0xdb, 0xe7, 0xdb, 0x61, 0x06, 0xf6, 0x48, 0x04, 0x49, 0x60, 0xfb, 0xb8,
-	0x25, 0x1d, 0x7b, 0x84, 0x44, 0x19, 0x7d, 0x54, 0x48, 0xaf, 0x4b, 0x34, 0x87, 0x0c, 0xa2, 0xc6,
-	0xf3, 0xa5, 0x11, 0x54, 0x40, 0xfa, 0x17, 0x09, 0x48, 0xb5, 0x47, 0x94, 0xf6, 0x7d, 0xf2, 0x11,
-	0x64, 0xdb, 0xe3, 0x21, 0x6f, 0xa0, 0xab, 0x9d, 0xdb, 0xb9, 0x8d, 0xfe, 0x0c, 0x42, 0xb6, 0xc3,
-	0x3e, 0x71, 0x26, 0xc3, 0x36, 0xf9, 0x36, 0x64, 0x76, 0x7b, 0x62, 0x1c, 0x8f, 0xca, 0xca, 0xca,
-	0x38, 0xd9, 0xc5, 0x87, 0x85, 0x98, 0xec, 0x1c, 0xc5, 0x49, 0x7e, 0xd9, 0x39, 0xd2, 0x94, 0x73,
-	0x54, 0x69, 0x40, 0x21, 0x46, 0x78, 0xce, 0x60, 0x5d, 0x1d, 0x2c, 0x76, 0x74, 0xb7, 0xc6, 0xc7,
-	0xa8, 0x47, 0xf2, 0x67, 0x78, 0x22, 0x39, 0x2b, 0xdf, 0x82, 0xb4, 0x20, 0xab, 0x4a, 0x40, 0x76,
-	0x4b, 0x5e, 0x38, 0x2b, 0x12, 0xb3, 0xf2, 0x18, 0xf2, 0x6a, 0xc7, 0x75, 0xf8, 0xd0, 0xff, 0x4a,
-	0x83, 0x42, 0xfb, 0xca, 0x0f, 0xe8, 0xf0, 0x3a, 0x91, 0xfb, 0x07, 0x00, 0x27, 0x3d, 0xdf, 0x14,
-	0x29, 0x27, 0x25, 0xeb, 0x25, 0xaf, 0x96, 0x91, 0x3d, 0xe9, 0x29, 0x04, 0x7d, 0xbe, 0x39, 0x4a,
-	0xbe, 0x45, 0x88, 0x41, 0xf4, 0xa0, 0x8e, 0xa7, 0xd4, 0xeb, 0x7a, 0x03, 0x1e, 0xbf, 0x64, 0x8d,
-	0xb0, 0xad, 0x7b, 0x40, 0x62, 0x2b, 0x7c, 0xed, 0x14, 0x0b, 0xf9, 0x18, 0x8a, 0x3e, 0x1f, 0x19,
-	0x2d, 0x35, 0xbc, 0x88, 0x71, 0x9a, 0x05, 0x5f, 0x6d, 0xea, 0x06, 0xdc, 0xac, 0xb9, 0x8e, 0x4f,
-	0x1d, 0x7f, 0x8c, 0x20, 0x61, 0x92, 0xbf, 0x8a, 0xc6, 0xd0, 0x7f, 0xad, 0xc1, 0x4a, 0x8c, 0xe8,
-	0xeb, 0x87, 0xf7, 0xd2, 0xc8, 0x8a, 0xf0, 0x5e, 0x1a, 0xd9, 0x77, 0xa1, 0xd8, 0x93, 0x04, 0x4d,
-	0x9c, 0x91, 0x7b, 0x91, 0x85, 0x10, 0xda, 0x64, 0xaa, 0xea, 0x3e, 0xe4, 0xfd, 0xc0, 0xf2, 0x02,
-	0x35, 0xf6, 0xcd, 0x1a, 0x39, 0x84, 0x09, 0xff, 0xe7, 0x7d, 0x58, 0xb9, 0xb4, 0x06, 0x76, 0x9f,
-	0x85, 0x19, 0x3e, 0xf7, 0xc2, 0x79, 0x26, 0xba, 0x18, 0x81, 0xd1, 0x03, 0xdf, 0x83, 0x94, 0x61,
-	0xbd, 0xec, 0x7a, 0x83, 0xd7, 0x15, 0x85, 0x87, 0xd8, 0x52, 0x14, 0xbc, 0xa5, 0xff, 0x52, 0x83,
-	0x25, 0xa6, 0xdc, 0x16, 0x06, 0xf2, 0xeb, 0x20, 0x22, 0xf7, 0xa9, 0x38, 0xbe, 0x02, 0x99, 0xc0,
-	0xe5, 0x99, 0x73, 0xe1, 0x41, 0x84, 0x6d, 0x26, 0x27, 0x91, 0xa4, 0x90, 0x1e, 0x84, 0xcc, 0x59,
-	0x6c, 0x42, 0x94, 0xa1, 0x40, 0xbe, 0xd4, 0x94, 0x85, 0xfe, 0xef, 0x1a, 0x64, 0xd9, 0x62, 0x78,
-	0xea, 0xe3, 0x2b, 0xe6, 0x67, 0x65, 0x22, 0x26, 0x19, 0x4f, 0xc4, 0x6c, 0x42, 0x96, 0x67, 0x0d,
-	0xa2, 0x47, 0x80, 0x08, 0x80, 0x0e, 0x06, 0xf3, 0xf9, 0x9b, 0xec, 0xde, 0x73, 0xb9, 0x47, 0x00,
-	0xc6, 0xb3, 0xcc, 0xf7, 0x0b, 0x8f, 0x26, 0xca, 0xff, 0x57, 0x20, 0xe3, 0x50, 0xda, 0x3f, 0x64,
-	0x46, 0x26, 0xc3, 0x03, 0x77, 0xd9, 0xd6, 0x7f, 0x0e, 0xc0, 0xd8, 0x12, 0x29, 0x93, 0xd7, 0xe1,
-	0xeb, 0x01, 0x37, 0x43, 0x87, 0x32, 0x60, 0xc9, 0xed, 0x64, 0xa4, 0x19, 0x32, 0xc2, 0x1e, 0x66,
-	0x82, 0x70, 0x71, 0x6d, 0x3a, 0xa0, 0xbd, 0x80, 0xf6, 0xe5, 0xa1, 0x8b, 0x01, 0xf5, 0xbf, 0xd6,
-	0xa0, 0xd8, 0xb4, 0x02, 0xfb, 0x92, 0xd6, 0xdc, 0x3e, 0xdd, 0xa3, 0x7e, 0x8f, 0x39, 0x6b, 0x4a,
-	0x3a, 0x6d, 0x49, 0x8a, 0x6c, 0xc1, 0xe1, 0x5e, 0x87, 0x54, 0xdf, 0x3e, 0xa3, 0x7e, 0x20, 0x36,
-	0x5a, 0xb4, 0x98, 0x4d, 0x19, 0x79, 0xf4, 0xf2, 0xb9, 0x18, 0x25, 0x0e, 0xb3, 0x02, 0x22, 0x5b,
-	0xb0, 0x82, 0xb1, 0x68, 0x75, 0x64, 0x4b, 0x2c, 0xbe, 0xe9, 0xd3, 0x60, 0xb6, 0xc8, 0xfc, 0x0b,
-	0xcb, 0x1f, 0x86, 0x4b, 0x64, 0x67, 0x68, 0xec, 0x30, 0xe7, 0x4e, 0x26, 0x10, 0x45, 0x93, 0xa7,
-	0x48, 0x86, 0x23, 0x7b, 0x40, 0x3d, 0xf9, 0xde, 0x25, 0xdb, 0x0b, 0x97, 0x7a, 0x17, 0x72, 0x97,
-	0x43, 0x33, 0x1c, 0x26, 0x1e, 0x7f, 0x2e, 0x87, 0x35, 0x39, 0xf0, 0x1d, 0x28, 0x84, 0x89, 0x88,
-	0xe0, 0x6a, 0x44, 0xc5, 0xe6, 0xe7, 0x25, 0xb0, 0x73, 0x35, 0xa2, 0xfa, 0x00, 0x4a, 0x91, 0x20,
-	0x85, 0xde, 0x78, 0x4f, 0x24, 0x71, 0xb4, 0x28, 0x1c, 0x8f, 0x0b, 0x5b, 0x24, 0x76, 0xd6, 0xc3,
-	0x77, 0x01, 0xee, 0x87, 0xcb, 0xfc, 0x7f, 0x19, 0xd2, 0xe7, 0xd4, 0x1a, 0x04, 0xe7, 0x57, 0x22,
-	0x61, 0x2e, 0x9b, 0x7a, 0x1b, 0xd6, 0xf6, 0x46, 0xae, 0x5f, 0xb3, 0x9c, 0x3e, 0xbb, 0xf7, 0xd4,
-	0x7f, 0x13, 0xaa, 0xaf, 0x0f, 0xeb, 0xd3, 0x44, 0xfd, 0x11, 0xd3, 0x51, 0xaf, 0x45, 0xf5, 0x3d,
-	0x28, 0xf6, 0xc2, 0x91, 0x4c, 0x0b, 0x09, 0x47, 0x62, 0x0a, 0xaa, 0x7b, 0x50, 0x61, 0xb3, 0x34,
-	0xdd, 0xa1, 0xed, 0x58, 0x01, 0x35, 0x68, 0xcf, 0xf5, 0xfa, 0x6f, 0x62, 0xfd, 0x8b, 0x2f, 0xb6,
-	0xbe, 0x07, 0x25, 0x75, 0x4e, 0xb6, 0x0e, 0x76, 0x9d, 0xc3, 0x95, 0x89, 0x63, 0x14, 0x01, 0xc2,
-	0x24, 0x20, 0x9f, 0x01, 0x7f, 0xeb, 0x7f, 0xac, 0xc1, 0xc6, 0xdc, 0xa5, 0x5f, 0x43, 0x4a, 0x9f,
-	0xc0, 0x8a, 0x13, 0x1f, 0x2e, 0xee, 0xf0, 0x4d, 0x86, 0x3c, 0xbd, 0x48, 0x63, 0x1a, 0x59, 0xff,
-	0x29, 0xdc, 0x0e, 0x91, 0xe8, 0xd7, 0x23, 0xbc, 0x8e, 0xb2, 0x61, 0xf4, 0x77, 0x62, 0x7a, 0x9e,
-	0x30, 0x1d, 0x7e, 0xd8, 0x9e, 0xbb, 0x5f, 0xd3, 0x11, 0xf8, 0x04, 0xe0, 0x32, 0x9c, 0xeb, 0x77,
-	0xd8, 0xfc, 0x97, 0x70, 0x6b, 0x66, 0xbd, 0xd7, 0x10, 0xc1, 0xc7, 0xb0, 0xc2, 0xa6, 0x67, 0x86,
-	0x2e, 0xbe, 0xef, 0x18, 0x93, 0x44, 0x2b, 0x33, 0xa6, 0xd1, 0x74, 0x37, 0x9a, 0xb8, 0xff, 0xb5,
-	0x48, 0xea, 0x23, 0xc8, 0x5d, 0x46, 0x93, 0xa1, 0x57, 0xea, 0x06, 0x62, 0x0e, 0x16, 0xa5, 0xb1,
-	0xc6, 0x5c, 0x11, 0xfd, 0x0c, 0xca, 0xb3, 0x2b, 0xbd, 0x86, 0x8c, 0xbe, 0x0b, 0x25, 0x9c, 0x78,
-	0x56, 0x48, 0x2b, 0x52, 0x48, 0x02, 0x6e, 0xcc, 0x20, 0xea, 0x36, 0x17, 0x53, 0xed, 0x9c, 0xf6,
-	0x2e, 0x0c, 0xea, 0x8f, 0x07, 0xc1, 0x1b, 0x11, 0x13, 0xe3, 0x93, 0xc5, 0xf0, 0x3c, 0x05, 0x83,
-	0xbf, 0xf5, 0x80, 0xf3, 0x19, 0x9f, 0xea, 0x9a, 0xd7, 0x81, 0xd1, 0x4c, 0x44, 0x34, 0x31, 0x29,
-	0x10, 0xd1, 0xc3, 0x87, 0x84, 0xac, 0xa1, 0x82, 0xf4, 0x16, 0xac, 0xb2, 0x59, 0xa5, 0x77, 0xfd,
-	0xd5, 0xd5, 0xfd, 0x8f, 0x81, 0xa8, 0x04, 0xaf, 0xa5, 0xea, 0x53, 0x31, 0x4f, 0xbd, 0x28, 0x75,
-	0x57, 0xfc, 0xfd, 0x5a, 0xff, 0x95, 0x06, 0x10, 0x81, 0x43, 0xbe, 0x35, 0x85, 0xef, 0x0d, 0xc8,
-	0xf2, 0x8c, 0xa7, 0x33, 0x96, 0x02, 0xc9, 0x9c, 0xc8, 0x3c, 0x88, 0x9a, 0x53, 0x12, 0x25, 0x1b,
-	0x61, 0x4e, 0xe9, 0x3e, 0xe4, 0xe5, 0x6f, 0x1c, 0xcb, 0xd3, 0x60, 0x39, 0x09, 0x63, 0xc3, 0xa7,
-	0x64, 0xba, 0x3c, 0x2b, 0xd3, 0x7f, 0xd2, 0xa0, 0x24, 0xb2, 0x79, 0x47, 0xb5, 0x37, 0x71, 0x5c,
-	0xbe, 0x09, 0x99, 0xf0, 0xa9, 0x22, 0xb9, 0x28, 0x29, 0x1b, 0xa2, 0xc4, 0x9f, 0x28, 0x96, 0xbe,
-	0xec, 0x89, 0x62, 0x79, 0xe6, 0x89, 0x42, 0xff, 0x23, 0x58, 0x55, 0xd6, 0x7f, 0x8d, 0x2d, 0x5c,
-	0xc4, 0xc0, 0x36, 0x63, 0x80, 0xd3, 0x11, 0x81, 0x20, 0x51, 0x19, 0xe0, 0x3d, 0x46, 0x88, 0xa3,
-	0xff, 0x7d, 0x02, 0x0a, 0x31, 0xee, 0x30, 0x33, 0xe6, 0xf6, 0xc7, 0x03, 0x6a, 0x2a, 0x6e, 0x24,
-	0x70, 0x10, 0x06, 0x3a, 0xaa, 0x3b, 0xa5, 0xac, 0x20, 0x74, 0xa7, 0x10, 0x09, 0xf3, 0x6b, 0xc1,
-	0xb9, 0xdb, 0x57, 0x23, 0x26, 0xe0, 0x20, 0x44, 0x78, 0x04, 0x4b, 0x96, 0x77, 0x26, 0xdf, 0xd1,
-	0x36, 0x66, 0xa4, 0xbc, 0x5d, 0xf5, 0xce, 0x44, 0x36, 0x01, 0x11, 0xc9, 0x63, 0x58, 0x09, 0x33,
-	0xd5, 0x03, 0x7b, 0x68, 0x07, 0x3e, 0x0a, 0x54, 0xec, 0x90, 0xcc, 0x51, 0x1f, 0xb2, 0x1e, 0xa3,
-	0xe8, 0xa9, 0x4d, 0x7f, 0xea, 0x49, 0x34, 0x2c, 0x6a, 0xaa, 0x7c, 0x04, 0xd9, 0x70, 0x9a, 0x2f,
-	0x0b, 0xe8, 0xf3, 0x6a, 0x40, 0xff, 0x9f, 0x09, 0x28, 0xc6, 0x65, 0xca, 0x2e, 0x95, 0x78, 0x45,
-	0xd4, 0xe6, 0x3e, 0xa9, 0x89, 0x5e, 0xf2, 0x0d, 0x48, 0xcb, 0x37, 0xc4, 0xc4, 0xfc, 0x67, 0x34,
-	0xd9, 0xcf, 0xee, 0x8f, 0xb2, 0x99, 0x49, 0x16, 0x93, 0xc8, 0x36, 0xb9, 0x8d, 0x19, 0x71, 0x73,
-	0xec, 0xd3, 0xbe, 0xb8, 0x3b, 0xe9, 0x33, 0xcb, 0xef, 0xfa, 0xb4, 0x1f, 0x3b, 0xc4, 0xcb, 0x5f,
-	0x7e, 0x88, 0x77, 0x58, 0xdc, 0xc6, 0xa9, 0xfa, 0xa2, 0xbe, 0x09, 0x9d, 0x99, 0x5a, 0xf8, 0x20,
-	0x27, 0x4e, 0x4d, 0x84, 0x46, 0x3e, 0xe0, 0xaf, 0x2a, 0xfc, 0x8d, 0x50, 0x3c, 0x5f, 0xc4, 0x9e,
-	0x4d, 0x95, 0x6e, 0xb2, 0x0d, 0xb9, 0x71, 0x18, 0x22, 0xf9, 0xe5, 0xcc, 0x9c, 0x97, 0x53, 0x15,
-	0x41, 0x1f, 0x01, 0x44, 0x72, 0xc3, 0x93, 0x3e, 0xee, 0x5d, 0xd0, 0x20, 0x2c, 0x10, 0xc0, 0x96,
-	0xdc, 0x2e, 0xbe, 0x35, 0xb8, 0x5d, 0xea, 0x7b, 0x7a, 0xf2, 0x55, 0xef, 0xe9, 0x4b, 0xd3, 0xc1,
-	0xe9, 0x33, 0xc8, 0x29, 0x1b, 0x70, 0x8d, 0x29, 0xc3, 0x13, 0x92, 0x54, 0x4e, 0x88, 0x5e, 0x85,
-	0x42, 0xec, 0x79, 0x90, 0xe9, 0x89, 0x23, 0xf9, 0x9c, 0x2d, 0xdd, 0x95, 0x10, 0xc0, 0xf4, 0x2a,
-	0x86, 0x9d, 0x9c, 0x2e, 0xfe, 0xd6, 0x7f, 0x04, 0x2b, 0x47, 0xd4, 0x1b, 0xda, 0x3e, 0x8b, 0xa0,
-	0x9e, 0xb9, 0x7d, 0x3a, 0x60, 0xd1, 0x88, 0x37, 0x1e, 0xf0, 0x1b, 0x59, 0xe4, 0xd7, 0x3a, 0x42,
-	0x31, 0xc6, 0x03, 0x6a, 0x60, 0x3f, 0x53, 0x9b, 0x56, 0xaf, 0x47, 0x47, 0xc1, 0x73, 0x25, 0x19,
-	0xa5, 0x82, 0xf4, 0xdb, 0xb0, 0x5c, 0xbd, 0x68, 0x73, 0x86, 0xac, 0x0b, 0x7e, 0x60, 0xb3, 0x06,
-	0xfb, 0xa9, 0xff, 0x85, 0x06, 0x29, 0xec, 0xf3, 0xc9, 0x16, 0x2c, 0xf9, 0x34, 0x3c, 0xce, 0x78,
-	0x24, 0x78, 0xcf, 0x36, 0xfb, 0x47, 0x5c, 0x4d, 0x86, 0x41, 0xee, 0x00, 0xd0, 0xc9, 0x88, 0x39,
-	0x1f, 0x51, 0x84, 0xa9, 0x40, 0x2a, 0xbb, 0x90, 0x0d, 0x87, 0xcc, 0xb9, 0x66, 0x77, 0xe3, 0x29,
-	0xbc, 0x6c, 0x38, 0x93, 0x7a, 0xe3, 0x7e, 0xad, 0x41, 0xb2, 0xda, 0x1b, 0x90, 0x77, 0x20, 0x31,
-	0x1a, 0x0a, 0xc5, 0x78, 0x23, 0x2e, 0x03, 0x14, 0x93, 0x91, 0x18, 0x0d, 0xc9, 0xb7, 0x21, 0x6b,
-	0x5d, 0xf8, 0x2f, 0x64, 0x0d, 0x51, 0x58, 0x96, 0x51, 0xed, 0x0d, 0xb6, 0xab, 0xb2, 0x43, 0x64,
-	0x38, 0x43, 0x44, 0xa6, 0x77, 0x2d, 0x64, 0x50, 0x4d, 0xa1, 0x71, 0x96, 0x0d, 0xd1, 0x53, 0xf9,
-	0x1e, 0x14, 0xe3, 0x04, 0xae, 0x95, 0x07, 0xfc, 0x6f, 0x0d, 0xb2, 0xd5, 0xde, 0xe0, 0x0d, 0x24,
-	0xc6, 0xf9, 0x26, 0x33, 0x25, 0xd6, 0x8c, 0xf4, 0xab, 0x0a, 0x22, 0x3a, 0xc4, 0x34, 0xb2, 0x30,
-	0x4f, 0x71, 0x2d, 0x7d, 0x07, 0x14, 0x95, 0x2c, 0xab, 0x22, 0x15, 0x25, 0xcd, 0xdc, 0x6c, 0xfe,
-	0xcc, 0x49, 0xfb, 0xa8, 0x3a, 0x33, 0x46, 0x04, 0x20, 0xb7, 0x21, 0x69, 0xf5, 0x06, 0xa2, 0xc0,
-	0x2f, 0x2d, 0xe4, 0x6b, 0x30, 0x98, 0xfe, 0x27, 0x1a, 0xe4, 0x1b, 0x7d, 0xea, 0x04, 0x76, 0x70,
-	0x55, 0x1d, 0x07, 0xe7, 0xe1, 0x13, 0x92, 0x36, 0xf7, 0x09, 0x29, 0x11, 0x7b, 0x42, 0x22, 0xb0,
-	0xa4, 0x54, 0x79, 0xe2, 0x6f, 0xc4, 0xa5, 0xd4, 0x6b, 0xec, 0x09, 0x3e, 0x44, 0x2b, 0xfe, 0x6a,
-	0x24, 0x93, 0x3a, 0x12, 0xa0, 0x7f, 0x07, 0x0a, 0xea, 0x2a, 0x7c, 0xf2, 0x00, 0x96, 0x98, 0xf9,
-	0x15, 0x67, 0xba, 0x84, 0x6a, 0x51, 0x41, 0x30, 0xb0, 0x57, 0x3f, 0x80, 0x42, 0xcc, 0x9e, 0xb0,
-	0x61, 0x98, 0x38, 0xe0, 0x57, 0xaf, 0xa4, 0x1a, 0x9c, 0xce, 0xd5, 0x88, 0x1a, 0xd8, 0x8b, 0x35,
-	0xbc, 0x0c, 0x5d, 0xf8, 0x41, 0xbc, 0xa1, 0xdb, 0xb0, 0x5a, 0x3d, 0xd8, 0x09, 0x9f, 0x52, 0x7f,
-	0x9f, 0x9e, 0xff, 0x4f, 0x80, 0xa8, 0x53, 0xbd, 0x01, 0x77, 0xa2, 0x1c, 0x55, 0xbe, 0x72, 0x97,
-	0x56, 0x36, 0x75, 0x0f, 0x2a, 0x4f, 0x68, 0x20, 0xe6, 0x0a, 0x5f, 0xa7, 0xdf, 0x14, 0x7f, 0xe1,
-	0x9c, 0x9a, 0x3a, 0xe7, 0x17, 0x1a, 0x6c, 0xcc, 0x9d, 0xf4, 0x1a, 0x9c, 0x7e, 0x1f, 0xc2, 0x4a,
-	0x93, 0xa9, 0xd4, 0x3a, 0x51, 0x8d, 0x9e, 0xf0, 0x84, 0x57, 0x42, 0x5c, 0x91, 0xb2, 0xfe, 0x3b,
-	0x0d, 0x8a, 0x71, 0x9c, 0x59, 0x7f, 0x48, 0x9b, 0x73, 0xd3, 0xe6, 0xc4, 0x5b, 0x61, 0x8d, 0x50,
-	0x52, 0xa9, 0x11, 0xda, 0x80, 0xac, 0xed, 0x9b, 0x27, 0x96, 0xe3, 0x08, 0xbb, 0x8e, 0x25, 0x74,
-	0xbb, 0xd8, 0x9e, 0x3d, 0xec, 0xd3, 0xe5, 0x40, 0x32, 0xab, 0x96, 0x8a, 0x65, 0xd5, 0xf4, 0x3f,
-	0x4d, 0xc0, 0xe6, 0x91, 0x47, 0xeb, 0x13, 0xda, 0x7b, 0x61, 0x07, 0xe7, 0x3c, 0x7b, 0xd8, 0xed,
-	0x1c, 0xb7, 0x7e, 0xaf, 0xc7, 0x91, 0xe9, 0x28, 0xcc, 0x56, 0x8a, 0xca, 0x09, 0xe1, 0xe1, 0x2b,
-	0x20, 0xe6, 0xa9, 0x30, 0x4d, 0x80, 0xd9, 0xa6, 0x94, 0xf2, 0x68, 0x10, 0xab, 0xad, 0x09, 0x51,
-	0x62, 0x79, 0xd8, 0x74, 0x3c, 0x0f, 0x4b, 0xb6, 0x21, 0x2d, 0x3c, 0x1a, 0xf1, 0xb6, 0x77, 0x53,
-	0xf1, 0x79, 0xc2, 0xe0, 0xc0, 0x90, 0x48, 0xfa, 0x3f, 0x6a, 0xf0, 0xf6, 0x02, 0x99, 0x7c, 0xfd,
-	0x6e, 0x38, 0xd9, 0xe6, 0xfe, 0x14, 0x77, 0x41, 0xc4, 0x43, 0x66, 0x51, 0x66, 0x85, 0x85, 0x8f,
-	0xa4, 0x60, 0xe8, 0xc7, 0x50, 0x9a, 0x76, 0xcf, 0x94, 0x2c, 0xa4, 0x36, 0x9d, 0x85, 0x1c, 0x52,
-	0xdf, 0xb7, 0xce, 0xc2, 0xd2, 0x53, 0xd1, 0x64, 0x07, 0xf0, 0xc4, 0xed, 0xcb, 0x1c, 0x3f, 0xfe,
-	0xd6, 0xff, 0x46, 0x83, 0x9c, 0x52, 0x3e, 0x44, 0xde, 0x85, 0x22, 0x3d, 0x3d, 0xa5, 0xbd, 0xc0,
-	0xbe, 0xa4, 0x51, 0xa9, 0x62, 0xd6, 0x28, 0x84, 0xd0, 0x8e, 0x28, 0xdb, 0x1f, 0x5a, 0xde, 0x05,
-	0xed, 0x8b, 0x27, 0x4d, 0xd1, 0x22, 0xdf, 0x80, 0x52, 0x34, 0x3c, 0x56, 0xfd, 0xb3, 0x12, 0xc2,
-	0xc5, 0xeb, 0xc8, 0xdb, 0x00, 0x51, 0x19, 0x60, 0x3c, 0x7d, 0x2f, 0xbc, 0x24, 0xb4, 0x20, 0x5c,
-	0xc9, 0xe3, 0x6f, 0xfd, 0x53, 0x10, 0x35, 0x4b, 0x44, 0x87, 0xc2, 0x79, 0xdf, 0x54, 0xc6, 0x8b,
-	0x32, 0xa5, 0xf3, 0x7e, 0xe4, 0x67, 0xbd, 0x03, 0x05, 0xd7, 0xb3, 0xcf, 0x6c, 0xc7, 0x1a, 0xf0,
-	0x47, 0x6f, 0x6e, 0x76, 0xf2, 0x12, 0xf8, 0xd4, 0xf2, 0xcf, 0xf5, 0x7f, 0x49, 0x40, 0x09, 0x53,
-	0xf1, 0x98, 0x97, 0x10, 0x15, 0xaf, 0xbf, 0x5f, 0x4b, 0xfd, 0xff, 0xa0, 0xe8, 0x8e, 0xa8, 0x13,
-	0xcd, 0x3a, 0x7d, 0x00, 0x44, 0xd2, 0x64, 0x0a, 0x8b, 0x3c, 0x86, 0x12, 0xdb, 0x22, 0xda, 0x57,
-	0x46, 0x2e, 0xcf, 0x1d, 0x39, 0x83, 0xc7, 0xc6, 0xf2, 0xaa, 0x4c, 0x65, 0x6c, 0x6a, 0xfe, 0xd8,
-	0x69, 0x3c, 0xe6, 0x59, 0xf4, 0x6d, 0x7f, 0x34, 0xb0, 0xae, 0xb0, 0x96, 0x42, 0xd6, 0x91, 0xaa,
-	0x30, 0xfd, 0x82, 0x3f, 0x8b, 0x44, 0xe9, 0x3a, 0x76, 0x78, 0x6b, 0xe1, 0x1b, 0x94, 0xa8, 0xc1,
-	0xe2, 0xb5, 0x18, 0x77, 0xf8, 0xe1, 0xaf, 0xaa, 0x9f, 0x9d, 0x28, 0x10, 0x72, 0x17, 0x96, 0xec,
-	0x80, 0x0e, 0xd5, 0xea, 0x4c, 0x46, 0xfb, 0x80, 0x5e, 0x19, 0xd8, 0xa1, 0xb7, 0x21, 0x2d, 0x00,
-	0xea, 0xf3, 0x94, 0x7c, 0x5a, 0x10, 0x21, 0xc0, 0x3a, 0xa4, 0x94, 0x72, 0xda, 0xac, 0x21, 0x5a,
-	0x4a, 0x6c, 0x98, 0x54, 0x63, 0x43, 0xbd, 0x0b, 0xb7, 0x54, 0x45, 0xbf, 0x67, 0x05, 0xd6, 0x9b,
-	0xc8, 0xda, 0x7c, 0xa1, 0x41, 0x79, 0x96, 0xee, 0x1b, 0x50, 0x39, 0x5b, 0xb0, 0xd4, 0xb7, 0xc2,
-	0x52, 0x89, 0x9b, 0xd3, 0xc6, 0x0c, 0xe7, 0x41, 0x0c, 0xfd, 0x0f, 0x22, 0xe5, 0x21, 0x7b, 0xd8,
-	0x9e, 0x5a, 0xd2, 0xac, 0xca, 0x4d, 0x4a, 0x1a, 0x31, 0x18, 0x79, 0x10, 0x19, 0xba, 0x5a, 0xb8,
-	0x55, 0x49, 0x23, 0x0e, 0xd4, 0xff, 0x4c, 0x83, 0x5b, 0xa2, 0xc8, 0xfa, 0x8d, 0xbb, 0x05, 0xf3,
-	0xed, 0xcc, 0xf4, 0xc7, 0x09, 0x4b, 0xb3, 0x1f, 0x27, 0x1c, 0x40, 0x5e, 0x2e, 0x06, 0x5f, 0xd7,
-	0xbe, 0x0b, 0xa1, 0x65, 0x37, 0x43, 0xa5, 0xb9, 0xc8, 0x09, 0x28, 0xf6, 0x62, 0x6d, 0xfd, 0x3f,
-	0x34, 0x28, 0xcf, 0x72, 0x78, 0x8d, 0x2d, 0x6c, 0xa0, 0x5b, 0x2d, 0x6a, 0xff, 0xb8, 0xf3, 0xf1,
-	0x01, 0xba, 0xcf, 0x0b, 0x88, 0x86, 0x0b, 0x92, 0x55, 0x19, 0xe1, 0xe8, 0x4a, 0x33, 0x72, 0x47,
-	0x16, 0xc6, 0x57, 0xef, 0xc5, 0xe3, 0xab, 0x92, 0xca, 0x22, 0x93, 0x86, 0x1a, 0xa1, 0xfc, 0x83,
-	0x06, 0xab, 0x35, 0xcf, 0xf5, 0xfd, 0x4f, 0xc7, 0xd4, 0xbb, 0x92, 0xfb, 0xb6, 0xa8, 0x48, 0x3f,
-	0xe6, 0x90, 0x24, 0xa6, 0x1d, 0x92, 0x58, 0x76, 0x2c, 0xf9, 0x65, 0xd9, 0xb1, 0xa5, 0xd9, 0x02,
-	0xde, 0x0f, 0xa6, 0x6d, 0xfa, 0x9c, 0x3c, 0x46, 0x68, 0xd0, 0xf7, 0x81, 0xa8, 0x0b, 0x17, 0xdb,
-	0xf1, 0x7f, 0x15, 0x43, 0xac, 0xcd, 0xde, 0x8c, 0x39, 0x19, 0x31, 0x26, 0x51, 0x46, 0x07, 0x0b,
-	0x70, 0xb0, 0x1a, 0x88, 0x28, 0xde, 0x7f, 0x56, 0xf8, 0xfa, 0x5b, 0x50, 0x1a, 0xda, 0x8e, 0x49,
-	0x9d, 0xbe, 0xeb, 0xf9, 0xae, 0xa7, 0xa4, 0x3f, 0x8b, 0x43, 0xdb, 0xa9, 0x0b, 0x70, 0x73, 0x3c,
-	0xd4, 0x9f, 0x43, 0x01, 0xe9, 0x49, 0xd8, 0x2b, 0xbe, 0xbd, 0xbb, 0x05, 0xe9, 0xd1, 0xf8, 0xc4,
-	0x94, 0x11, 0x51, 0x16, 0x23, 0x22, 0x61, 0xfb, 0xce, 0x5d, 0x5f, 0x6a, 0x28, 0xfc, 0xad, 0x07,
-	0x62, 0x9d, 0xc8, 0x2f, 0xae, 0xf3, 0x43, 0x00, 0x5e, 0xf4, 0x88, 0x25, 0x53, 0xca, 0xa3, 0x65,
-	0x9c, 0x1f, 0x23, 0xdb, 0x0b, 0x59, 0x7b, 0x04, 0x59, 0xc9, 0x82, 0x3c, 0x89, 0xab, 0xe1, 0x08,
-	0xb9, 0x62, 0x23, 0xc2, 0xd1, 0x7f, 0xa5, 0xa9, 0xd3, 0xa2, 0xe9, 0x7d, 0x14, 0xed, 0x12, 0x9f,
-	0x73, 0x2d, 0xa4, 0xa0, 0x1e, 0xa2, 0x70, 0xa7, 0xc8, 0x8e, 0xb2, 0x27, 0xfc, 0x48, 0xae, 0x4f,
-	0x8f, 0x98, 0x71, 0x90, 0xde, 0x87, 0x65, 0x5e, 0x82, 0x9d, 0x5c, 0x54, 0x82, 0xcd, 0xfb, 0xf5,
-	0x36, 0x14, 0xe4, 0xe6, 0xd6, 0x2f, 0xa9, 0x13, 0xf0, 0x27, 0x65, 0x51, 0x04, 0xaa, 0xc9, 0x27,
-	0x65, 0x51, 0x09, 0x2b, 0xdf, 0xca, 0x13, 0xca, 0x5b, 0xf9, 0x1c, 0xa7, 0xe8, 0xe1, 0xdf, 0xa6,
-	0x60, 0x65, 0xea, 0x9b, 0x12, 0x92, 0x83, 0x74, 0xbb, 0x5b, 0xab, 0xd5, 0xdb, 0xed, 0xd2, 0x5b,
-	0xa4, 0x04, 0xf9, 0x6e, 0xf3, 0xa0, 0xd9, 0x7a, 0x61, 0xf2, 0xef, 0xb6, 0x34, 0x42, 0xa0, 0x58,
-	0x6b, 0x35, 0x9b, 0xf5, 0x5a, 0xc7, 0x34, 0xea, 0xfb, 0xdd, 0x76, 0xbd, 0x94, 0x20, 0xb7, 0x61,
-	0xad, 0xd9, 0xea, 0x98, 0xf5, 0x66, 0xab, 0xfb, 0xe4, 0xa9, 0xc9, 0x9c, 0x4d, 0x81, 0x9e, 0x24,
-	0x3a, 0xdc, 0x61, 0xed, 0xe7, 0xcf, 0xcc, 0xea, 0xa1, 0x51, 0xaf, 0xee, 0x7d, 0x66, 0x76, 0x9b,
-	0xb5, 0x56, 0x73, 0xbf, 0x61, 0x3c, 0x13, 0x38, 0x4b, 0xa4, 0x02, 0xeb, 0x02, 0x87, 0x51, 0xd9,
-	0x6f, 0x75, 0x9b, 0x7b, 0xa2, 0x6f, 0x99, 0xdc, 0x83, 0xcd, 0x46, 0xf3, 0xa8, 0xdb, 0x31, 0x5b,
-	0xdd, 0x0e, 0xfb, 0x83, 0xf3, 0x7c, 0xda, 0xad, 0x1e, 0x0a, 0x8c, 0x14, 0x59, 0x07, 0xd2, 0x39,
-	0x9e, 0x19, 0x99, 0x26, 0xab, 0x50, 0xe8, 0x1c, 0x9b, 0xed, 0xc6, 0x93, 0xa6, 0x00, 0x65, 0xc8,
-	0x2d, 0xb8, 0xb1, 0x7b, 0xd8, 0xaa, 0x1d, 0xd4, 0x9e, 0x56, 0x1b, 0x4d, 0x36, 0x84, 0x7f, 0x68,
-	0x96, 0x65, 0x4c, 0x3d, 0xaf, 0x1e, 0x36, 0xf6, 0xaa, 0x9d, 0xba, 0x40, 0x06, 0xb2, 0x01, 0xb7,
-	0x6a, 0xd5, 0x26, 0xa3, 0xdb, 0xfe, 0xac, 0x59, 0x33, 0x71, 0xa0, 0xe8, 0xcc, 0x31, 0x4a, 0x92,
-	0x0b, 0xb5, 0x23, 0x4f, 0xd6, 0x60, 0x55, 0xf0, 0x72, 0x74, 0x58, 0xfd, 0x4c, 0x80, 0x0b, 0xa4,
-	0x08, 0xf0, 0xa2, 0x7a, 0x28, 0xd1, 0x8a, 0xe4, 0x06, 0xac, 0x30, 0xca, 0x5c, 0x22, 0x1c, 0xb8,
-	0xc2, 0xc6, 0x0a, 0x62, 0x6c, 0x59, 0x02, 0x5c, 0x62, 0xe2, 0x31, 0x5a, 0xad, 0x8e, 0x39, 0xdb,
-	0xb7, 0x2a, 0x98, 0xdf, 0xeb, 0x1e, 0x1d, 0x36, 0x6a, 0xd1, 0xe2, 0x6f, 0xb0, 0x1d, 0x69, 0xd7,
-	0x8d, 0xe7, 0x8d, 0x5a, 0x5d, 0xec, 0x92, 0x94, 0xcb, 0x4d, 0x36, 0x4b, 0xe7, 0x78, 0xaf, 0xda,
-	0xa9, 0xaa, 0xb2, 0x59, 0x63, 0x3b, 0xcd, 0xc4, 0x75, 0x28, 0x69, 0xdc, 0x66, 0x02, 0xe8, 0x1c,
-	0x9b, 0xfb, 0xf5, 0xba, 0xa9, 0x6c, 0x2e, 0xef, 0xac, 0x30, 0x06, 0x70, 0x9f, 0x15, 0x1a, 0x9b,
-	0xe4, 0x26, 0x94, 0xf6, 0x8e, 0x5a, 0x6d, 0xf3, 0xd3, 0x6e, 0xdd, 0x90, 0x6c, 0xdd, 0x65, 0xb2,
-	0x32, 0x5e, 0xb4, 0xeb, 0x1d, 0xb3, 0xd1, 0x44, 0x21, 0x8b, 0x8e, 0xfb, 0xbc, 0xa3, 0x5a, 0x3b,
-	0x9c, 0xea, 0xd0, 0x49, 0x19, 0x6e, 0x3e, 0xa9, 0xb6, 0x67, 0xa7, 0x7d, 0x87, 0x6c, 0x42, 0xb9,
-	0x73, 0x6c, 0x3e, 0xaf, 0x1b, 0xed, 0x46, 0xab, 0x39, 0x35, 0xee, 0x01, 0xb9, 0x0f, 0x6f, 0xd7,
-	0x5a, 0xcf, 0x8e, 0x0e, 0x1b, 0xd5, 0x66, 0xad, 0x6e, 0xd6, 0x9e, 0xd6, 0x6b, 0x07, 0x48, 0xa4,
-	0x7a, 0x74, 0x64, 0xb4, 0x9e, 0xd7, 0xf7, 0x4a, 0xef, 0x32, 0x94, 0x6a, 0xad, 0xd6, 0xea, 0x36,
-	0x3b, 0x66, 0xad, 0xd5, 0xec, 0x18, 0xd5, 0x5a, 0xc7, 0x6c, 0x77, 0xaa, 0x9d, 0x6e, 0x5b, 0x50,
-	0x79, 0x8f, 0xc9, 0x8e, 0xcf, 0xd1, 0xd8, 0x67, 0x42, 0x65, 0x13, 0xf1, 0xae, 0xad, 0x87, 0x14,
-	0x56, 0x67, 0x3e, 0x19, 0x25, 0x79, 0xc8, 0x74, 0x9b, 0x7b, 0xf5, 0xfd, 0x46, 0xb3, 0x5e, 0x7a,
-	0x4b, 0xfd, 0x80, 0x51, 0x63, 0x0d, 0x71, 0x4c, 0x4a, 0x09, 0x52, 0x80, 0xec, 0x7e, 0xd7, 0xe0,
-	0x14, 0x4b, 0x49, 0xd6, 0x0c, 0xaf, 0x42, 0x69, 0x89, 0x00, 0xa4, 0xf6, 0xab, 0x8d, 0xc3, 0xfa,
-	0x5e, 0x69, 0xf9, 0xe1, 0x01, 0x40, 0xf4, 0x55, 0x1e, 0xc9, 0xc0, 0x52, 0xb3, 0x85, 0xb4, 0x01,
-	0x52, 0x87, 0xf5, 0xbd, 0x27, 0x75, 0x76, 0x0f, 0xd9, 0xac, 0x9d, 0xe3, 0x56, 0xa3, 0xb9, 0xdf,
-	0x2a, 0x25, 0xd8, 0xf9, 0xe2, 0x9f, 0x50, 0x62, 0x3b, 0x49, 0xb2, 0xb0, 0x7c, 0x54, 0xaf, 0x1b,
-	0xed, 0xd2, 0xd2, 0xc3, 0x3f, 0x84, 0x62, 0x3c, 0x9d, 0x8a, 0x04, 0xbb, 0x87, 0x87, 0xa5, 0xb7,
-	0xd8, 0xb9, 0xc7, 0x0d, 0xec, 0x3c, 0x35, 0xea, 0xed, 0xa7, 0xad, 0xc3, 0xbd, 0x92, 0xc6, 0x48,
-	0x21, 0xac, 0x7a, 0xd0, 0xae, 0x77, 0xf8, 0xb2, 0xb1, 0x6d, 0x54, 0x3b, 0xf5, 0x52, 0x92, 0xcd,
-	0x8b, 0xcd, 0x76, 0x97, 0xad, 0xba, 0x00, 0xd9, 0x5a, 0xd5, 0x64, 0x47, 0xad, 0xce, 0x6e, 0x2b,
-	0x2a, 0x87, 0x67, 0xcf, 0xba, 0xcd, 0x46, 0xe7, 0x33, 0xf3, 0x79, 0xab, 0x53, 0x2f, 0xa5, 0x1e,
-	0x7e, 0x04, 0x79, 0x35, 0xa7, 0x44, 0xd2, 0x90, 0xac, 0x1d, 0x75, 0x39, 0x37, 0xcf, 0xea, 0xcf,
-	0x5a, 0xc6, 0x67, 0x25, 0x8d, 0x2d, 0x69, 0xaf, 0xd1, 0x3e, 0x28, 0x25, 0xd8, 0xaf, 0xe3, 0xfd,
-	0x7a, 0xbd, 0x94, 0xdc, 0xf9, 0xcd, 0x0d, 0x48, 0x1d, 0xa3, 0x4a, 0x27, 0x5d, 0x28, 0x45, 0x81,
-	0xec, 0xee, 0x15, 0x7e, 0x71, 0x50, 0x90, 0xfe, 0x32, 0x66, 0xd4, 0x2b, 0x53, 0x51, 0xa5, 0xae,
-	0xff, 0xe2, 0xdf, 0xfe, 0xeb, 0xcf, 0x13, 0x9b, 0xfa, 0xad, 0x47, 0x97, 0x1f, 0x3e, 0xf2, 0x71,
-	0xb0, 0x89, 0x1f, 0x4c, 0x9c, 0x5c, 0xe1, 0x57, 0x0c, 0x8f, 0xb5, 0x87, 0xe4, 0x07, 0x90, 0x3a,
-	0x72, 0xfd, 0xa0, 0x33, 0x21, 0xb1, 0x8f, 0x6e, 0x2b, 0x2b, 0xdc, 0x94, 0x86, 0x5f, 0x64, 0xea,
-	0xeb, 0x48, 0xac, 0xa4, 0xe7, 0x18, 0xb1, 0x91, 0xeb, 0x07, 0x66, 0x30, 0x61, 0x04, 0x76, 0x21,
-	0x83, 0x8a, 0xbd, 0x5a, 0x3b, 0xe4, 0xeb, 0x09, 0x93, 0xa0, 0x95, 0x78, 0x53, 0x2f, 0x23, 0x05,
-	0xa2, 0x17, 0x18, 0x85, 0x9f, 0xb2, 0x31, 0xa6, 0xd5, 0x1b, 0x30, 0x1a, 0x26, 0xac, 0x20, 0x0d,
-	0x25, 0xac, 0xb8, 0x19, 0x0f, 0x55, 0x78, 0xb0, 0x56, 0x99, 0x0b, 0xd5, 0xef, 0x21, 0xe1, 0x8a,
-	0xbe, 0x16, 0x11, 0x46, 0x36, 0x3d, 0x44, 0x62, 0x13, 0xfc, 0x0c, 0xd6, 0x70, 0x82, 0x19, 0xdf,
-	0x78, 0x63, 0xae, 0x2f, 0xcd, 0x8d, 0x59, 0x65, 0x73, 0x7e, 0xa7, 0x70, 0x26, 0xde, 0xc7, 0x59,
-	0xef, 0xeb, 0x9b, 0xd1, 0xac, 0x31, 0xbf, 0xd3, 0x64, 0x0e, 0x39, 0x9b, 0xfc, 0xe7, 0x70, 0x63,
-	0x4e, 0x66, 0x8b, 0xdc, 0xc1, 0xaf, 0x1c, 0x16, 0xe6, 0xd9, 0x2a, 0x77, 0x17, 0xf6, 0x8b, 0x05,
-	0x3c, 0xc0, 0x05, 0xdc, 0xd1, 0x6f, 0xb3, 0x05, 0x9c, 0xd1, 0x20, 0xfc, 0xea, 0x23, 0x74, 0x21,
-	0xd9, 0xec, 0x9f, 0x40, 0x1a, 0x59, 0x9f, 0xd9, 0xe1, 0x58, 0x4b, 0xbf, 0x85, 0xc4, 0x56, 0xf5,
-	0x7c, 0xc4, 0x0d, 0xdf, 0xdf, 0x26, 0xc0, 0x13, 0x1a, 0x88, 0x6f, 0x2a, 0xc9, 0xaa, 0xe2, 0xcb,
-	0x0a, 0x3a, 0xb3, 0x20, 0xbd, 0x82, 0xc4, 0x6e, 0xea, 0x2b, 0x72, 0x65, 0xe2, 0x23, 0x52, 0x46,
-	0xcf, 0x86, 0x52, 0x44, 0x4f, 0x7e, 0x75, 0xaa, 0x90, 0x88, 0x7d, 0xbd, 0x59, 0x59, 0xd8, 0xa3,
-	0xdf, 0xc7, 0x39, 0x36, 0xf4, 0xf5, 0xa9, 0x39, 0xcc, 0x3e, 0xd2, 0x64, 0x53, 0xfd, 0x08, 0xa7,
-	0xe2, 0x9f, 0x6a, 0x5e, 0x8f, 0x81, 0x19, 0xe2, 0xe2, 0xdb, 0x47, 0x85, 0x8f, 0xef, 0x41, 0x86,
-	0xf1, 0x81, 0x89, 0x94, 0x5c, 0xf8, 0xb1, 0x78, 0x63, 0xaf, 0x92, 0x0d, 0x1b, 0xf1, 0x13, 0x8f,
-	0x6b, 0x64, 0x60, 0x36, 0xda, 0xe0, 0x52, 0x60, 0xcd, 0xdd, 0x2b, 0x91, 0x24, 0x59, 0x09, 0x07,
-	0x72, 0x80, 0x4a, 0x29, 0x76, 0x95, 0x43, 0x4a, 0xec, 0x22, 0xf3, 0xc4, 0x0b, 0xdf, 0xa9, 0x1b,
-	0x92, 0x26, 0xfa, 0x33, 0x52, 0x37, 0xab, 0x65, 0xc5, 0x95, 0x58, 0x4b, 0xdf, 0x40, 0xb2, 0x6b,
-	0x7a, 0x29, 0x24, 0xdb, 0xe3, 0x21, 0x13, 0xa3, 0xd7, 0x80, 0x62, 0x8c, 0x9e, 0x20, 0x25, 0xbf,
-	0xb9, 0xae, 0x44, 0xeb, 0xe5, 0xdd, 0x92, 0x5d, 0xa2, 0x50, 0xe3, 0x45, 0xea, 0xa4, 0x0b, 0x2b,
-	0x4f, 0x68, 0xc0, 0x0b, 0x86, 0xd5, 0x65, 0x85, 0xb4, 0xd6, 0x67, 0x0b, 0x8a, 0x51, 0xeb, 0x6c,
-	0x22, 0xc9, 0x75, 0x7d, 0x55, 0x92, 0xf4, 0xaf, 0xfc, 0x68, 0x85, 0x67, 0x40, 0x9e, 0xd0, 0x60,
-	0xba, 0x24, 0xb8, 0x2c, 0xae, 0xed, 0x4c, 0xf1, 0x71, 0xe5, 0xc6, 0x4c, 0xcf, 0xd8, 0x9f, 0x15,
-	0x6d, 0x58, 0xfb, 0x1b, 0x4d, 0xf4, 0x3e, 0x64, 0x9f, 0xd0, 0xa0, 0x49, 0x83, 0xae, 0x71, 0x38,
-	0xb5, 0x72, 0x0c, 0x02, 0x79, 0x45, 0xaf, 0xfe, 0x16, 0x39, 0x00, 0x88, 0xb4, 0xf4, 0x97, 0xe9,
-	0xe7, 0x3b, 0x38, 0x73, 0x59, 0xbf, 0x31, 0xa5, 0x9f, 0x7d, 0xf3, 0x72, 0x87, 0xcd, 0xfa, 0x85,
-	0x06, 0x6b, 0x73, 0xf3, 0x98, 0x04, 0xbf, 0x38, 0x79, 0x55, 0xda, 0xb7, 0x72, 0xff, 0x15, 0x18,
-	0x42, 0x7f, 0xc4, 0x18, 0x1f, 0x79, 0x94, 0x4e, 0x68, 0xcf, 0x54, 0x96, 0xc1, 0x96, 0xf0, 0x04,
-	0x8a, 0xf1, 0xba, 0x43, 0x72, 0x5b, 0x16, 0x94, 0xcc, 0x14, 0x38, 0x56, 0x2a, 0xf3, 0xba, 0x84,
-	0x93, 0xff, 0x1c, 0x6e, 0xcc, 0xa9, 0xcf, 0xe3, 0x4a, 0x70, 0x71, 0xcd, 0x21, 0x57, 0x82, 0xaf,
-	0x2a, 0xec, 0x6b, 0xf3, 0x4a, 0x99, 0x78, 0x05, 0x1c, 0x79, 0x3b, 0x36, 0x6c, 0xba, 0x18, 0xaf,
-	0x72, 0x67, 0x51, 0xb7, 0x20, 0xfa, 0x43, 0x58, 0x99, 0x2a, 0x28, 0x23, 0x21, 0x6f, 0xb3, 0x55,
-	0x71, 0x95, 0x8d, 0xb9, 0x7d, 0x82, 0xd6, 0x33, 0x5e, 0xdf, 0xa8, 0x56, 0x5e, 0x91, 0xd8, 0x80,
-	0xa9, 0xca, 0x31, 0x6e, 0x75, 0x16, 0x16, 0x6b, 0x09, 0x72, 0x6a, 0x81, 0x53, 0x44, 0x6e, 0x4e,
-	0x85, 0x55, 0x44, 0x6e, 0x6e, 0x4d, 0xd4, 0x77, 0x63, 0x55, 0x40, 0x6b, 0x53, 0xc5, 0x42, 0x82,
-	0xc4, 0xfa, 0x34, 0x58, 0x0c, 0xb6, 0x50, 0x41, 0x08, 0xfb, 0xb4, 0x7b, 0x55, 0x3d, 0xe0, 0x04,
-	0x66, 0x9e, 0xc4, 0x38, 0x81, 0xd9, 0xe7, 0xab, 0xb8, 0xe1, 0x56, 0x2d, 0xd8, 0xc9, 0x95, 0x69,
-	0xa1, 0x9e, 0xbc, 0xe4, 0xb6, 0x73, 0x2a, 0x79, 0xc2, 0x39, 0x5e, 0x90, 0x89, 0xe2, 0x1c, 0x2f,
-	0xca, 0xb7, 0xcc, 0xb1, 0x9a, 0x1c, 0x33, 0x6e, 0x35, 0x9b, 0x90, 0x16, 0x97, 0x87, 0xcc, 0x7d,
-	0x6c, 0xa8, 0xac, 0x4d, 0x41, 0x05, 0xf5, 0xb8, 0x97, 0xc4, 0xef, 0xd4, 0x63, 0xed, 0xe1, 0x49,
-	0x0a, 0xff, 0x53, 0xa0, 0x6f, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x49, 0x52, 0x71,
-	0x58, 0x48, 0x00, 0x00,
+	// 6491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3c, 0x4d, 0x73, 0x23, 0xc7,
+	0x75, 0x02, 0x40, 0x7c, 0x3d, 0x10, 0x20, 0xd8, 0xcb, 0xe5, 0x62, 0xb1, 0xd4, 0x7e, 0x8c, 0x64,
+	0x69, 0xb5, 0x8a, 0xb9, 0x16, 0x6d, 0x47, 0x2a, 0xd9, 0x96, 0x03, 0x82, 0xd8, 0x5d, 0x78, 0x49,
+	0x80, 0x6a, 0x00, 0xbb, 0x54, 0x39, 0x55, 0xe3, 0x21, 0xd0, 0x24, 0xc7, 0x04, 0x66, 0xe0, 0x99,
+	0x01, 0x05, 0xca, 0xae, 0x44, 0x71, 0xe5, 0x12, 0x1f, 0x93, 0xaa, 0xdc, 0x92, 0x72, 0x72, 0xcc,
+	0x21, 0x87, 0x54, 0xaa, 0x72, 0x48, 0x55, 0xaa, 0xe2, 0x4a, 0x52, 0x39, 0xe5, 0x92, 0x53, 0x72,
+	0x75, 0x2a, 0xff, 0x20, 0xf7, 0xd4, 0xeb, 0x8f, 0x99, 0x1e, 0x7c, 0xac, 0x96, 0xd6, 0x5a, 0x97,
+	0x5d, 0xbc, 0x8f, 0x7e, 0xdd, 0xef, 0x75, 0xf7, 0xeb, 0xd7, 0xaf, 0xdf, 0x10, 0x56, 0xa7, 0xfd,
+	0x33, 0xcb, 0x76, 0xb6, 0xc7, 0x9e, 0x1b, 0xb8, 0x24, 0x39, 0x3e, 0xae, 0x6e, 0x9d, 0xba, 0xee,
+	0xe9, 0x90, 0x3d, 0xb4, 0xc6, 0xf6, 0x43, 0xcb, 0x71, 0xdc, 0xc0, 0x0a, 0x6c, 0xd7, 0xf1, 0x05,
+	0x47, 0xb5, 0xcc, 0xd9, 0xd9, 0xe0, 0xf8, 0x24, 0x10, 0x18, 0xe3, 0x04, 0x32, 0x4f, 0x98, 0x35,
+	0x60, 0x1e, 0xd9, 0x80, 0xf4, 0xd0, 0x3d, 0xb5, 0x07, 0x95, 0xc4, 0xdd, 0xc4, 0xfd, 0x3c, 0x15,
+	0x00, 0xb9, 0x05, 0xf9, 0x13, 0xcf, 0x1d, 0x99, 0x8e, 0x3b, 0x60, 0x95, 0x24, 0xa7, 0xe4, 0x10,
+	0xd1, 0x72, 0x07, 0x8c, 0xbc, 0x03, 0x69, 0xe6, 0x79, 0xae, 0x57, 0x49, 0xdd, 0x4d, 0xdc, 0x2f,
+	0xed, 0x5c, 0xdb, 0x1e, 0x1f, 0x6f, 0x1f, 0xd5, 0xb1, 0x8b, 0x06, 0xa2, 0x1b, 0xce, 0x64, 0x44,
+	0x05, 0x87, 0x71, 0x02, 0xc5, 0xee, 0x74, 0xcf, 0x0a, 0xac, 0x5a, 0xbf, 0xef, 0x4e, 0x9c, 0x80,
+	0x54, 0x20, 0x6b, 0x0d, 0x06, 0x1e, 0xf3, 0x7d, 0xd9, 0xa1, 0x02, 0xc9, 0x26, 0x64, 0xac, 0x11,
+	0xf2, 0xc8, 0xfe, 0x24, 0x44, 0xde, 0x80, 0xe2, 0x89, 0xe7, 0x7e, 0xc6, 0x1c, 0xf3, 0x8c, 0xd9,
+	0xa7, 0x67, 0x01, 0xef, 0x35, 0x45, 0x57, 0x05, 0xf2, 0x09, 0xc7, 0x19, 0xbf, 0x4e, 0x42, 0x46,
+	0x74, 0x44, 0x0c, 0xc8, 0x9c, 0x71, 0xd5, 0x2a, 0xc5, 0xbb, 0x89, 0xfb, 0x85, 0x1d, 0xc0, 0xe1,
+	0x09, 0x65, 0xa9, 0xa4, 0x10, 0x02, 0x2b, 0xc1, 0x54, 0xea, 0xbc, 0x4a, 0xf9, 0x6f, 0xec, 0xff,
+	0xb8, 0xef, 0x58, 0x23, 0xa5, 0xaf, 0x84, 0x42, 0x53, 0xe0, 0x38, 0x2b, 0xa9, 0xc8, 0x14, 0xb5,
+	0xc1, 0xc0, 0x23, 0x77, 0xa0, 0xc0, 0x89, 0xe3, 0xc9, 0xf1, 0x39, 0xbb, 0xac, 0xac, 0x70, 0x32,
+	0x20, 0xea, 0x90, 0x63, 0x42, 0x06, 0xbf, 0xef, 0x21, 0x43, 0x3a, 0x62, 0xe8, 0x70, 0x0c, 0x8a,
+	0x9f, 0xf8, 0xcc, 0x33, 0x7d, 0xfb, 0xd4, 0xa9, 0x94, 0xf8, 0x78, 0x72, 0x88, 0xe8, 0xd8, 0xa7,
+	0x0e, 0x79, 0x17, 0xb2, 0x96, 0x30, 0x5c, 0x25, 0x73, 0x37, 0x75, 0xbf, 0xb0, 0xb3, 0x8e, 0xca,
+	0xc4, 0x2c, 0x4a, 0x15, 0x07, 0xce, 0xa4, 0xe3, 0x3a, 0x7d, 0x56, 0xc9, 0x89, 0x99, 0xe4, 0x00,
+	0xd9, 0x82, 0x7c, 0x60, 0x8f, 0x98, 0x1f, 0x58, 0xa3, 0x71, 0x25, 0xcf, 0x4d, 0x17, 0x21, 0xd0,
+	0x10, 0x03, 0xe6, 0xf7, 0x2b, 0xab, 0xc2, 0x10, 0xf8, 0x1b, 0xa7, 0xe8, 0x82, 0x79, 0xbe, 0xed,
+	0x3a, 0x95, 0xb5, 0xbb, 0x89, 0xfb, 0x69, 0xaa, 0x40, 0xe3, 0xdf, 0x12, 0x90, 0xeb, 0x4e, 0x3b,
+	0x81, 0x15, 0x4c, 0x7c, 0xcd, 0xce, 0x89, 0xa5, 0x76, 0x5e, 0x66, 0x53, 0x65, 0xff, 0x94, 0x66,
+	0xff, 0xaf, 0x43, 0xc6, 0xe7, 0x92, 0xb9, 0x15, 0x4b, 0x3b, 0xd7, 0xb9, 0xaa, 0x9e, 0xe5, 0xf8,
+	0x56, 0x1f, 0x17, 0xb3, 0xe8, 0x96, 0x4a, 0x26, 0x52, 0x85, 0xdc, 0xc0, 0xf6, 0x03, 0x0b, 0x15,
+	0x4e, 0x73, 0xb5, 0x42, 0x98, 0xdc, 0x81, 0x64, 0x30, 0xad, 0x64, 0xf9, 0xb0, 0xd6, 0x66, 0xc4,
+	0xd0, 0x64, 0x30, 0x35, 0x18, 0x94, 0xba, 0xd3, 0xfa, 0x19, 0xeb, 0x9f, 0x3f, 0xb2, 0xec, 0xe1,
+	0xc4, 0x63, 0xe4, 0x6b, 0x90, 0xee, 0x23, 0xcc, 0x95, 0x29, 0xc9, 0x56, 0x82, 0xa5, 0x7b, 0x39,
+	0x66, 0x54, 0x50, 0xd1, 0xc6, 0xb6, 0x33, 0x60, 0x53, 0xae, 0x4f, 0x9a, 0x0a, 0x00, 0xd5, 0xf4,
+	0x98, 0xe5, 0xbb, 0x8e, 0x5c, 0x1f, 0x12, 0x32, 0xfe, 0x26, 0x01, 0xe4, 0x99, 0x35, 0xb4, 0x07,
+	0x56, 0xc0, 0xba, 0x53, 0xca, 0xfc, 0xb1, 0xeb, 0xf8, 0xec, 0x95, 0x5b, 0x6e, 0x03, 0xd2, 0x17,
+	0xd8, 0x0b, 0x37, 0x5c, 0x8e, 0x0a, 0x80, 0x6c, 0x43, 0xee, 0x44, 0x28, 0xe7, 0x57, 0xd2, 0x7c,
+	0xf1, 0x10, 0x4d, 0x29, 0xa9, 0x37, 0x0d, 0x79, 0x8c, 0x16, 0xe4, 0x76, 0xad, 0xa0, 0x7f, 0xd6,
+	0x9d, 0xbe, 0xdc, 0xdc, 0xde, 0x86, 0x54, 0x77, 0xea, 0x57, 0x92, 0x5c, 0xf4, 0xaa, 0x10, 0x2d,
+	0xe7, 0x08, 0x09, 0xc6, 0xff, 0x25, 0x20, 0xbd, 0x3b, 0x74, 0xfb, 0xe7, 0x5f, 0x4a, 0xdf, 0x0a,
+	0x64, 0x8f, 0x51, 0x48, 0xa8, 0xb2, 0x02, 0xc9, 0xf6, 0xcc, 0x7a, 0xd9, 0x44, 0xa9, 0xbc, 0xc3,
+	0xed, 0x06, 0xff, 0x6f, 0x66, 0xc1, 0xbc, 0x0d, 0x69, 0xde, 0x94, 0xaf, 0x16, 0xb9, 0x93, 0x9a,
+	0x4e, 0xc0, 0x3c, 0xc7, 0x1a, 0x72, 0x7e, 0x2a, 0xe8, 0xc6, 0xf7, 0x60, 0x55, 0x17, 0x40, 0xf2,
+	0x90, 0x6e, 0x50, 0xda, 0xa6, 0xe5, 0xd7, 0xf0, 0x67, 0x97, 0xf6, 0x5a, 0x4f, 0xcb, 0x09, 0x02,
+	0x90, 0xd9, 0xa5, 0xb5, 0x56, 0xfd, 0x49, 0x39, 0x49, 0x0a, 0x90, 0x6d, 0xb5, 0x1b, 0x47, 0xcd,
+	0x4e, 0xb7, 0x9c, 0x32, 0x7e, 0x9e, 0x80, 0x2c, 0x6f, 0xde, 0xdc, 0xd3, 0x34, 0x5f, 0x79, 0x09,
+	0xcd, 0x13, 0xcb, 0x34, 0x4f, 0xc6, 0x35, 0xbf, 0x07, 0xab, 0x0e, 0x63, 0x03, 0xb3, 0xef, 0x3a,
+	0x01, 0x73, 0x84, 0x43, 0xcc, 0xd1, 0x02, 0xe2, 0xea, 0x02, 0x65, 0x58, 0x50, 0xe0, 0x63, 0x10,
+	0xee, 0x51, 0x1b, 0x47, 0xea, 0xca, 0xe3, 0xd8, 0xc4, 0xb6, 0xdc, 0xf1, 0x26, 0xf9, 0x36, 0x93,
+	0x90, 0xf1, 0xb7, 0x09, 0xd9, 0x87, 0x4f, 0x2d, 0xe7, 0xf4, 0xcb, 0xad, 0xea, 0x7b, 0xb0, 0xea,
+	0x07, 0x96, 0x17, 0xc4, 0x5d, 0x7c, 0x81, 0xe3, 0xa4, 0x0a, 0xaf, 0x03, 0x30, 0x67, 0xa0, 0x18,
+	0x56, 0x84, 0x23, 0x63, 0xce, 0x40, 0x92, 0x67, 0x6d, 0x92, 0x9e, 0xb7, 0xc9, 0x7b, 0x50, 0xa8,
+	0xbb, 0xa3, 0x91, 0xeb, 0x50, 0x36, 0x1e, 0x5e, 0xbe, 0xcc, 0x78, 0x0d, 0x13, 0x72, 0xa2, 0x49,
+	0xd3, 0x79, 0x29, 0xfd, 0x1e, 0x42, 0xe1, 0xc2, 0x66, 0x9f, 0x9a, 0xee, 0x18, 0x5d, 0x0d, 0x57,
+	0xb2, 0xb4, 0x53, 0x42, 0xc6, 0x67, 0x36, 0xfb, 0xb4, 0xcd, 0xb1, 0x14, 0x2e, 0xc2, 0xdf, 0xc6,
+	0x8f, 0xa1, 0xd0, 0x75, 0xcf, 0x99, 0xb3, 0xc7, 0x02, 0xcb, 0x1e, 0xbe, 0x70, 0x2d, 0x58, 0x43,
+	0xee, 0xeb, 0x84, 0xe1, 0x14, 0x78, 0x95, 0xb3, 0x78, 0x0c, 0xc5, 0x9a, 0x38, 0x6b, 0xaf, 0xe0,
+	0xc1, 0xb5, 0xf3, 0x3a, 0x19, 0x3f, 0xaf, 0xef, 0x41, 0xea, 0xb8, 0xef, 0x57, 0x52, 0x7c, 0xff,
+	0x0b, 0x7f, 0x19, 0x69, 0x42, 0x91, 0x66, 0x34, 0x61, 0x9d, 0xe3, 0x1e, 0xf1, 0xa3, 0x5a, 0xea,
+	0xa8, 0xe9, 0x92, 0x88, 0xeb, 0x52, 0x85, 0x9c, 0xed, 0x0b, 0x5e, 0xde, 0x59, 0x8e, 0x86, 0xb0,
+	0xf1, 0x79, 0x02, 0xc8, 0x9c, 0x2c, 0x7f, 0xa9, 0xc1, 0xde, 0x86, 0x54, 0x70, 0x32, 0x90, 0xce,
+	0xe9, 0x7a, 0x38, 0x38, 0xbd, 0x31, 0x45, 0x8e, 0xab, 0xd8, 0xef, 0xf3, 0x04, 0x6c, 0x48, 0x03,
+	0xee, 0x8a, 0x11, 0xbf, 0x12, 0x3b, 0x3e, 0x80, 0x95, 0xe0, 0x64, 0xa0, 0x0c, 0xb9, 0xb9, 0x70,
+	0xac, 0x3e, 0xe5, 0x3c, 0xc6, 0x5f, 0x24, 0x20, 0xdb, 0x9d, 0x36, 0x9d, 0xf1, 0x24, 0x20, 0x37,
+	0x21, 0xe7, 0xb1, 0x13, 0x53, 0x8b, 0x63, 0xb2, 0x1e, 0x3b, 0xe9, 0xe2, 0x81, 0xf0, 0x3a, 0x00,
+	0x92, 0xdc, 0x93, 0x13, 0x9f, 0x05, 0xf2, 0xa8, 0xca, 0x7b, 0xec, 0xa4, 0xcd, 0x11, 0xf1, 0x88,
+	0x26, 0x2d, 0x42, 0x8e, 0x30, 0xa2, 0x89, 0xc2, 0xb0, 0x0c, 0xa7, 0x2c, 0x0d, 0xc3, 0xb2, 0x0b,
+	0xc2, 0xb0, 0x1f, 0x61, 0x7c, 0xd0, 0x9e, 0x04, 0x38, 0xbe, 0x48, 0x50, 0x22, 0x26, 0xe8, 0x06,
+	0x64, 0x03, 0x57, 0xf4, 0x2d, 0xfc, 0x5a, 0x26, 0x70, 0x79, 0xcf, 0x73, 0x3d, 0xac, 0x2c, 0xe8,
+	0xa1, 0x0d, 0xa5, 0xa3, 0xc9, 0x58, 0x84, 0x47, 0x56, 0x80, 0x27, 0xf7, 0x1d, 0x28, 0x8c, 0x27,
+	0xc7, 0x43, 0xbb, 0x6f, 0x9e, 0xb3, 0x4b, 0x8c, 0x2a, 0x53, 0xf7, 0x57, 0x29, 0x08, 0xd4, 0x53,
+	0x76, 0xe9, 0x63, 0x04, 0xe4, 0x2b, 0x6e, 0xd9, 0x65, 0x84, 0x30, 0xfe, 0x23, 0x03, 0x05, 0x2d,
+	0x3c, 0x58, 0x18, 0x1a, 0x2e, 0x77, 0xc5, 0xf7, 0x21, 0x1f, 0x4c, 0x4d, 0x1b, 0x27, 0x44, 0xcd,
+	0x60, 0x41, 0x1c, 0x85, 0x7c, 0x92, 0x68, 0x2e, 0x10, 0x3f, 0x7c, 0xf2, 0x2e, 0x40, 0x30, 0x35,
+	0x5d, 0x6e, 0x1b, 0x3c, 0xb2, 0xb4, 0x53, 0x53, 0x18, 0x8c, 0xe6, 0x03, 0xf9, 0xcb, 0x0f, 0xc3,
+	0xb2, 0x8c, 0x16, 0x96, 0x55, 0x21, 0xd7, 0x77, 0x6d, 0xe7, 0xd8, 0xf2, 0x19, 0xb7, 0x7d, 0x8e,
+	0x86, 0xf0, 0x6f, 0x14, 0xfa, 0x69, 0x61, 0x1e, 0xc4, 0xc2, 0x3c, 0xa4, 0x58, 0x93, 0xc0, 0x3d,
+	0x65, 0x4e, 0xa5, 0xc0, 0x3b, 0x52, 0x20, 0xd9, 0x81, 0x62, 0xa8, 0xae, 0xc9, 0xa6, 0x41, 0xe5,
+	0x06, 0xd7, 0xa3, 0xa4, 0xa9, 0xdc, 0x98, 0x06, 0xb4, 0xa0, 0xb4, 0x6e, 0x4c, 0x03, 0xf2, 0x6d,
+	0x28, 0x45, 0x8a, 0xf3, 0x46, 0x15, 0xcd, 0x65, 0x48, 0x95, 0xb1, 0xd5, 0x6a, 0xa8, 0x3f, 0x36,
+	0xfb, 0x08, 0xd6, 0xd1, 0x97, 0x7b, 0x56, 0x3f, 0x30, 0x3d, 0xf6, 0x93, 0x09, 0xf3, 0x03, 0xbf,
+	0x72, 0x33, 0x0a, 0x82, 0x9b, 0xce, 0x85, 0x7b, 0xce, 0xa8, 0xa0, 0xd0, 0xb2, 0xe2, 0x95, 0x08,
+	0x3e, 0xeb, 0xb6, 0x63, 0x07, 0xb6, 0x15, 0xb8, 0x5e, 0xa5, 0xca, 0xcd, 0x12, 0x21, 0xf0, 0xb8,
+	0xb0, 0x26, 0xc1, 0x19, 0x97, 0x6c, 0x7b, 0xac, 0x72, 0xeb, 0x6e, 0xea, 0x7e, 0x9e, 0x16, 0x10,
+	0x47, 0x05, 0x8a, 0x7c, 0x08, 0x6b, 0x21, 0x3f, 0x8f, 0xce, 0xfd, 0xca, 0x56, 0xd4, 0x7d, 0xb8,
+	0xfe, 0x9a, 0xce, 0x89, 0x4b, 0x4b, 0x21, 0x27, 0xe2, 0x7d, 0xf2, 0x7d, 0x20, 0xba, 0x78, 0xd9,
+	0xfc, 0xf5, 0x65, 0xcd, 0xcb, 0x5a, 0xbf, 0x42, 0xc0, 0xd7, 0x81, 0x78, 0xac, 0xcf, 0xec, 0x0b,
+	0x36, 0x30, 0xa3, 0x39, 0xbc, 0xcd, 0xe7, 0x70, 0x5d, 0x51, 0xba, 0xe1, 0x5c, 0xbe, 0x07, 0x30,
+	0xc5, 0x5d, 0xc1, 0x3b, 0xaa, 0xdc, 0xb9, 0x9b, 0x50, 0xd1, 0x5e, 0x7c, 0xaf, 0xd0, 0xfc, 0x54,
+	0xc1, 0x64, 0x07, 0x56, 0x47, 0xee, 0xc0, 0x3e, 0xb9, 0x34, 0x45, 0x54, 0x74, 0x37, 0x8a, 0x96,
+	0x0f, 0x38, 0x5e, 0xc4, 0x44, 0x85, 0x51, 0x04, 0x90, 0x37, 0x20, 0xfb, 0x64, 0xcf, 0xb4, 0x9d,
+	0x13, 0xb7, 0x72, 0x4f, 0xf3, 0x74, 0x7b, 0x5c, 0x89, 0x8c, 0xf8, 0xdf, 0xf0, 0x01, 0xf6, 0xd9,
+	0xe0, 0x94, 0x79, 0x07, 0x2c, 0xb0, 0xd0, 0xd0, 0x9e, 0xeb, 0x06, 0xa6, 0xda, 0x3f, 0x62, 0x5b,
+	0x15, 0x10, 0xb7, 0x2b, 0x50, 0xb8, 0x81, 0x03, 0x7b, 0x6c, 0xc6, 0x77, 0x18, 0x04, 0xf6, 0x78,
+	0x37, 0x8a, 0x77, 0x02, 0x6f, 0xe2, 0x9c, 0xcf, 0x44, 0x07, 0x1c, 0x27, 0xdd, 0xc2, 0x2f, 0xd2,
+	0x90, 0xeb, 0x05, 0x53, 0x97, 0xf7, 0xf9, 0x35, 0x28, 0x0d, 0xad, 0x80, 0xf9, 0xb3, 0xbd, 0x16,
+	0x05, 0x56, 0x89, 0x35, 0xa0, 0x88, 0xbf, 0xd0, 0x6d, 0x98, 0x43, 0xdb, 0x0f, 0xf8, 0x69, 0x91,
+	0xa7, 0x05, 0x44, 0x3e, 0x65, 0x97, 0xfb, 0xb6, 0xcf, 0xa3, 0x8e, 0x49, 0x30, 0x75, 0xcd, 0xc0,
+	0x0d, 0xac, 0xa1, 0x8c, 0xee, 0xf3, 0x88, 0xe9, 0x22, 0x02, 0xf7, 0xa4, 0x75, 0x71, 0xba, 0xc7,
+	0x86, 0xd6, 0xa5, 0xf4, 0x56, 0x21, 0x4c, 0x7e, 0x07, 0xd6, 0x27, 0x4e, 0xdf, 0x75, 0x4e, 0x6c,
+	0x6f, 0xd4, 0x9d, 0xd6, 0x84, 0x2b, 0x14, 0x37, 0x95, 0x79, 0x02, 0x79, 0x13, 0x4a, 0x23, 0x6b,
+	0x2a, 0x06, 0x6c, 0xfa, 0xf6, 0x67, 0x8c, 0xef, 0xfd, 0x14, 0x5d, 0x1d, 0x59, 0x53, 0x11, 0x8c,
+	0xda, 0x9f, 0x31, 0xf2, 0x7b, 0xb8, 0x2c, 0x7c, 0xe6, 0x5d, 0xc8, 0x48, 0x07, 0x57, 0xbc, 0x5f,
+	0xc9, 0x2e, 0xdb, 0x15, 0xeb, 0x8a, 0xb9, 0xae, 0x78, 0x51, 0xc2, 0x89, 0xeb, 0x1d, 0xdb, 0x83,
+	0x01, 0x73, 0x42, 0x11, 0xdc, 0x6d, 0x2c, 0x96, 0x10, 0x32, 0x2b, 0x11, 0xe4, 0x7b, 0x70, 0xcb,
+	0x61, 0x9f, 0x9a, 0xf2, 0xd6, 0x69, 0x7a, 0xcc, 0x77, 0x27, 0x5e, 0x9f, 0x99, 0xd2, 0xd9, 0x0b,
+	0x3f, 0x53, 0x71, 0xd8, 0xa7, 0xea, 0x82, 0x2a, 0x19, 0xa4, 0xa2, 0x1f, 0xc0, 0x0d, 0xdb, 0xf3,
+	0x18, 0xf7, 0x35, 0xc7, 0x43, 0xa6, 0x45, 0xa9, 0xdc, 0x0d, 0xa5, 0xe8, 0x32, 0xf2, 0x6c, 0xcb,
+	0xce, 0xd0, 0x1e, 0xb0, 0xe7, 0xb6, 0x33, 0x70, 0x3f, 0xad, 0x14, 0xe6, 0x5b, 0x6a, 0x64, 0x72,
+	0x1f, 0x72, 0xa7, 0x96, 0x7f, 0xe8, 0xd9, 0x7d, 0xc6, 0x6f, 0xba, 0xd2, 0xf3, 0x3e, 0x96, 0x38,
+	0x1a, 0x52, 0x49, 0x1d, 0x36, 0x4e, 0x3d, 0x77, 0x32, 0x36, 0x79, 0xc6, 0x24, 0x32, 0x50, 0x71,
+	0x99, 0x81, 0x08, 0x67, 0xe7, 0x01, 0x83, 0xb2, 0x90, 0xf1, 0x19, 0xe4, 0x94, 0x68, 0x3c, 0xa5,
+	0xfb, 0xe3, 0x89, 0xe9, 0x59, 0x81, 0x08, 0x51, 0x52, 0x34, 0xdb, 0x1f, 0x4f, 0xa8, 0x15, 0x70,
+	0xd2, 0x88, 0x8d, 0x04, 0x49, 0x84, 0xd6, 0xd9, 0x11, 0x1b, 0x71, 0xd2, 0x2d, 0xc8, 0x0f, 0x6c,
+	0xff, 0x5c, 0xd0, 0x52, 0xe1, 0xed, 0xf6, 0x5c, 0x11, 0xa7, 0x27, 0x8c, 0x09, 0xa2, 0x5c, 0x75,
+	0x88, 0x40, 0xa2, 0xf1, 0xcf, 0x69, 0x28, 0xc6, 0x6e, 0x35, 0xba, 0x9f, 0x4f, 0xc4, 0xfd, 0x7c,
+	0x78, 0x6a, 0xc8, 0xcb, 0x2c, 0x07, 0x5e, 0x70, 0xe3, 0xba, 0x09, 0xb9, 0xb1, 0xc7, 0xcc, 0x33,
+	0xcb, 0x3f, 0xe3, 0xfd, 0xae, 0xd2, 0xec, 0xd8, 0x63, 0x4f, 0x2c, 0xff, 0x0c, 0x37, 0xc2, 0xd8,
+	0x73, 0xc7, 0xae, 0xcf, 0xc2, 0x88, 0x42, 0xc1, 0x78, 0x98, 0x71, 0xb7, 0x24, 0x0f, 0x33, 0xfc,
+	0x8d, 0xc1, 0x81, 0x4c, 0x99, 0x64, 0x39, 0x56, 0x42, 0xe8, 0x0b, 0x46, 0xcc, 0x3b, 0x1f, 0x32,
+	0x13, 0x3d, 0x04, 0x5f, 0x97, 0xab, 0x14, 0x04, 0x8a, 0xba, 0x6e, 0xa0, 0xdd, 0x46, 0xf2, 0xfa,
+	0x6d, 0x24, 0x7e, 0xd6, 0xc1, 0xec, 0x59, 0xf7, 0x4d, 0xf4, 0x20, 0xe1, 0x19, 0xef, 0x57, 0x0a,
+	0xda, 0x09, 0x14, 0xe1, 0x69, 0x8c, 0x09, 0xd5, 0x0d, 0xa6, 0xa6, 0xc8, 0xbe, 0xac, 0x0a, 0xcb,
+	0x05, 0xd3, 0x3a, 0x82, 0xda, 0x30, 0x03, 0x8f, 0xb1, 0x4a, 0x51, 0xc4, 0x1c, 0x02, 0xd5, 0xf5,
+	0x18, 0x37, 0x62, 0x7f, 0xe2, 0x75, 0x99, 0x37, 0xaa, 0x94, 0xe5, 0xac, 0x0b, 0x90, 0xdc, 0x85,
+	0x42, 0x7f, 0xe2, 0xf1, 0xa9, 0x69, 0x4d, 0x46, 0x95, 0x75, 0xe1, 0xcb, 0x34, 0x14, 0xf9, 0x3e,
+	0x00, 0x5e, 0xca, 0xd1, 0xf3, 0x4f, 0xfd, 0x0a, 0xe1, 0x43, 0xbd, 0x3b, 0x77, 0x5b, 0xdd, 0x7e,
+	0xc4, 0x79, 0xba, 0x53, 0xbf, 0xe1, 0x04, 0xde, 0x25, 0xcd, 0x9f, 0x28, 0x98, 0xdc, 0x06, 0x08,
+	0x2c, 0xef, 0x94, 0x05, 0xbb, 0x76, 0xe0, 0x57, 0xae, 0xf1, 0xa1, 0x6b, 0x18, 0x72, 0x1f, 0xb2,
+	0x3f, 0x98, 0xf8, 0x81, 0x7d, 0x72, 0x59, 0xd9, 0xb8, 0x9b, 0x50, 0xe7, 0xf7, 0xc7, 0x13, 0xd7,
+	0x9b, 0x8c, 0xea, 0xcc, 0x0b, 0xa8, 0x22, 0xa3, 0x09, 0x6c, 0xc7, 0xe4, 0x8e, 0x96, 0xe7, 0xa6,
+	0x72, 0x34, 0x6b, 0x3b, 0x5d, 0x04, 0x71, 0x15, 0x3a, 0x6c, 0x1a, 0x88, 0xd5, 0xb0, 0x26, 0xa6,
+	0x1c, 0x11, 0xb8, 0x1c, 0xaa, 0xdf, 0x85, 0x52, 0x7c, 0x78, 0xa4, 0x0c, 0x29, 0x9c, 0x6d, 0x11,
+	0xa5, 0xe3, 0x4f, 0x99, 0xb5, 0x98, 0xa8, 0x1b, 0x8d, 0x00, 0x3e, 0x4c, 0x7e, 0x90, 0x30, 0x7e,
+	0x9d, 0x80, 0xdc, 0x6e, 0xfd, 0x15, 0xa4, 0x99, 0x0c, 0x58, 0x19, 0xb1, 0xc0, 0xaa, 0xa4, 0x22,
+	0x2d, 0xa3, 0xa3, 0x89, 0x72, 0x5a, 0x94, 0x16, 0x58, 0x79, 0x71, 0x5a, 0x00, 0x9d, 0xc8, 0x44,
+	0x9e, 0x30, 0x95, 0x74, 0xe4, 0x44, 0xd4, 0xa9, 0x43, 0x43, 0x2a, 0x79, 0x13, 0x8a, 0xc7, 0x9e,
+	0xe5, 0xf4, 0xcf, 0xe4, 0x49, 0xc3, 0x73, 0x77, 0x79, 0x1a, 0x47, 0x1a, 0x1d, 0x28, 0xec, 0xd6,
+	0xbb, 0xf6, 0xf8, 0x0a, 0x7a, 0xde, 0x85, 0x55, 0xdb, 0x17, 0xd3, 0x61, 0x06, 0xf6, 0x58, 0x5e,
+	0x92, 0xc0, 0xf6, 0xf9, 0x94, 0x74, 0xed, 0x31, 0x17, 0x8a, 0xf2, 0xb9, 0x43, 0x7a, 0x59, 0xa1,
+	0x05, 0xae, 0x20, 0xf7, 0x78, 0xbe, 0x3a, 0x04, 0x35, 0x94, 0xf1, 0x79, 0x12, 0x32, 0x9d, 0x31,
+	0x63, 0x03, 0x9f, 0xbc, 0x0f, 0xf9, 0xce, 0x64, 0x24, 0x00, 0x1e, 0x6a, 0x17, 0x76, 0x6e, 0xf2,
+	0x78, 0x86, 0x63, 0xb6, 0x43, 0x9a, 0x5c, 0x93, 0x21, 0x4c, 0xbe, 0x05, 0xb9, 0xdd, 0xbe, 0x6c,
+	0x27, 0x6e, 0x65, 0x15, 0xad, 0xdd, 0x6e, 0x5f, 0x6f, 0x16, 0x72, 0xe2, 0x3a, 0x8a, 0x8b, 0xfc,
+	0xa2, 0x75, 0x94, 0xd0, 0xd6, 0x51, 0xb5, 0x09, 0xc5, 0xdd, 0xfe, 0x8b, 0x1b, 0x1b, 0x7a, 0x63,
+	0x39, 0xa3, 0xbb, 0x75, 0xd1, 0x46, 0x5f, 0x92, 0x3f, 0x85, 0x9c, 0x42, 0x93, 0x6f, 0x42, 0x56,
+	0x8a, 0xd5, 0x2d, 0xb0, 0x5b, 0x8f, 0xeb, 0x22, 0x54, 0x51, 0x9c, 0xd5, 0x0f, 0x61, 0x55, 0x27,
+	0x5c, 0x45, 0x0f, 0xe3, 0x97, 0x09, 0x28, 0x76, 0x2e, 0xfd, 0x80, 0x8d, 0xae, 0x72, 0x73, 0x7f,
+	0x17, 0xe0, 0xb8, 0xef, 0x9b, 0x32, 0x47, 0xa6, 0xa5, 0xe9, 0xd4, 0xd6, 0xa2, 0xf9, 0xe3, 0xbe,
+	0x26, 0xd0, 0x17, 0x93, 0xa3, 0x25, 0x88, 0xa4, 0x19, 0x24, 0x85, 0xfb, 0x78, 0xc6, 0xbc, 0x9e,
+	0x37, 0x14, 0xf7, 0x97, 0x3c, 0x0d, 0x61, 0xc3, 0x03, 0x12, 0x1b, 0xe1, 0x4b, 0xa7, 0x58, 0xc8,
+	0x07, 0x50, 0xf2, 0x45, 0xcb, 0x68, 0xa8, 0xe1, 0x46, 0x8c, 0xcb, 0x2c, 0xfa, 0x3a, 0x68, 0x50,
+	0xd8, 0xa8, 0x63, 0x3e, 0xd5, 0xf1, 0x27, 0x1c, 0x25, 0x8f, 0xe4, 0x2f, 0xe3, 0x31, 0x8c, 0x5f,
+	0x25, 0x60, 0x2d, 0x26, 0xf4, 0xe5, 0xaf, 0xf7, 0xea, 0x90, 0x95, 0xd7, 0x7b, 0x09, 0x62, 0x30,
+	0xda, 0x57, 0x02, 0x4d, 0xde, 0xa3, 0x88, 0x22, 0x8b, 0x21, 0xb6, 0xb5, 0x28, 0x03, 0x26, 0x5e,
+	0x12, 0x62, 0x19, 0xb0, 0xb7, 0x61, 0xed, 0x42, 0x24, 0x93, 0x5d, 0xcf, 0x17, 0x51, 0xb8, 0x78,
+	0x4e, 0x28, 0x45, 0x68, 0x1e, 0x81, 0xef, 0x41, 0x86, 0x5a, 0x9f, 0xf6, 0xbc, 0xe1, 0xcb, 0x9a,
+	0xc2, 0xe3, 0xdc, 0xca, 0x14, 0x02, 0x32, 0x8e, 0x61, 0xed, 0x90, 0x31, 0x8f, 0x7b, 0x12, 0x39,
+	0x82, 0x2b, 0xa6, 0x08, 0x67, 0x23, 0xfb, 0xd4, 0x6c, 0x64, 0x6f, 0xfc, 0x55, 0x12, 0x00, 0x3b,
+	0x91, 0xa9, 0xa1, 0x12, 0x24, 0xc3, 0x87, 0xa8, 0xa4, 0xb8, 0x77, 0x2f, 0x49, 0x9a, 0x54, 0xa2,
+	0x87, 0x91, 0x94, 0xa4, 0x08, 0x90, 0xbc, 0x0b, 0x19, 0xe9, 0xc9, 0xc4, 0x1d, 0x9b, 0x67, 0x74,
+	0x66, 0x14, 0xa1, 0x92, 0x85, 0x3c, 0xc4, 0x38, 0xcb, 0x63, 0xfc, 0xc0, 0xe7, 0xc6, 0x2c, 0xed,
+	0xac, 0x2b, 0xfe, 0x3d, 0x45, 0xa0, 0x11, 0x0f, 0xf6, 0x8b, 0x97, 0x08, 0xa7, 0x7f, 0x29, 0xe3,
+	0x73, 0x05, 0xf2, 0xdb, 0x84, 0xe5, 0x07, 0xe6, 0xc8, 0x3f, 0xe5, 0x37, 0x36, 0x99, 0x1f, 0x29,
+	0x20, 0xf2, 0xc0, 0x3f, 0xc5, 0xbb, 0x1a, 0x6e, 0x71, 0x91, 0x6c, 0x92, 0xd7, 0x74, 0x0e, 0xe0,
+	0x1d, 0x63, 0xec, 0xb9, 0xc7, 0x4c, 0x34, 0x93, 0xf7, 0x74, 0x8e, 0xc1, 0x46, 0x98, 0x4f, 0x2e,
+	0x47, 0x36, 0xba, 0xc2, 0xce, 0x7a, 0x13, 0x5f, 0xf6, 0xfa, 0xd6, 0xb0, 0x92, 0x8c, 0x8e, 0xbf,
+	0x48, 0x10, 0x15, 0x44, 0xe4, 0xc2, 0x5d, 0xac, 0xb2, 0x17, 0x73, 0x5c, 0x9c, 0x68, 0xfc, 0x22,
+	0x01, 0x2b, 0x78, 0xd2, 0x2d, 0xcd, 0xea, 0x6c, 0x82, 0x4c, 0xe3, 0xcc, 0x24, 0x75, 0xaa, 0x90,
+	0x0b, 0x5c, 0xf1, 0x16, 0x26, 0xe7, 0x3f, 0x84, 0xd1, 0x98, 0x32, 0x63, 0xa5, 0xc2, 0x49, 0x09,
+	0x62, 0x34, 0x17, 0xa6, 0xab, 0x2a, 0xe9, 0x99, 0xfc, 0x95, 0xf1, 0x27, 0x49, 0xc8, 0xe3, 0x60,
+	0x44, 0x1e, 0xec, 0x4b, 0xbe, 0x2e, 0xa8, 0x05, 0x96, 0x8a, 0x2f, 0xb0, 0x2d, 0xc8, 0x8b, 0x14,
+	0x52, 0xf4, 0xac, 0x17, 0x21, 0x90, 0xca, 0x6f, 0x84, 0x2d, 0x3c, 0x04, 0xc4, 0x26, 0x8c, 0x10,
+	0xa8, 0xb3, 0x7a, 0xc1, 0x93, 0xe1, 0x6d, 0x08, 0x23, 0xcd, 0x61, 0x6c, 0xb0, 0x8f, 0x11, 0x47,
+	0x4e, 0x64, 0x71, 0x14, 0xfc, 0x05, 0xf9, 0x9a, 0x30, 0x5a, 0x07, 0x2d, 0xc7, 0x63, 0xfc, 0x0c,
+	0x00, 0x4d, 0x21, 0x73, 0x6e, 0x2f, 0xb7, 0x2c, 0x78, 0xa4, 0xb2, 0xaf, 0x6e, 0xbc, 0x85, 0x9d,
+	0x9c, 0x8a, 0x63, 0x68, 0x48, 0xc1, 0x18, 0x86, 0x2b, 0xd4, 0x61, 0x43, 0xd6, 0x0f, 0xd8, 0x40,
+	0x79, 0xad, 0x18, 0xd2, 0xf8, 0xeb, 0x04, 0x94, 0x5a, 0x56, 0x60, 0x5f, 0xb0, 0xba, 0x3b, 0x60,
+	0x7b, 0x98, 0xa6, 0x22, 0xb0, 0xa2, 0x79, 0x88, 0x15, 0x65, 0xe6, 0x25, 0xde, 0x71, 0x13, 0x32,
+	0x03, 0xfb, 0x94, 0xf9, 0x81, 0x5c, 0x1c, 0x12, 0xc2, 0xa0, 0x64, 0xec, 0xb1, 0x8b, 0x67, 0xb2,
+	0x95, 0xf4, 0x86, 0x1a, 0x8a, 0xdc, 0x87, 0x35, 0x9e, 0xcc, 0xa8, 0x8d, 0x6d, 0xc5, 0x25, 0x16,
+	0xca, 0x2c, 0x1a, 0x07, 0xb9, 0xfa, 0xdc, 0xf2, 0x47, 0xe1, 0x10, 0x71, 0xdd, 0x4d, 0x9c, 0xc0,
+	0x0e, 0x47, 0xa9, 0x40, 0x91, 0x63, 0x1b, 0x8d, 0xed, 0x21, 0xf3, 0xd4, 0xab, 0xb7, 0x82, 0x97,
+	0x0e, 0xf5, 0x0e, 0x14, 0x2e, 0x46, 0x66, 0xd8, 0x4c, 0x0c, 0x15, 0x2e, 0x46, 0x75, 0xd5, 0xf0,
+	0x0d, 0x28, 0x86, 0x99, 0xac, 0xe0, 0x72, 0xcc, 0xe4, 0x82, 0x59, 0x55, 0x48, 0x7c, 0x5f, 0x34,
+	0x86, 0x50, 0x8e, 0x0c, 0x29, 0x0f, 0x9e, 0xb7, 0x64, 0x16, 0x30, 0x11, 0xe5, 0x73, 0xe2, 0xc6,
+	0x96, 0x99, 0xc1, 0xcd, 0xf0, 0x25, 0x4c, 0x5c, 0xe4, 0x24, 0x84, 0x7a, 0x9e, 0x31, 0x6b, 0x18,
+	0x9c, 0x5d, 0xca, 0x27, 0x22, 0x05, 0x1a, 0x1d, 0xb8, 0xbe, 0x37, 0x76, 0xfd, 0xba, 0xe5, 0x0c,
+	0xf8, 0xe3, 0xa4, 0xff, 0x2a, 0xce, 0xce, 0x01, 0x6c, 0xce, 0x0a, 0xbd, 0xc2, 0x83, 0xe7, 0x5b,
+	0x50, 0xea, 0x87, 0x2d, 0xf1, 0x18, 0x93, 0x91, 0xe8, 0x0c, 0xd6, 0xf0, 0xa0, 0x8a, 0xbd, 0xb4,
+	0xdc, 0x91, 0xed, 0x58, 0x01, 0xa3, 0xac, 0xef, 0x7a, 0x83, 0x57, 0x31, 0xfe, 0xe5, 0xce, 0xc0,
+	0xd8, 0x83, 0xb2, 0xde, 0x27, 0x8e, 0x03, 0x37, 0x6b, 0x38, 0x32, 0xb9, 0x8c, 0x22, 0x44, 0x98,
+	0x45, 0x16, 0x3d, 0xf0, 0xdf, 0xc6, 0x1f, 0x25, 0xe0, 0xd6, 0xc2, 0xa1, 0x5f, 0xc1, 0x4a, 0x1f,
+	0xc1, 0x9a, 0x13, 0x6f, 0x2e, 0xf7, 0xf0, 0x06, 0x32, 0xcf, 0x0e, 0x92, 0xce, 0x32, 0x1b, 0x3f,
+	0x81, 0x9b, 0x21, 0x13, 0xfb, 0x6a, 0x8c, 0xd7, 0x85, 0xea, 0xa2, 0x2e, 0xaf, 0xa0, 0xf4, 0x22,
+	0x63, 0x3a, 0x62, 0xb1, 0x3d, 0x73, 0xbf, 0xa2, 0x25, 0xf0, 0x11, 0xc0, 0x45, 0xd8, 0xd7, 0x6f,
+	0x30, 0xf9, 0x9f, 0xc2, 0x8d, 0xb9, 0xf1, 0x5e, 0xc1, 0x04, 0x1f, 0xc0, 0x1a, 0x76, 0x8f, 0x87,
+	0x63, 0x7c, 0xde, 0xf9, 0x79, 0x1d, 0x8d, 0x8c, 0xce, 0xb2, 0x19, 0x6e, 0xd4, 0xf1, 0xe0, 0x2b,
+	0xb1, 0xd4, 0xfb, 0x50, 0xb8, 0x88, 0x3a, 0xe3, 0xd7, 0x1a, 0x37, 0x90, 0x7d, 0xe4, 0xa9, 0x00,
+	0x16, 0x9a, 0xe8, 0xa7, 0x50, 0x99, 0x1f, 0xe9, 0x15, 0x6c, 0xf4, 0x1d, 0x28, 0xf3, 0x8e, 0xe7,
+	0x8d, 0xb4, 0xa6, 0x8c, 0x24, 0xf1, 0x74, 0x8e, 0xd1, 0xb0, 0x85, 0x99, 0x78, 0x6d, 0x04, 0x65,
+	0xfe, 0x64, 0x18, 0xbc, 0x12, 0x33, 0xa1, 0x9e, 0x98, 0x04, 0x12, 0x39, 0x3c, 0xfe, 0xdb, 0x08,
+	0xa0, 0x32, 0xdf, 0xd5, 0x15, 0xb7, 0x03, 0xca, 0x4c, 0x46, 0x32, 0x79, 0x56, 0x29, 0x92, 0xc7,
+	0x63, 0xb9, 0x3c, 0xd5, 0x51, 0x46, 0x1b, 0xd6, 0xb1, 0x57, 0x75, 0x3d, 0xfb, 0xf2, 0xee, 0xfe,
+	0x47, 0x40, 0x74, 0x81, 0x57, 0x72, 0xf5, 0x99, 0xd8, 0x55, 0xaf, 0xa4, 0x7c, 0x57, 0xbc, 0x62,
+	0xc3, 0xf8, 0xcb, 0x04, 0x40, 0x84, 0x0e, 0xf5, 0x4e, 0x68, 0x7a, 0xdf, 0x82, 0xbc, 0x48, 0x99,
+	0x3b, 0x13, 0x65, 0x90, 0xdc, 0xb1, 0x4a, 0xa4, 0xe9, 0x49, 0x49, 0x59, 0xb8, 0xa5, 0x60, 0xbc,
+	0x6f, 0xa9, 0xdf, 0xbc, 0xad, 0xc8, 0xa3, 0x16, 0x14, 0xae, 0x35, 0x99, 0xb3, 0x69, 0x7a, 0xde,
+	0xa6, 0xff, 0x94, 0x80, 0xb2, 0x4c, 0x07, 0x1f, 0xd6, 0x5f, 0xc5, 0x72, 0xf9, 0x3a, 0xbe, 0xe9,
+	0xca, 0xb7, 0xae, 0xd4, 0xb2, 0xac, 0x7e, 0xc8, 0x12, 0x7f, 0xe3, 0x5a, 0xf9, 0xa2, 0x37, 0xae,
+	0xf4, 0xdc, 0x1b, 0x97, 0xf1, 0x87, 0xb0, 0xae, 0x8d, 0xff, 0x15, 0x94, 0x27, 0x6d, 0xa3, 0x02,
+	0x42, 0x4e, 0x25, 0x15, 0x85, 0x2d, 0x4a, 0x01, 0x41, 0xa1, 0x21, 0x8f, 0xf1, 0xf7, 0x49, 0x28,
+	0x2a, 0xa2, 0x30, 0x1f, 0xa6, 0x56, 0xdd, 0xc1, 0x64, 0xc8, 0x4c, 0x2d, 0x8c, 0x04, 0x81, 0xe2,
+	0x37, 0x65, 0x3d, 0x9c, 0xd2, 0x46, 0x10, 0x86, 0x53, 0x9c, 0x09, 0xa5, 0xb0, 0xe0, 0xcc, 0x1d,
+	0xe8, 0x57, 0x6e, 0x10, 0x28, 0xce, 0xf0, 0x10, 0x56, 0x2c, 0xef, 0x54, 0x5d, 0x12, 0x6f, 0xcd,
+	0x59, 0x79, 0xbb, 0xe6, 0x9d, 0xca, 0x74, 0x14, 0x67, 0xc4, 0xe7, 0xc0, 0xf0, 0xa9, 0x63, 0x68,
+	0x8f, 0x30, 0xb3, 0x9a, 0x8e, 0x66, 0x48, 0x3d, 0x72, 0xec, 0x23, 0x85, 0x96, 0x3c, 0x1d, 0xf4,
+	0x67, 0xde, 0xd4, 0xc3, 0xd2, 0xc6, 0xea, 0xfb, 0x90, 0x0f, 0xbb, 0xf9, 0xa2, 0x8c, 0xd0, 0xaa,
+	0x9e, 0x11, 0xfa, 0xef, 0x24, 0x94, 0xe2, 0x36, 0xc5, 0x4d, 0x25, 0x9f, 0xa1, 0x13, 0x0b, 0xdf,
+	0x64, 0x25, 0x95, 0xbc, 0x03, 0x59, 0xf5, 0x08, 0x9d, 0x5c, 0xfc, 0x0e, 0xab, 0xe8, 0xb8, 0x7f,
+	0xb4, 0xc9, 0xc4, 0x14, 0x77, 0x08, 0x63, 0x66, 0xf8, 0xd4, 0xf2, 0xcd, 0x89, 0xcf, 0x06, 0x72,
+	0xef, 0x64, 0x4f, 0x2d, 0xbf, 0xe7, 0xb3, 0x41, 0x6c, 0x11, 0xa7, 0xbf, 0x78, 0x11, 0xef, 0x40,
+	0x5e, 0x49, 0xf5, 0x2b, 0x99, 0x28, 0x98, 0xa9, 0x87, 0x2f, 0xba, 0x82, 0x48, 0x23, 0x36, 0xcc,
+	0x6d, 0x4d, 0xd4, 0x05, 0x50, 0xbd, 0x7f, 0xc5, 0xde, 0xdd, 0x35, 0x32, 0xd9, 0x86, 0xc2, 0x24,
+	0xbc, 0x22, 0xf9, 0x95, 0xdc, 0x82, 0xa7, 0x77, 0x9d, 0xc1, 0x18, 0x03, 0x44, 0x76, 0xe3, 0x2b,
+	0x7d, 0xd2, 0x3f, 0x67, 0x41, 0x98, 0xf3, 0xe0, 0x90, 0x9a, 0x2e, 0x31, 0x35, 0xf8, 0x33, 0x56,
+	0x90, 0x91, 0x7a, 0x51, 0x41, 0xc6, 0xca, 0xec, 0x85, 0xf6, 0x00, 0x0a, 0xda, 0x04, 0x5c, 0xa1,
+	0xcb, 0x70, 0x85, 0xa4, 0xb4, 0x15, 0x62, 0xd4, 0xa0, 0x18, 0x7b, 0x5f, 0x46, 0x3f, 0x71, 0xa8,
+	0xea, 0x21, 0x54, 0xb8, 0x12, 0x22, 0xd0, 0xaf, 0x22, 0xbb, 0x94, 0xcb, 0x7f, 0x1b, 0x3f, 0xc4,
+	0xe4, 0x8f, 0x37, 0xb2, 0x7d, 0xbc, 0x41, 0x1d, 0xb8, 0x03, 0x36, 0xc4, 0xdb, 0x88, 0x37, 0x19,
+	0x32, 0x59, 0x20, 0x49, 0x44, 0x9e, 0x40, 0xb1, 0xd0, 0xc9, 0x90, 0x51, 0x4e, 0x47, 0xb7, 0x69,
+	0xf5, 0xfb, 0x6c, 0x1c, 0x3c, 0xd3, 0xb2, 0x99, 0x3a, 0xca, 0xb8, 0x09, 0xe9, 0xda, 0x79, 0x47,
+	0x28, 0x64, 0x9d, 0x8b, 0x05, 0x9b, 0xa7, 0xf8, 0xd3, 0xf8, 0xf3, 0x04, 0x64, 0x38, 0x0d, 0x5f,
+	0x29, 0x56, 0x7c, 0x16, 0x2e, 0x67, 0xbe, 0x24, 0x04, 0x65, 0x1b, 0xff, 0x91, 0x5b, 0x13, 0x39,
+	0xf0, 0xbd, 0x83, 0x4d, 0xc7, 0x18, 0x7c, 0x44, 0x37, 0x4c, 0x0d, 0x53, 0xdd, 0x85, 0x7c, 0xd8,
+	0x64, 0xc1, 0x36, 0xbb, 0x13, 0xcf, 0x01, 0xe7, 0xc3, 0x9e, 0xf4, 0x1d, 0xf7, 0xab, 0x04, 0xa4,
+	0x6a, 0xfd, 0x21, 0x79, 0x03, 0x92, 0xe3, 0x91, 0x74, 0x8c, 0xd7, 0xe2, 0x36, 0xe0, 0x66, 0xa2,
+	0xc9, 0xf1, 0x88, 0x7c, 0x0b, 0xf2, 0xd6, 0xb9, 0xff, 0x5c, 0xa5, 0xc4, 0xc2, 0xba, 0x9e, 0x5a,
+	0x7f, 0xb8, 0x5d, 0x53, 0x04, 0x99, 0x22, 0x0f, 0x19, 0xd1, 0xef, 0x5a, 0x5c, 0x41, 0x3d, 0x07,
+	0x2b, 0x54, 0xa6, 0x92, 0x82, 0x09, 0xf1, 0xb8, 0x80, 0x2b, 0x25, 0x92, 0xff, 0x37, 0x01, 0xf9,
+	0x5a, 0x7f, 0xf8, 0x0a, 0x5e, 0x56, 0xc4, 0x24, 0xa3, 0x13, 0x6b, 0x45, 0xfe, 0x55, 0x47, 0x11,
+	0x03, 0x62, 0x1e, 0x59, 0x1e, 0x4f, 0x31, 0x1c, 0x4e, 0x5c, 0xe4, 0x92, 0x55, 0x6d, 0x74, 0x84,
+	0xe1, 0x61, 0xb6, 0x78, 0x27, 0x67, 0x03, 0xee, 0x3a, 0x73, 0x34, 0x42, 0x90, 0x9b, 0x90, 0xb2,
+	0xfa, 0x43, 0x59, 0xe6, 0x9b, 0x95, 0xf6, 0xa5, 0x88, 0x33, 0xfe, 0x38, 0x01, 0xab, 0xcd, 0x01,
+	0x73, 0x02, 0x3b, 0xb8, 0xac, 0x4d, 0x82, 0xb3, 0xf0, 0x0d, 0x32, 0xb1, 0xf0, 0x0d, 0x32, 0x19,
+	0x7b, 0x83, 0x24, 0xb0, 0xa2, 0xd5, 0x7a, 0xf3, 0xdf, 0x9c, 0x97, 0x31, 0xaf, 0xb9, 0x27, 0xf5,
+	0x90, 0x50, 0x3c, 0x65, 0xa3, 0x12, 0x41, 0x0a, 0x61, 0x7c, 0x1b, 0x8a, 0xfa, 0x28, 0x7c, 0xf2,
+	0x26, 0xac, 0xe0, 0xf1, 0x2b, 0xd7, 0x74, 0x99, 0xbb, 0x45, 0x8d, 0x81, 0x72, 0xaa, 0xf1, 0x14,
+	0x8a, 0xb1, 0xf3, 0x04, 0x9b, 0xf1, 0xc4, 0x81, 0xd8, 0x7a, 0x65, 0xfd, 0xc0, 0xe1, 0xc5, 0xc9,
+	0x9c, 0xca, 0x2b, 0xf9, 0x91, 0x5d, 0xc6, 0x41, 0x02, 0x30, 0x6c, 0x58, 0xaf, 0x3d, 0xdd, 0x09,
+	0xdf, 0xe2, 0x7f, 0x9b, 0x91, 0xff, 0x8f, 0x81, 0xe8, 0x5d, 0xbd, 0x82, 0x70, 0x22, 0x96, 0xe6,
+	0x4d, 0x69, 0x69, 0x5e, 0x4c, 0x03, 0x3c, 0x66, 0x81, 0xec, 0x2b, 0x2c, 0x6f, 0x78, 0x55, 0xfa,
+	0x2d, 0x4c, 0x2d, 0x63, 0x01, 0xe0, 0xad, 0x85, 0x9d, 0x5e, 0x41, 0xd3, 0xef, 0x41, 0x58, 0xaa,
+	0x34, 0xf3, 0x36, 0x43, 0xf4, 0x43, 0x4f, 0x46, 0xc2, 0x6b, 0x21, 0xaf, 0x40, 0x18, 0x7f, 0x97,
+	0x80, 0x52, 0x9c, 0x67, 0x3e, 0x1e, 0x4a, 0x2c, 0xd8, 0x69, 0x0b, 0xee, 0x5b, 0x61, 0x91, 0x59,
+	0x4a, 0x2b, 0x32, 0xbb, 0x05, 0x79, 0xdb, 0x37, 0x8f, 0x2d, 0xc7, 0x61, 0xaa, 0x9c, 0x3c, 0x67,
+	0xfb, 0xbb, 0x1c, 0x9e, 0x5f, 0xec, 0xb3, 0xf5, 0x64, 0x2a, 0xab, 0x96, 0x89, 0x65, 0xd5, 0x8c,
+	0x7f, 0x4d, 0xc2, 0xd6, 0xa1, 0xc7, 0x1a, 0x53, 0xd6, 0x7f, 0x6e, 0x07, 0x67, 0x22, 0x7b, 0xd8,
+	0xeb, 0x1e, 0xb5, 0x7f, 0xab, 0xcb, 0x11, 0x7d, 0x14, 0xcf, 0x56, 0xca, 0xd2, 0x1b, 0x19, 0xe1,
+	0x6b, 0x28, 0x8c, 0x54, 0xd0, 0x13, 0xf0, 0x6c, 0x53, 0x46, 0x7b, 0x75, 0x8a, 0x15, 0x67, 0x85,
+	0x2c, 0xb1, 0xdc, 0x6d, 0x76, 0x26, 0x77, 0xbb, 0x8d, 0xb9, 0x6c, 0xae, 0x8d, 0x7c, 0x1c, 0xde,
+	0xd0, 0x62, 0x9e, 0xf0, 0x72, 0x40, 0x15, 0x53, 0xdc, 0x96, 0xb9, 0xa5, 0xb9, 0xde, 0xbc, 0x9e,
+	0xeb, 0xfd, 0xc7, 0x04, 0xbc, 0xbe, 0xc4, 0x8e, 0x5f, 0x7d, 0xe8, 0x4e, 0xb6, 0x45, 0x0c, 0x26,
+	0xc2, 0x16, 0xf9, 0x7a, 0x5e, 0x52, 0x99, 0x64, 0x81, 0xa5, 0x1a, 0x87, 0x71, 0x04, 0xe5, 0xd9,
+	0x90, 0x4e, 0xcb, 0x5c, 0x26, 0x66, 0x33, 0x97, 0x23, 0xe6, 0xfb, 0xd6, 0x69, 0x58, 0xef, 0x2c,
+	0x41, 0x5c, 0xb4, 0xc7, 0xee, 0x40, 0xbd, 0x25, 0xf0, 0xdf, 0xf8, 0x99, 0x45, 0x41, 0xab, 0x59,
+	0xc3, 0x27, 0x37, 0x76, 0x72, 0xc2, 0xfa, 0x98, 0x2a, 0x8d, 0xea, 0x63, 0xf3, 0xb4, 0x18, 0x62,
+	0xbb, 0xf2, 0x83, 0x9f, 0x91, 0xe5, 0x9d, 0xb3, 0x81, 0x7c, 0x47, 0x97, 0x10, 0x79, 0x07, 0xca,
+	0x51, 0xf3, 0x58, 0xc9, 0xd9, 0x5a, 0x88, 0x8f, 0x8a, 0xd2, 0xa3, 0xda, 0xd3, 0xf8, 0x33, 0x81,
+	0x8c, 0xac, 0xf8, 0xa9, 0x23, 0x0e, 0x06, 0xfe, 0xdb, 0xf8, 0x18, 0x64, 0xa1, 0x1c, 0xbe, 0x18,
+	0x9d, 0x0d, 0x4c, 0xad, 0xbd, 0xac, 0x8d, 0x3b, 0x1b, 0x44, 0xb1, 0xd9, 0x1b, 0x50, 0x74, 0x3d,
+	0xfb, 0xd4, 0x76, 0xac, 0xa1, 0xa8, 0xb4, 0x10, 0x47, 0xd5, 0xaa, 0x42, 0x62, 0xb5, 0x85, 0xf1,
+	0x2f, 0x49, 0x28, 0xf3, 0xf4, 0x3d, 0xcf, 0x65, 0xc8, 0xb7, 0xb4, 0xdf, 0xee, 0xe9, 0xfe, 0xbb,
+	0x50, 0x72, 0xc7, 0xcc, 0x89, 0x7a, 0x9d, 0x5d, 0x00, 0x02, 0x4b, 0x67, 0xb8, 0xc8, 0x87, 0x50,
+	0xc6, 0x29, 0x62, 0x03, 0xad, 0x65, 0x7a, 0x61, 0xcb, 0x39, 0x3e, 0x6c, 0x2b, 0x4a, 0x81, 0xb5,
+	0xb6, 0x99, 0xc5, 0x6d, 0x67, 0xf9, 0x30, 0x1a, 0x19, 0xd8, 0xfe, 0x78, 0x68, 0x5d, 0xf2, 0x02,
+	0x1e, 0x55, 0xbc, 0xac, 0xe3, 0x8c, 0x73, 0x00, 0xad, 0xc5, 0x16, 0xf0, 0x3a, 0xbf, 0x7a, 0xf8,
+	0xd6, 0x95, 0xa7, 0x11, 0x02, 0x23, 0x17, 0x04, 0x6a, 0xfa, 0x07, 0x6b, 0x1a, 0x86, 0xdc, 0x81,
+	0x15, 0x3b, 0x60, 0x23, 0xbd, 0x24, 0x18, 0x65, 0x3f, 0x65, 0x97, 0x94, 0x13, 0x8c, 0x0e, 0x64,
+	0x25, 0x42, 0x7f, 0x06, 0x53, 0xcf, 0x11, 0x02, 0xc4, 0xf9, 0xd1, 0x6a, 0xb8, 0xf3, 0x54, 0x42,
+	0xda, 0x7d, 0x32, 0xa5, 0xdf, 0x27, 0x8d, 0x1e, 0xdc, 0xd0, 0x0f, 0x07, 0xfc, 0x4a, 0xec, 0x55,
+	0x64, 0x7a, 0x3e, 0x4f, 0x40, 0x65, 0x5e, 0xee, 0x2b, 0x70, 0x39, 0xf7, 0x61, 0x65, 0x60, 0x85,
+	0xf5, 0x39, 0x1b, 0xb3, 0x07, 0x20, 0xef, 0x87, 0x73, 0x18, 0xbf, 0x0f, 0xe5, 0x59, 0x0a, 0xce,
+	0xa9, 0xa5, 0x8e, 0x62, 0x35, 0x49, 0x29, 0x1a, 0xc3, 0xe1, 0x33, 0x96, 0x3a, 0x07, 0xeb, 0xe1,
+	0x54, 0xa5, 0x68, 0x1c, 0x69, 0xfc, 0x69, 0x02, 0x6e, 0xc8, 0xca, 0xfe, 0x57, 0x1e, 0x4a, 0x2c,
+	0x3e, 0x9b, 0x66, 0x3f, 0x57, 0x59, 0x99, 0xff, 0x5c, 0xe5, 0x29, 0xac, 0xaa, 0xc1, 0xf0, 0x17,
+	0xb9, 0xef, 0x40, 0x18, 0x0d, 0x98, 0xa1, 0xd3, 0x5c, 0x16, 0x38, 0x94, 0xfa, 0x31, 0xd8, 0xf8,
+	0xaf, 0x04, 0x54, 0xe6, 0x35, 0xbc, 0xc2, 0x14, 0x36, 0x79, 0x28, 0x2e, 0x1a, 0xca, 0x80, 0xe5,
+	0x5d, 0x1e, 0x72, 0x2f, 0x11, 0x1a, 0x0e, 0x48, 0x95, 0x02, 0x85, 0xad, 0xab, 0x2d, 0x28, 0xc5,
+	0x89, 0x0b, 0xee, 0x30, 0x6f, 0xc5, 0xef, 0x64, 0x65, 0x5d, 0x45, 0xb4, 0x86, 0x7e, 0xab, 0xf9,
+	0x87, 0x04, 0xac, 0xd7, 0x3d, 0xd7, 0xf7, 0x3f, 0x9e, 0x30, 0xef, 0x52, 0xcd, 0xdb, 0xb2, 0x5a,
+	0x85, 0xd8, 0xc1, 0x9b, 0x9c, 0x3d, 0x78, 0x63, 0x19, 0xb5, 0xd4, 0x17, 0x65, 0xd4, 0x56, 0xe6,
+	0xab, 0xc6, 0xdf, 0x9d, 0x8d, 0x03, 0x16, 0xe4, 0x3e, 0x14, 0x87, 0xf1, 0x08, 0x88, 0x3e, 0x70,
+	0x39, 0x1d, 0xdf, 0xd0, 0x0e, 0xe2, 0xc4, 0xfc, 0xce, 0x58, 0x90, 0x45, 0x43, 0x8b, 0xa2, 0x1c,
+	0x5e, 0xe2, 0xc0, 0x4b, 0xd0, 0x88, 0x76, 0x63, 0xc8, 0xcb, 0xfb, 0xc1, 0x7d, 0x28, 0x8f, 0x6c,
+	0xc7, 0x64, 0xce, 0xc0, 0xf5, 0x7c, 0xd7, 0xd3, 0x52, 0xa6, 0xa5, 0x91, 0xed, 0x34, 0x24, 0xba,
+	0x35, 0x19, 0x19, 0xcf, 0xa0, 0xc8, 0xe5, 0x29, 0xdc, 0x0b, 0xbe, 0xda, 0xbd, 0x01, 0xd9, 0xf1,
+	0xe4, 0xd8, 0x54, 0xb7, 0xa8, 0x3c, 0xbf, 0x45, 0xc9, 0xb3, 0xef, 0xcc, 0xf5, 0x95, 0x87, 0xe2,
+	0xbf, 0x8d, 0x00, 0x4a, 0x91, 0xbe, 0x7c, 0x9c, 0xef, 0x01, 0x88, 0x4a, 0x5b, 0x5e, 0xa7, 0xa7,
+	0x3d, 0x74, 0xc6, 0xf5, 0xa1, 0xf9, 0x7e, 0xa8, 0xda, 0x43, 0xc8, 0x2b, 0x15, 0xd4, 0x4a, 0x5c,
+	0x0f, 0x5b, 0xa8, 0x11, 0xd3, 0x88, 0x07, 0xd3, 0xc8, 0x5a, 0xb7, 0xfc, 0xe8, 0x7d, 0x18, 0xcd,
+	0x92, 0xe8, 0xf3, 0x7a, 0x28, 0x41, 0x5f, 0x44, 0x51, 0xb8, 0xb6, 0xa3, 0xcd, 0x89, 0x58, 0x92,
+	0x9b, 0xb3, 0x2d, 0xe6, 0x02, 0xa4, 0xb7, 0x21, 0x2d, 0xea, 0xfe, 0x53, 0xcb, 0xea, 0xfe, 0x05,
+	0xdd, 0xe8, 0x40, 0x51, 0x4d, 0x6e, 0xe3, 0x82, 0x39, 0x81, 0x78, 0x86, 0x16, 0x08, 0x69, 0xef,
+	0x10, 0x0e, 0xdf, 0xd7, 0x93, 0xda, 0xfb, 0xfa, 0x82, 0xa0, 0xe8, 0xc1, 0xbf, 0x67, 0x60, 0x6d,
+	0xe6, 0x43, 0x26, 0xfc, 0x4e, 0xb1, 0xd3, 0xab, 0xd7, 0x1b, 0x9d, 0x4e, 0xf9, 0x35, 0x52, 0x86,
+	0xd5, 0x5e, 0xeb, 0x69, 0xab, 0xfd, 0xdc, 0x14, 0x5f, 0x37, 0x26, 0x08, 0x81, 0x52, 0xbd, 0xdd,
+	0x6a, 0x35, 0xea, 0x5d, 0x93, 0x36, 0x1e, 0xf5, 0x3a, 0x8d, 0x72, 0x92, 0xdc, 0x84, 0xeb, 0xad,
+	0x76, 0xd7, 0x6c, 0xb4, 0xda, 0xbd, 0xc7, 0x4f, 0x4c, 0x0c, 0x36, 0x25, 0x7b, 0x8a, 0x18, 0x70,
+	0x1b, 0xe1, 0x67, 0x07, 0x66, 0x6d, 0x9f, 0x36, 0x6a, 0x7b, 0x9f, 0x98, 0xbd, 0x56, 0xbd, 0xdd,
+	0x7a, 0xd4, 0xa4, 0x07, 0x92, 0x67, 0x85, 0x54, 0x61, 0x53, 0xf2, 0xa0, 0x94, 0x47, 0xed, 0x5e,
+	0x6b, 0x4f, 0xd2, 0xd2, 0xe4, 0x2e, 0x6c, 0x35, 0x5b, 0x87, 0xbd, 0xae, 0xd9, 0xee, 0x75, 0xf1,
+	0x3f, 0xde, 0xcf, 0xc7, 0xbd, 0xda, 0xbe, 0xe4, 0xc8, 0x90, 0x4d, 0x20, 0xdd, 0xa3, 0xb9, 0x96,
+	0x59, 0xb2, 0x0e, 0xc5, 0xee, 0x91, 0xd9, 0x69, 0x3e, 0x6e, 0x49, 0x54, 0x8e, 0xdc, 0x80, 0x6b,
+	0xbb, 0xfb, 0xed, 0xfa, 0xd3, 0xfa, 0x93, 0x5a, 0xb3, 0x85, 0x4d, 0xc4, 0xe7, 0x98, 0x79, 0x54,
+	0xea, 0x59, 0x6d, 0xbf, 0xb9, 0x57, 0xeb, 0x36, 0x24, 0x33, 0x90, 0x5b, 0x70, 0xa3, 0x5e, 0x6b,
+	0xa1, 0xdc, 0xce, 0x27, 0xad, 0xba, 0xc9, 0x1b, 0x4a, 0x62, 0x01, 0x25, 0x29, 0x2d, 0x74, 0xc2,
+	0x2a, 0xb9, 0x0e, 0xeb, 0x52, 0x97, 0xc3, 0xfd, 0xda, 0x27, 0x12, 0x5d, 0x24, 0x25, 0x80, 0xe7,
+	0xb5, 0x7d, 0xc5, 0x56, 0x22, 0xd7, 0x60, 0x0d, 0x25, 0x0b, 0x8b, 0x08, 0xe4, 0x1a, 0xb6, 0x95,
+	0xc2, 0x70, 0x58, 0x12, 0x5d, 0x46, 0xf3, 0xd0, 0x76, 0xbb, 0x6b, 0xce, 0xd3, 0xd6, 0xa5, 0xf2,
+	0x7b, 0xbd, 0xc3, 0xfd, 0x66, 0x3d, 0x1a, 0xfc, 0x35, 0x9c, 0x91, 0x4e, 0x83, 0x3e, 0x6b, 0xd6,
+	0x1b, 0x72, 0x96, 0x94, 0x5d, 0x36, 0xb0, 0x97, 0xee, 0xd1, 0x5e, 0xad, 0x5b, 0xd3, 0x6d, 0x73,
+	0x1d, 0x67, 0x1a, 0xcd, 0xb5, 0xaf, 0x64, 0xdc, 0x44, 0x03, 0x74, 0x8f, 0xcc, 0x47, 0x8d, 0x86,
+	0xa9, 0x4d, 0xae, 0x20, 0x56, 0x51, 0x01, 0x3e, 0xcf, 0x9a, 0x8c, 0x2d, 0xb2, 0x01, 0xe5, 0xbd,
+	0xc3, 0x76, 0xc7, 0xfc, 0xb8, 0xd7, 0xa0, 0x4a, 0xad, 0x3b, 0x68, 0x2b, 0xfa, 0xbc, 0xd3, 0xe8,
+	0x9a, 0xcd, 0x16, 0x37, 0xb2, 0x24, 0xdc, 0x13, 0x84, 0x5a, 0x7d, 0x7f, 0x86, 0x60, 0x90, 0x0a,
+	0x6c, 0x3c, 0xae, 0x75, 0xe6, 0xbb, 0x7d, 0x83, 0x6c, 0x41, 0xa5, 0x7b, 0x64, 0x3e, 0x6b, 0xd0,
+	0x4e, 0xb3, 0xdd, 0x9a, 0x69, 0xf7, 0x26, 0xb9, 0x07, 0xaf, 0xd7, 0xdb, 0x07, 0x87, 0xfb, 0xcd,
+	0x5a, 0xab, 0xde, 0x30, 0xeb, 0x4f, 0x1a, 0xf5, 0xa7, 0x5c, 0x48, 0xed, 0xf0, 0x90, 0xb6, 0x9f,
+	0x35, 0xf6, 0xca, 0x5f, 0x43, 0x96, 0x5a, 0xbd, 0xde, 0xee, 0xb5, 0xba, 0x66, 0xbd, 0xdd, 0xea,
+	0xd2, 0x5a, 0xbd, 0x6b, 0x76, 0xba, 0xb5, 0x6e, 0xaf, 0x23, 0xa5, 0xbc, 0x85, 0xb6, 0x13, 0x7d,
+	0x34, 0x1f, 0xa1, 0x51, 0xb1, 0x23, 0x41, 0xba, 0x8f, 0x24, 0x31, 0x0b, 0xb3, 0xcb, 0xed, 0x9d,
+	0x07, 0x0c, 0xd6, 0xe7, 0xbe, 0x43, 0x27, 0xab, 0x90, 0xeb, 0xb5, 0xf6, 0x1a, 0x8f, 0x9a, 0xad,
+	0x46, 0xf9, 0x35, 0xfd, 0x0b, 0xe0, 0x04, 0x02, 0x72, 0x05, 0x95, 0x93, 0xa4, 0x08, 0xf9, 0x47,
+	0x3d, 0x2a, 0x3a, 0x2b, 0xa7, 0x10, 0x0c, 0x77, 0x49, 0x79, 0x05, 0xbf, 0x22, 0x7e, 0x54, 0x6b,
+	0xee, 0x37, 0xf6, 0xca, 0xe9, 0x07, 0xcf, 0x01, 0xa2, 0xaf, 0x44, 0x49, 0x0e, 0x56, 0x5a, 0x6d,
+	0x2e, 0x1b, 0x20, 0xb3, 0xdf, 0xd8, 0x7b, 0xdc, 0xc0, 0x2d, 0x8a, 0xbd, 0x76, 0x8f, 0xda, 0xcd,
+	0xd6, 0xa3, 0x76, 0x39, 0x89, 0x4b, 0x4f, 0x7c, 0x83, 0xcc, 0xe1, 0x14, 0x7e, 0x9e, 0x7c, 0xd8,
+	0x68, 0xd0, 0x8e, 0x10, 0xdc, 0x39, 0x6c, 0x34, 0xf6, 0x3a, 0xe5, 0xf4, 0x03, 0x07, 0xd3, 0xcb,
+	0xe1, 0xa7, 0xec, 0x38, 0x04, 0x9c, 0xfc, 0x36, 0x3d, 0xa8, 0x75, 0xcb, 0xaf, 0xa9, 0xd5, 0xd1,
+	0x7c, 0xdc, 0xaa, 0x75, 0x7b, 0xb4, 0x51, 0x4e, 0xc8, 0xed, 0x75, 0xd8, 0xa0, 0x07, 0xcd, 0x0e,
+	0x4e, 0x86, 0xf8, 0xc2, 0xb9, 0x7b, 0xc4, 0xb7, 0x7f, 0x39, 0x45, 0xd6, 0xa0, 0xd0, 0x3d, 0xe2,
+	0x0b, 0xdc, 0xec, 0x34, 0xba, 0xe5, 0x15, 0x29, 0x91, 0x9b, 0xf5, 0x93, 0x72, 0xfa, 0x41, 0x0d,
+	0x8a, 0xb1, 0x02, 0x3a, 0x5c, 0x97, 0x7b, 0x4d, 0xda, 0xa8, 0x73, 0x83, 0x0b, 0xa7, 0xd3, 0x12,
+	0x46, 0x6b, 0xb6, 0x76, 0xd1, 0xd4, 0x42, 0xb3, 0x76, 0xaf, 0x2b, 0xa0, 0xe4, 0x83, 0x3f, 0x80,
+	0x52, 0x3c, 0xb9, 0xcc, 0xed, 0xd1, 0xdb, 0xdf, 0x2f, 0xbf, 0x86, 0x3b, 0x9a, 0x2f, 0xcd, 0xee,
+	0x13, 0xda, 0xe8, 0x3c, 0x69, 0xef, 0x63, 0xeb, 0x12, 0x00, 0xc7, 0xd5, 0x9e, 0xe2, 0x88, 0xb8,
+	0xd5, 0x39, 0x4c, 0x6b, 0xdd, 0x46, 0x39, 0x85, 0xc2, 0x39, 0xd8, 0xe9, 0x1d, 0x88, 0xe1, 0xd6,
+	0x6b, 0x26, 0x6e, 0xa2, 0x06, 0xfa, 0x21, 0xee, 0xf6, 0x0e, 0x0e, 0x7a, 0xad, 0x66, 0xf7, 0x13,
+	0xf3, 0x59, 0xbb, 0xdb, 0x28, 0x67, 0x1e, 0xbc, 0x0f, 0xab, 0x7a, 0x86, 0x8d, 0x64, 0x21, 0x55,
+	0x3f, 0xec, 0x89, 0xc9, 0x38, 0x68, 0x1c, 0xb4, 0xe9, 0x27, 0xe5, 0x04, 0x0e, 0x69, 0xaf, 0xd9,
+	0x79, 0x5a, 0x4e, 0xe2, 0xaf, 0xa3, 0x47, 0x8d, 0x46, 0x39, 0xb5, 0xf3, 0xcb, 0xeb, 0x90, 0x39,
+	0xe2, 0x87, 0x15, 0xe9, 0x41, 0x39, 0xba, 0xa2, 0xef, 0x5e, 0xf2, 0x0f, 0x78, 0x8a, 0xea, 0x26,
+	0xc0, 0xdf, 0x17, 0xaa, 0x33, 0xf7, 0x65, 0xc3, 0xf8, 0xf9, 0x7f, 0xfe, 0xcf, 0x9f, 0x25, 0xb7,
+	0x3e, 0x4c, 0x3c, 0x30, 0x6e, 0x3c, 0xbc, 0x78, 0xef, 0xa1, 0xcf, 0xdb, 0x9b, 0xfc, 0x13, 0xa4,
+	0xe3, 0x4b, 0xfe, 0x5d, 0x10, 0xf9, 0x3e, 0x64, 0x0e, 0x5d, 0x3f, 0xe8, 0x4e, 0x49, 0xec, 0xa3,
+	0xfb, 0xea, 0x9a, 0x08, 0x12, 0xc2, 0x0f, 0x9c, 0x8d, 0x4d, 0x2e, 0xac, 0x8c, 0xc2, 0x0a, 0x28,
+	0x6c, 0xec, 0xfa, 0x81, 0x19, 0x4c, 0x49, 0x0b, 0x20, 0xfa, 0xa3, 0x04, 0x33, 0x42, 0xf8, 0xa9,
+	0x36, 0xff, 0x27, 0x0b, 0x8c, 0x2a, 0x97, 0xb5, 0x81, 0xb2, 0xd6, 0x50, 0x96, 0xac, 0x38, 0xc5,
+	0xeb, 0x35, 0xd9, 0x85, 0x1c, 0x3f, 0x02, 0x6b, 0xf5, 0x7d, 0xa1, 0x5f, 0x98, 0x62, 0xae, 0xc6,
+	0x41, 0xa3, 0xc2, 0xa5, 0x10, 0x94, 0x52, 0x44, 0x29, 0x3f, 0xc1, 0x66, 0xa6, 0xd5, 0x1f, 0x12,
+	0x13, 0xd6, 0xb8, 0x0c, 0xed, 0x02, 0xb6, 0x11, 0xbf, 0xd4, 0x89, 0x6b, 0x6d, 0x75, 0x21, 0xd6,
+	0xb8, 0xcb, 0x05, 0x57, 0x51, 0xf0, 0xf5, 0x48, 0x30, 0x37, 0x9b, 0x27, 0xa4, 0xfd, 0x14, 0xae,
+	0xf3, 0x0e, 0xe6, 0x6e, 0x11, 0xb7, 0x16, 0xde, 0x3a, 0xc4, 0xb1, 0x5f, 0xdd, 0x5a, 0x4c, 0x94,
+	0x46, 0x79, 0x9b, 0xf7, 0x7a, 0x0f, 0x7b, 0xdd, 0x8a, 0x7a, 0x8d, 0x05, 0xe9, 0x26, 0xde, 0x5e,
+	0xc8, 0xcf, 0xe0, 0xda, 0x82, 0xbc, 0x21, 0xb9, 0xcd, 0x3f, 0x42, 0x5a, 0x9a, 0xc5, 0xac, 0xde,
+	0x59, 0x4a, 0x97, 0x03, 0x78, 0x93, 0x0f, 0xe0, 0x36, 0x0e, 0xe0, 0x26, 0x0e, 0xe0, 0x94, 0x05,
+	0xe1, 0x77, 0x59, 0x61, 0xbc, 0x4d, 0x3e, 0x82, 0x2c, 0x57, 0x7d, 0x6e, 0xb2, 0x63, 0x90, 0x71,
+	0x83, 0x0b, 0x5b, 0x47, 0x61, 0xab, 0x91, 0x36, 0x62, 0xbd, 0x3c, 0x66, 0x81, 0xfc, 0xe4, 0x99,
+	0xac, 0x6b, 0x51, 0xbf, 0x94, 0x33, 0x8f, 0x9a, 0x5b, 0x2f, 0x38, 0x32, 0xf5, 0x99, 0xb7, 0x0d,
+	0xe5, 0x48, 0x9e, 0xfa, 0x28, 0x5c, 0x13, 0x11, 0xfb, 0xb8, 0xba, 0xba, 0x94, 0x62, 0xdc, 0xe3,
+	0x7d, 0xdc, 0xc2, 0x3e, 0x36, 0x67, 0xfa, 0x30, 0x07, 0x42, 0xec, 0x0f, 0x79, 0x57, 0xe2, 0x4b,
+	0xea, 0xab, 0x29, 0xb0, 0x48, 0xb8, 0xfc, 0x3a, 0x59, 0xe9, 0xf1, 0x5d, 0xc8, 0xa1, 0x1e, 0x3c,
+	0xe5, 0x54, 0x08, 0xff, 0xf8, 0x44, 0x73, 0xaf, 0x9a, 0x0f, 0x81, 0xb9, 0x15, 0xcf, 0xc7, 0xc8,
+	0x5b, 0x50, 0x61, 0x05, 0xfc, 0xbd, 0x7b, 0x29, 0xd3, 0x49, 0x6b, 0x61, 0x43, 0x81, 0xd0, 0x25,
+	0xcd, 0xba, 0x86, 0x50, 0x12, 0x3a, 0x06, 0x59, 0x6f, 0xfd, 0x1e, 0xac, 0x29, 0x99, 0x7e, 0x27,
+	0xf0, 0x98, 0x35, 0xd2, 0x44, 0x8a, 0x3f, 0xd3, 0xa0, 0x8b, 0x7c, 0xed, 0x1b, 0x09, 0xd2, 0xe2,
+	0x4b, 0x33, 0xfa, 0x66, 0x44, 0x9d, 0x6e, 0xfa, 0x87, 0x02, 0xd5, 0x18, 0x64, 0xdc, 0xe2, 0x23,
+	0xb9, 0x8e, 0x23, 0x29, 0x87, 0x23, 0xe9, 0xcb, 0xcc, 0x5d, 0x13, 0x4a, 0x31, 0x79, 0x52, 0x94,
+	0xfa, 0x2b, 0x0a, 0xd5, 0x68, 0x3c, 0x82, 0xac, 0x2c, 0x44, 0x34, 0x51, 0xb2, 0x38, 0xbb, 0xc7,
+	0xb5, 0x11, 0x9f, 0x00, 0xe8, 0xc3, 0x0a, 0x65, 0x6d, 0xce, 0x7f, 0x22, 0xc0, 0x1d, 0xdf, 0x16,
+	0x17, 0xb9, 0x89, 0x03, 0x5c, 0x57, 0x52, 0xfd, 0x4b, 0x5f, 0x8e, 0xf0, 0x14, 0xc8, 0x63, 0x16,
+	0xcc, 0x16, 0xf9, 0x57, 0xe4, 0x4e, 0x9f, 0xfb, 0x9c, 0xa0, 0x7a, 0x6d, 0x8e, 0x32, 0xf1, 0x17,
+	0xce, 0x46, 0x58, 0xd0, 0x1f, 0xfe, 0xc1, 0x91, 0xfc, 0x63, 0x16, 0xb4, 0x58, 0xd0, 0xa3, 0xfb,
+	0x33, 0x23, 0xe7, 0x37, 0x6c, 0x51, 0xa3, 0x6f, 0xbc, 0x46, 0x7a, 0xdc, 0x66, 0x5a, 0x8d, 0xf7,
+	0x0c, 0xf7, 0x46, 0xbc, 0x26, 0x5b, 0x6a, 0x79, 0x87, 0x0f, 0xe1, 0x26, 0x0e, 0x61, 0x43, 0x0d,
+	0x61, 0xcc, 0x98, 0x27, 0xd7, 0xbe, 0x4f, 0x9e, 0x02, 0x44, 0xe7, 0xcf, 0x17, 0x9d, 0x3c, 0xb7,
+	0xb9, 0xb4, 0x0a, 0x4a, 0xbb, 0x36, 0x73, 0xf2, 0xf8, 0xe6, 0xc5, 0x0e, 0xf9, 0x3c, 0x01, 0xd7,
+	0x17, 0xe6, 0x9e, 0x09, 0xff, 0x34, 0xed, 0x45, 0xe9, 0xfd, 0xea, 0xbd, 0x17, 0x70, 0x48, 0x4f,
+	0x36, 0x6b, 0xcf, 0xb1, 0xc7, 0xd8, 0x94, 0xf5, 0x4d, 0x6d, 0x18, 0xe4, 0x31, 0x94, 0xe2, 0xf5,
+	0xa5, 0xe4, 0xa6, 0x2a, 0x1c, 0x9a, 0x2b, 0x64, 0xad, 0x56, 0x17, 0x91, 0x44, 0x67, 0xe4, 0x19,
+	0x5c, 0x5b, 0x50, 0x87, 0x29, 0xdc, 0xf1, 0xf2, 0xda, 0xd2, 0xea, 0x9d, 0xa5, 0x74, 0x29, 0xb7,
+	0x03, 0x24, 0x24, 0x87, 0x95, 0x8e, 0xe4, 0xf5, 0x58, 0xb3, 0xd9, 0xa2, 0xcb, 0xea, 0xed, 0x65,
+	0x64, 0x29, 0xf4, 0x07, 0xb0, 0x36, 0x53, 0x38, 0x48, 0x42, 0xdd, 0xe6, 0xab, 0x1f, 0xab, 0xb7,
+	0x16, 0xd2, 0xa4, 0xac, 0x03, 0x28, 0x2b, 0x92, 0x2a, 0x7c, 0x23, 0xb1, 0x06, 0x33, 0x15, 0x82,
+	0xd5, 0xad, 0xc5, 0xc4, 0xb8, 0x38, 0xbd, 0x90, 0x2d, 0x12, 0xb7, 0xa0, 0x92, 0xae, 0xba, 0xb5,
+	0x98, 0x28, 0xc5, 0x7d, 0x27, 0x56, 0xed, 0x75, 0x7d, 0xa6, 0x28, 0x4c, 0x8a, 0xd8, 0x9c, 0x45,
+	0xcb, 0xc6, 0x16, 0x94, 0xa2, 0x93, 0x72, 0xf7, 0xb2, 0xf6, 0x54, 0x08, 0x98, 0x7b, 0xfa, 0xac,
+	0x6e, 0xce, 0xa2, 0xe5, 0x0a, 0x9c, 0x0d, 0x21, 0xf4, 0xb3, 0xf4, 0xf8, 0xd2, 0xb4, 0xce, 0xc9,
+	0x85, 0x38, 0xc5, 0x67, 0x12, 0x5e, 0x42, 0xe3, 0x25, 0xd9, 0xc3, 0xea, 0xd6, 0x62, 0xe2, 0x8b,
+	0xce, 0x6f, 0xc1, 0xac, 0x9d, 0xdf, 0x2d, 0xc8, 0xca, 0xcd, 0x43, 0x16, 0x3e, 0x2a, 0x55, 0xaf,
+	0xcf, 0x60, 0xa5, 0xf4, 0xb9, 0xf8, 0x4f, 0xec, 0xa9, 0xe3, 0x0c, 0xff, 0x13, 0x70, 0xdf, 0xfc,
+	0xff, 0x01, 0x00, 0xd0, 0x8c, 0x23, 0xe9, 0x46, 0x4e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6945,6 +7522,9 @@ type XchainClient interface {
 	SelectUTXOBySize(ctx context.Context, in *UtxoInput, opts ...grpc.CallOption) (*UtxoOutput, error)
 	// PostTx post Transaction to a node
 	PostTx(ctx context.Context, in *TxStatus, opts ...grpc.CallOption) (*CommonReply, error)
+	// ValidateTx run the node side verification of a signed Transaction
+	// without putting it into the tx pool or broadcasting it
+	ValidateTx(ctx context.Context, in *TxStatus, opts ...grpc.CallOption) (*ValidateTxResponse, error)
 	QueryACL(ctx context.Context, in *AclStatus, opts ...grpc.CallOption) (*AclStatus, error)
 	QueryUtxoRecord(ctx context.Context, in *UtxoRecordDetail, opts ...grpc.CallOption) (*UtxoRecordDetail, error)
 	QueryContractStatData(ctx context.Context, in *ContractStatDataRequest, opts ...grpc.CallOption) (*ContractStatDataResponse, error)
@@ -6968,6 +7548,11 @@ type XchainClient interface {
 	// GetBlockByHeight get block by height and return if the block in trunk or in
 	// branch
 	GetBlockByHeight(ctx context.Context, in *BlockHeight, opts ...grpc.CallOption) (*Block, error)
+	// GetBlocksStream stream trunk blocks of a height range in ascending order,
+	// for sync tools pulling a long range in one call with flow control.
+	// When the node is draining the stream ends with UNAVAILABLE, and the
+	// next_height metadata of the ErrorInfo detail is the height to resume from
+	GetBlocksStream(ctx context.Context, in *BlocksRange, opts ...grpc.CallOption) (Xchain_GetBlocksStreamClient, error)
 	GetBlockChainStatus(ctx context.Context, in *BCStatus, opts ...grpc.CallOption) (*BCStatus, error)
 	// Get blockchains query blockchains
 	GetBlockChains(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*BlockChains, error)
@@ -6977,6 +7562,8 @@ type XchainClient interface {
 	GetConsensusStatus(ctx context.Context, in *ConsensusStatRequest, opts ...grpc.CallOption) (*ConsensusStatus, error)
 	// GetNetURL return net url
 	GetNetURL(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*RawUrl, error)
+	// GetPeerDetails return connected peers with their chain heights and latency of the last background probe
+	GetPeerDetails(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*PeerDetailsReply, error)
 	// 新的Select utxos接口, 不需要签名，可以支持选择账户的utxo
 	SelectUTXO(ctx context.Context, in *UtxoInput, opts ...grpc.CallOption) (*UtxoOutput, error)
 	// PreExecWithSelectUTXO preExec & selectUtxo
@@ -7029,6 +7616,15 @@ func (c *xchainClient) PostTx(ctx context.Context, in *TxStatus, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *xchainClient) ValidateTx(ctx context.Context, in *TxStatus, opts ...grpc.CallOption) (*ValidateTxResponse, error) {
+	out := new(ValidateTxResponse)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/ValidateTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) QueryACL(ctx context.Context, in *AclStatus, opts ...grpc.CallOption) (*AclStatus, error) {
 	out := new(AclStatus)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/QueryACL", in, out, opts...)
@@ -7119,6 +7715,38 @@ func (c *xchainClient) GetBlockByHeight(ctx context.Context, in *BlockHeight, op
 	return out, nil
 }
 
+func (c *xchainClient) GetBlocksStream(ctx context.Context, in *BlocksRange, opts ...grpc.CallOption) (Xchain_GetBlocksStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Xchain_serviceDesc.Streams[0], "/pb.Xchain/GetBlocksStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &xchainGetBlocksStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Xchain_GetBlocksStreamClient interface {
+	Recv() (*Block, error)
+	grpc.ClientStream
+}
+
+type xchainGetBlocksStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *xchainGetBlocksStreamClient) Recv() (*Block, error) {
+	m := new(Block)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *xchainClient) GetBlockChainStatus(ctx context.Context, in *BCStatus, opts ...grpc.CallOption) (*BCStatus, error) {
 	out := new(BCStatus)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/GetBlockChainStatus", in, out, opts...)
@@ -7164,6 +7792,15 @@ func (c *xchainClient) GetNetURL(ctx context.Context, in *CommonIn, opts ...grpc
 	return out, nil
 }
 
+func (c *xchainClient) GetPeerDetails(ctx context.Context, in *CommonIn, opts ...grpc.CallOption) (*PeerDetailsReply, error) {
+	out := new(PeerDetailsReply)
+	err := c.cc.Invoke(ctx, "/pb.Xchain/GetPeerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *xchainClient) SelectUTXO(ctx context.Context, in *UtxoInput, opts ...grpc.CallOption) (*UtxoOutput, error) {
 	out := new(UtxoOutput)
 	err := c.cc.Invoke(ctx, "/pb.Xchain/SelectUTXO", in, out, opts...)
@@ -7278,6 +7915,9 @@ type XchainServer interface {
 	SelectUTXOBySize(context.Context, *UtxoInput) (*UtxoOutput, error)
 	// PostTx post Transaction to a node
 	PostTx(context.Context, *TxStatus) (*CommonReply, error)
+	// ValidateTx run the node side verification of a signed Transaction
+	// without putting it into the tx pool or broadcasting it
+	ValidateTx(context.Context, *TxStatus) (*ValidateTxResponse, error)
 	QueryACL(context.Context, *AclStatus) (*AclStatus, error)
 	QueryUtxoRecord(context.Context, *UtxoRecordDetail) (*UtxoRecordDetail, error)
 	QueryContractStatData(context.Context, *ContractStatDataRequest) (*ContractStatDataResponse, error)
@@ -7301,6 +7941,11 @@ type XchainServer interface {
 	// GetBlockByHeight get block by height and return if the block in trunk or in
 	// branch
 	GetBlockByHeight(context.Context, *BlockHeight) (*Block, error)
+	// GetBlocksStream stream trunk blocks of a height range in ascending order,
+	// for sync tools pulling a long range in one call with flow control.
+	// When the node is draining the stream ends with UNAVAILABLE, and the
+	// next_height metadata of the ErrorInfo detail is the height to resume from
+	GetBlocksStream(*BlocksRange, Xchain_GetBlocksStreamServer) error
 	GetBlockChainStatus(context.Context, *BCStatus) (*BCStatus, error)
 	// Get blockchains query blockchains
 	GetBlockChains(context.Context, *CommonIn) (*BlockChains, error)
@@ -7310,6 +7955,8 @@ type XchainServer interface {
 	GetConsensusStatus(context.Context, *ConsensusStatRequest) (*ConsensusStatus, error)
 	// GetNetURL return net url
 	GetNetURL(context.Context, *CommonIn) (*RawUrl, error)
+	// GetPeerDetails return connected peers with their chain heights and latency of the last background probe
+	GetPeerDetails(context.Context, *CommonIn) (*PeerDetailsReply, error)
 	// 新的Select utxos接口, 不需要签名，可以支持选择账户的utxo
 	SelectUTXO(context.Context, *UtxoInput) (*UtxoOutput, error)
 	// PreExecWithSelectUTXO preExec & selectUtxo
@@ -7346,6 +7993,9 @@ func (*UnimplementedXchainServer) SelectUTXOBySize(ctx context.Context, req *Utx
 func (*UnimplementedXchainServer) PostTx(ctx context.Context, req *TxStatus) (*CommonReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PostTx not implemented")
 }
+func (*UnimplementedXchainServer) ValidateTx(ctx context.Context, req *TxStatus) (*ValidateTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTx not implemented")
+}
 func (*UnimplementedXchainServer) QueryACL(ctx context.Context, req *AclStatus) (*AclStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryACL not implemented")
 }
@@ -7376,6 +8026,9 @@ func (*UnimplementedXchainServer) GetBlock(ctx context.Context, req *BlockID) (*
 func (*UnimplementedXchainServer) GetBlockByHeight(ctx context.Context, req *BlockHeight) (*Block, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHeight not implemented")
 }
+func (*UnimplementedXchainServer) GetBlocksStream(req *BlocksRange, srv Xchain_GetBlocksStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetBlocksStream not implemented")
+}
 func (*UnimplementedXchainServer) GetBlockChainStatus(ctx context.Context, req *BCStatus) (*BCStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockChainStatus not implemented")
 }
@@ -7391,6 +8044,9 @@ func (*UnimplementedXchainServer) GetConsensusStatus(ctx context.Context, req *C
 func (*UnimplementedXchainServer) GetNetURL(ctx context.Context, req *CommonIn) (*RawUrl, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNetURL not implemented")
 }
+func (*UnimplementedXchainServer) GetPeerDetails(ctx context.Context, req *CommonIn) (*PeerDetailsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeerDetails not implemented")
+}
 func (*UnimplementedXchainServer) SelectUTXO(ctx context.Context, req *UtxoInput) (*UtxoOutput, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectUTXO not implemented")
 }
@@ -7468,6 +8124,24 @@ func _Xchain_PostTx_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_ValidateTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).ValidateTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/ValidateTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).ValidateTx(ctx, req.(*TxStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_QueryACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AclStatus)
 	if err := dec(in); err != nil {
@@ -7648,6 +8322,27 @@ func _Xchain_GetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetBlocksStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlocksRange)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(XchainServer).GetBlocksStream(m, &xchainGetBlocksStreamServer{stream})
+}
+
+type Xchain_GetBlocksStreamServer interface {
+	Send(*Block) error
+	grpc.ServerStream
+}
+
+type xchainGetBlocksStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *xchainGetBlocksStreamServer) Send(m *Block) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Xchain_GetBlockChainStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BCStatus)
 	if err := dec(in); err != nil {
@@ -7738,6 +8433,24 @@ func _Xchain_GetNetURL_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Xchain_GetPeerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommonIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(XchainServer).GetPeerDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.Xchain/GetPeerDetails",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(XchainServer).GetPeerDetails(ctx, req.(*CommonIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Xchain_SelectUTXO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UtxoInput)
 	if err := dec(in); err != nil {
@@ -7966,6 +8679,10 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PostTx",
 			Handler:    _Xchain_PostTx_Handler,
 		},
+		{
+			MethodName: "ValidateTx",
+			Handler:    _Xchain_ValidateTx_Handler,
+		},
 		{
 			MethodName: "QueryACL",
 			Handler:    _Xchain_QueryACL_Handler,
@@ -8026,6 +8743,10 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNetURL",
 			Handler:    _Xchain_GetNetURL_Handler,
 		},
+		{
+			MethodName: "GetPeerDetails",
+			Handler:    _Xchain_GetPeerDetails_Handler,
+		},
 		{
 			MethodName: "SelectUTXO",
 			Handler:    _Xchain_SelectUTXO_Handler,
@@ -8075,6 +8796,12 @@ var _Xchain_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Xchain_PreExec_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetBlocksStream",
+			Handler:       _Xchain_GetBlocksStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "xchain.proto",
 }
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -230,24 +231,27 @@ func init() {
 func init() { proto.RegisterFile("xuperos.proto", fileDescriptor_76de507326ad4f72) }
 
 var fileDescriptor_76de507326ad4f72 = []byte{
-	// 263 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x4f, 0x4b, 0x03, 0x31,
-	0x10, 0xc5, 0xad, 0xd5, 0xfd, 0x33, 0x22, 0x68, 0x44, 0x5c, 0xf5, 0x22, 0x39, 0x89, 0xc2, 0x1e,
-	0x5a, 0xc4, 0x9b, 0xa2, 0xbd, 0xd8, 0x83, 0x0a, 0xf1, 0xe2, 0x6d, 0x49, 0x37, 0xe3, 0xb6, 0xb8,
-	0x6b, 0xd2, 0x99, 0x56, 0xfa, 0xf1, 0x25, 0xe9, 0xb6, 0x48, 0x0f, 0xbd, 0x25, 0x33, 0xf9, 0xbd,
-	0x97, 0xf7, 0xe0, 0x70, 0x31, 0x77, 0x48, 0x96, 0x73, 0x47, 0x76, 0x66, 0x45, 0xbc, 0x98, 0x3b,
-	0xcb, 0x6e, 0x24, 0x1f, 0x21, 0x55, 0x38, 0x7d, 0x41, 0x6d, 0x90, 0xc4, 0x29, 0x44, 0xb5, 0xad,
-	0x8a, 0x89, 0xc9, 0x3a, 0x57, 0x9d, 0xeb, 0x54, 0xed, 0xd7, 0xb6, 0x1a, 0x1a, 0x71, 0x09, 0x29,
-	0x63, 0xfd, 0x55, 0xfc, 0xe8, 0x06, 0xb3, 0xdd, 0xb0, 0x49, 0xfc, 0xe0, 0x4d, 0x37, 0x28, 0x09,
-	0x40, 0x21, 0xbb, 0xed, 0x0a, 0xe7, 0x90, 0x20, 0x51, 0x51, 0x5a, 0xb3, 0x14, 0xe8, 0xaa, 0x18,
-	0x89, 0x06, 0xd6, 0xa0, 0x38, 0x03, 0x7f, 0x2c, 0x1a, 0xae, 0xb2, 0x6e, 0x40, 0x22, 0x24, 0x7a,
-	0xe5, 0xca, 0x33, 0x33, 0xd2, 0x25, 0x7a, 0xb1, 0xbd, 0xb0, 0x89, 0xc3, 0x7d, 0x68, 0xe4, 0x1d,
-	0xc4, 0xcf, 0x9a, 0x51, 0xe1, 0x54, 0xdc, 0x40, 0x34, 0x0e, 0xd6, 0xc1, 0xf0, 0xa0, 0x27, 0xf2,
-	0x36, 0x59, 0xbe, 0x8e, 0xa5, 0xda, 0x17, 0xf2, 0x1e, 0x92, 0x25, 0xc6, 0x4e, 0xdc, 0x6e, 0x70,
-	0x27, 0xff, 0xb8, 0x55, 0x9a, 0x15, 0xd8, 0x7b, 0x80, 0xf8, 0xd3, 0xd7, 0xf7, 0xfe, 0x21, 0xfa,
-	0x00, 0x83, 0x31, 0x96, 0xdf, 0x4f, 0xf5, 0xe4, 0x17, 0xc5, 0xd1, 0x9a, 0x6a, 0xff, 0x73, 0x71,
-	0xbc, 0x31, 0x61, 0x27, 0x77, 0x46, 0x51, 0x28, 0xbd, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0x7f,
-	0x11, 0x28, 0x06, 0x85, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0x80, 0x69, 0xfb, 0xff, 0x49, 0x3b, 0x22, 0xe8, 0x8a, 0xb4, 0xd6, 0x1e, 0x64, 0x4f, 0x52,
+	0xa1, 0xc1, 0x8a, 0x08, 0x5e, 0x44, 0x7b, 0xb1, 0x07, 0x15, 0x22, 0x82, 0xb7, 0xb8, 0x4d, 0xc6,
+	0x6d, 0x30, 0xc9, 0x6c, 0x77, 0xd3, 0xd2, 0xb3, 0xaf, 0xe0, 0xa3, 0xf9, 0x0a, 0x3e, 0x88, 0xec,
+	0x36, 0x14, 0xed, 0xc1, 0xdb, 0xee, 0xcc, 0x7c, 0xdf, 0xec, 0xec, 0xc0, 0xf6, 0x72, 0xae, 0x50,
+	0x93, 0x19, 0x28, 0x4d, 0x25, 0x31, 0x7f, 0x39, 0x57, 0x64, 0xd4, 0xa4, 0xdb, 0x93, 0x44, 0x32,
+	0xc3, 0x40, 0xa8, 0x34, 0x10, 0x45, 0x41, 0xa5, 0x28, 0x53, 0x2a, 0xaa, 0x32, 0x7e, 0x05, 0xad,
+	0x10, 0x67, 0xb7, 0x28, 0x12, 0xd4, 0x6c, 0x1f, 0xbc, 0x8c, 0x64, 0x94, 0x26, 0x9d, 0xda, 0x51,
+	0xed, 0xb8, 0x15, 0xfe, 0xcf, 0x48, 0x8e, 0x13, 0x76, 0x08, 0x2d, 0x83, 0xd9, 0x6b, 0x54, 0x88,
+	0x1c, 0x3b, 0x75, 0x97, 0x69, 0xda, 0xc0, 0xbd, 0xc8, 0x91, 0x6b, 0x80, 0x10, 0x8d, 0xfa, 0xdb,
+	0x70, 0x00, 0x4d, 0xd4, 0x3a, 0x8a, 0x29, 0x59, 0x09, 0x1a, 0xa1, 0x8f, 0x5a, 0x8f, 0x28, 0x41,
+	0xd6, 0x06, 0x7b, 0x8c, 0x72, 0x23, 0x3b, 0x0d, 0x87, 0x78, 0xa8, 0xf5, 0x9d, 0x91, 0x96, 0x29,
+	0xb5, 0x88, 0xd1, 0xca, 0xfe, 0xb9, 0x8c, 0xef, 0xee, 0xe3, 0x84, 0x9f, 0x83, 0x7f, 0x23, 0x0c,
+	0x86, 0x38, 0x63, 0x7d, 0xf0, 0xa6, 0xae, 0xb5, 0x6b, 0xb8, 0x35, 0x64, 0x83, 0x6a, 0xee, 0xc1,
+	0x7a, 0xac, 0xb0, 0xaa, 0xe0, 0x17, 0xd0, 0x5c, 0x61, 0x46, 0xb1, 0x93, 0x0d, 0x6e, 0xef, 0x07,
+	0x67, 0xd4, 0x6f, 0x70, 0xf8, 0x02, 0xfe, 0xb3, 0xfd, 0xdc, 0x87, 0x47, 0xf6, 0x04, 0x30, 0x9a,
+	0x62, 0xfc, 0x76, 0x9d, 0xa5, 0x0b, 0x64, 0x3b, 0x6b, 0xaa, 0x7a, 0x4f, 0x77, 0x77, 0x23, 0x62,
+	0x14, 0xe7, 0xef, 0x9f, 0x5f, 0x1f, 0xf5, 0xde, 0x65, 0xad, 0xcf, 0xdb, 0xc1, 0xe2, 0x34, 0xa8,
+	0xf6, 0x14, 0xc4, 0xd6, 0x13, 0x09, 0x2b, 0x9a, 0x78, 0x6e, 0x1b, 0x67, 0xdf, 0x03, 0x00, 0xc8,
+	0xaa, 0xa5, 0xdf, 0xc5, 0x01, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,7 +266,7 @@ const _ = grpc.SupportPackageIsVersion6
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type XuperOSClient interface {
-	// 示例接口
+	// 检查节点服务是否存活
 	CheckAlive(ctx context.Context, in *BaseReq, opts ...grpc.CallOption) (*BaseResp, error)
 }
 
@@ -285,7 +289,7 @@ func (c *xuperOSClient) CheckAlive(ctx context.Context, in *BaseReq, opts ...grp
 
 // XuperOSServer is the server API for XuperOS service.
 type XuperOSServer interface {
-	// 示例接口
+	// 检查节点服务是否存活
 	CheckAlive(context.Context, *BaseReq) (*BaseResp, error)
 }
 
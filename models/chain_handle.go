@@ -2,7 +2,6 @@ package models
 
 import (
 	"math/big"
-	"strconv"
 
 	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	xctx "github.com/xuperchain/xupercore/kernel/common/xcontext"
@@ -47,22 +46,59 @@ func (t *ChainHandle) SubmitTx(tx *lpb.Transaction) error {
 	return t.chain.SubmitTx(t.genXctx(), tx)
 }
 
+// 预校验交易，不提交到交易池也不广播，返回所有校验失败项
+func (t *ChainHandle) ValidateTx(tx *lpb.Transaction) []*TxCheckFailure {
+	return newTxValidator(t.chain.Context(), tx).validate()
+}
+
+// 背书前预校验交易的格式、发起人签名和utxo，pending为同时提交、尚未上链的交易
+func (t *ChainHandle) PrecheckTx(tx *lpb.Transaction, pending ...*lpb.Transaction) []*TxCheckFailure {
+	return newTxValidator(t.chain.Context(), tx).precheck(pending)
+}
+
 func (t *ChainHandle) PreExec(req []*protos.InvokeRequest,
 	initiator string, authRequires []string) (*protos.InvokeResponse, error) {
 	return t.chain.PreExec(t.genXctx(), req, initiator, authRequires)
 }
 
-func (t *ChainHandle) QueryTx(txId []byte) (*xpb.TxInfo, error) {
-	return reader.NewLedgerReader(t.chain.Context(), t.genXctx()).QueryTx(txId)
+// cache为空时直接查询账本
+func (t *ChainHandle) QueryTx(txId []byte, cache *LedgerCache) (*xpb.TxInfo, error) {
+	chainCtx := t.chain.Context()
+	cc := cache.chain(t.bcName, chainCtx)
+	if cc == nil {
+		return reader.NewLedgerReader(chainCtx, t.genXctx()).QueryTx(txId)
+	}
+
+	meta := chainCtx.Ledger.GetMeta()
+	if hit := cc.getTx(txId); hit != nil {
+		return &xpb.TxInfo{
+			Status:   lpb.TransactionStatus_TX_CONFIRM,
+			Distance: meta.GetTrunkHeight() - hit.height,
+			Tx:       hit.tx,
+		}, nil
+	}
+
+	tipId := cc.snapshot()
+	txInfo, err := reader.NewLedgerReader(chainCtx, t.genXctx()).QueryTx(txId)
+	if err != nil {
+		return nil, err
+	}
+	// 查询期间主干高度变化时无法确定交易所在高度，不缓存
+	if txInfo.GetStatus() == lpb.TransactionStatus_TX_CONFIRM &&
+		meta == chainCtx.Ledger.GetMeta() {
+		cc.addTx(tipId, txInfo.GetTx(), meta.GetTrunkHeight()-txInfo.GetDistance())
+	}
+	return txInfo, nil
 }
 
 func (t *ChainHandle) SelectUtxo(account string, need *big.Int, isLock, isExclude bool,
-	pubKey string, sign []byte) (*lpb.UtxoOutput, error) {
+	sign *UtxoLockSign, guard *UtxoLockGuard) (*lpb.UtxoOutput, error) {
 	// 如果需要临时锁定utxo，需要校验权限
-	ok := t.checkSelectUtxoSign(account, pubKey, sign, isLock, need)
-	if !ok {
-		t.reqCtx.GetLog().Warn("select utxo verify sign failed", "account", account, "isLock", isLock)
-		return nil, ecom.ErrUnauthorized
+	err := t.checkSelectUtxoSign(account, sign, isLock, need, guard)
+	if err != nil {
+		t.reqCtx.GetLog().Warn("select utxo verify sign failed", "account", account, "isLock", isLock,
+			"err", err)
+		return nil, err
 	}
 
 	return reader.NewUtxoReader(t.chain.Context(), t.genXctx()).SelectUTXO(account, need,
@@ -70,12 +106,13 @@ func (t *ChainHandle) SelectUtxo(account string, need *big.Int, isLock, isExclud
 }
 
 func (t *ChainHandle) SelectUTXOBySize(account string, isLock, isExclude bool,
-	pubKey string, sign []byte) (*lpb.UtxoOutput, error) {
+	sign *UtxoLockSign, guard *UtxoLockGuard) (*lpb.UtxoOutput, error) {
 	// 如果需要临时锁定utxo，需要校验权限
-	ok := t.checkSelectUtxoSign(account, pubKey, sign, isLock, big.NewInt(0))
-	if !ok {
-		t.reqCtx.GetLog().Warn("select utxo verify sign failed", "account", account, "isLock", isLock)
-		return nil, ecom.ErrUnauthorized
+	err := t.checkSelectUtxoSign(account, sign, isLock, big.NewInt(0), guard)
+	if err != nil {
+		t.reqCtx.GetLog().Warn("select utxo verify sign failed", "account", account, "isLock", isLock,
+			"err", err)
+		return nil, err
 	}
 
 	return reader.NewUtxoReader(t.chain.Context(), t.genXctx()).SelectUTXOBySize(account,
@@ -104,6 +141,15 @@ func (t *ChainHandle) GetAccountContracts(account string) ([]*protos.ContractSta
 		t.genXctx()).GetAccountContracts(account)
 }
 
+// 查询合约abi，evm合约部署时保存，其他合约返回空
+func (t *ChainHandle) QueryContractAbi(contractName string) ([]byte, error) {
+	data, err := t.chain.Context().State.CreateXMReader().Get("contract", []byte(contractName+".abi"))
+	if err != nil {
+		return nil, err
+	}
+	return data.GetPureData().GetValue(), nil
+}
+
 func (t *ChainHandle) GetBalance(account string) (string, error) {
 	return reader.NewUtxoReader(t.chain.Context(), t.genXctx()).GetBalance(account)
 }
@@ -116,8 +162,27 @@ func (t *ChainHandle) GetBalanceDetail(account string) ([]*lpb.BalanceDetailInfo
 	return reader.NewUtxoReader(t.chain.Context(), t.genXctx()).GetBalanceDetail(account)
 }
 
-func (t *ChainHandle) QueryBlock(blkId []byte, needContent bool) (*xpb.BlockInfo, error) {
-	return reader.NewLedgerReader(t.chain.Context(), t.genXctx()).QueryBlock(blkId, needContent)
+func (t *ChainHandle) QueryBlock(blkId []byte, needContent bool, cache *LedgerCache) (*xpb.BlockInfo, error) {
+	chainCtx := t.chain.Context()
+	cc := cache.chain(t.bcName, chainCtx)
+	if cc == nil {
+		return reader.NewLedgerReader(chainCtx, t.genXctx()).QueryBlock(blkId, needContent)
+	}
+
+	if hit := cc.getBlock(blkId); hit != nil {
+		return cachedBlockInfo(hit, needContent), nil
+	}
+
+	tipId := cc.snapshot()
+	blockInfo, err := reader.NewLedgerReader(chainCtx, t.genXctx()).QueryBlock(blkId, true)
+	if err != nil {
+		return blockInfo, err
+	}
+	cc.addBlock(tipId, blockInfo.GetBlock())
+	if !needContent {
+		blockInfo.Block = nil
+	}
+	return blockInfo, nil
 }
 
 func (t *ChainHandle) QueryChainStatus() (*xpb.ChainStatus, error) {
@@ -132,8 +197,30 @@ func (t *ChainHandle) IsTrunkTipBlock(blockId []byte) (bool, error) {
 	return reader.NewChainReader(t.chain.Context(), t.genXctx()).IsTrunkTipBlock(blockId)
 }
 
-func (t *ChainHandle) QueryBlockByHeight(height int64, needContent bool) (*xpb.BlockInfo, error) {
-	return reader.NewLedgerReader(t.chain.Context(), t.genXctx()).QueryBlockByHeight(height, needContent)
+func (t *ChainHandle) QueryBlockByHeight(height int64, needContent bool,
+	cache *LedgerCache) (*xpb.BlockInfo, error) {
+	chainCtx := t.chain.Context()
+	cc := cache.chain(t.bcName, chainCtx)
+	if cc == nil {
+		return reader.NewLedgerReader(chainCtx, t.genXctx()).QueryBlockByHeight(height, needContent)
+	}
+
+	if hit := cc.getBlockByHeight(height); hit != nil {
+		return cachedBlockInfo(hit, needContent), nil
+	}
+
+	tipId := cc.snapshot()
+	blockInfo, err := reader.NewLedgerReader(chainCtx, t.genXctx()).QueryBlockByHeight(height, true)
+	if err != nil {
+		return blockInfo, err
+	}
+	if blockInfo.GetBlock() != nil {
+		cc.addBlock(tipId, blockInfo.GetBlock())
+	}
+	if !needContent {
+		blockInfo.Block = nil
+	}
+	return blockInfo, nil
 }
 
 func (t *ChainHandle) GetAccountByAK(address string) ([]string, error) {
@@ -147,32 +234,45 @@ func (t *ChainHandle) genXctx() xctx.XContext {
 	}
 }
 
-func (t *ChainHandle) checkSelectUtxoSign(account, pubKey string, sign []byte,
-	isLock bool, need *big.Int) bool {
+// guard为空时不校验签名时效和重放
+func (t *ChainHandle) checkSelectUtxoSign(account string, sign *UtxoLockSign,
+	isLock bool, need *big.Int, guard *UtxoLockGuard) error {
 	// 只对需要临时锁定utxo的校验
 	if aclUtils.IsAccount(account) == 1 || !isLock {
-		return true
+		return nil
+	}
+	if sign == nil {
+		return ecom.ErrUnauthorized
+	}
+	if guard != nil {
+		if err := guard.checkFresh(sign); err != nil {
+			return err
+		}
 	}
 
 	crypto := t.chain.Context().Crypto
-	publicKey, err := crypto.GetEcdsaPublicKeyFromJsonStr(pubKey)
+	publicKey, err := crypto.GetEcdsaPublicKeyFromJsonStr(sign.PublicKey)
 	if err != nil {
-		return false
+		return ecom.ErrUnauthorized
 	}
 
-	hashStr := t.bcName + account + need.String() + strconv.FormatBool(isLock)
-	doubleHash := cryptoHash.DoubleSha256([]byte(hashStr))
-	checkSignResult, err := crypto.VerifyECDSA(publicKey, sign, doubleHash)
+	content := sign.content(t.bcName, account, need, isLock)
+	doubleHash := cryptoHash.DoubleSha256([]byte(content))
+	checkSignResult, err := crypto.VerifyECDSA(publicKey, sign.Sign, doubleHash)
 	if err != nil {
-		return false
+		return ecom.ErrUnauthorized
 	}
 	if checkSignResult != true {
-		return false
+		return ecom.ErrUnauthorized
 	}
 	addrMatchCheckResult, _ := crypto.VerifyAddressUsingPublicKey(account, publicKey)
 	if addrMatchCheckResult != true {
-		return false
+		return ecom.ErrUnauthorized
 	}
 
-	return true
+	// 签名校验通过后才记录，避免伪造的签名占用
+	if guard != nil && !guard.use(sign, content) {
+		return ecom.ErrUnauthorized.More("utxo lock sign replayed")
+	}
+	return nil
 }
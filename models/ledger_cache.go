@@ -0,0 +1,278 @@
+package models
+
+import (
+	"bytes"
+	"sync"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+	"github.com/xuperchain/xupercore/lib/cache"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	"github.com/xuperchain/xuperos/common/metrics"
+)
+
+const (
+	// 主干末端变化时最多回溯的区块数，超过时按主干切换处理
+	maxTipWalk = 128
+
+	cacheTypeBlock  = "block"
+	cacheTypeHeight = "height"
+	cacheTypeTx     = "tx"
+)
+
+// LedgerCache 主干上已确认区块和交易的LRU缓存，各服务共享，按链分别维护
+// 只缓存有后继区块的主干区块(NextHash不再变化)和其中的交易，
+// 主干切换或回滚时清除不可逆高度及以上的缓存
+type LedgerCache struct {
+	blockSize int
+	txSize    int
+
+	mutex  sync.Mutex
+	chains map[string]*chainCache
+	// 同步缓存时读取的账本
+	newView func(chainCtx *ecom.ChainCtx) ledgerView
+}
+
+// ledgerView 同步缓存用到的账本查询
+type ledgerView interface {
+	GetMeta() *lpb.LedgerMeta
+	QueryBlockHeader(blockId []byte) (*lpb.InternalBlock, error)
+	IrreversibleHeight() int64
+}
+
+type chainLedger struct {
+	chainCtx *ecom.ChainCtx
+}
+
+func newChainLedger(chainCtx *ecom.ChainCtx) ledgerView {
+	return &chainLedger{chainCtx: chainCtx}
+}
+
+func (t *chainLedger) GetMeta() *lpb.LedgerMeta {
+	return t.chainCtx.Ledger.GetMeta()
+}
+
+func (t *chainLedger) QueryBlockHeader(blockId []byte) (*lpb.InternalBlock, error) {
+	return t.chainCtx.Ledger.QueryBlockHeader(blockId)
+}
+
+func (t *chainLedger) IrreversibleHeight() int64 {
+	return t.chainCtx.State.GetMeta().GetIrreversibleBlockHeight()
+}
+
+// blockSize、txSize为每条链缓存的区块数和交易数，都为0时不缓存
+func NewLedgerCache(blockSize, txSize int) *LedgerCache {
+	return &LedgerCache{
+		blockSize: blockSize,
+		txSize:    txSize,
+		chains:    make(map[string]*chainCache),
+		newView:   newChainLedger,
+	}
+}
+
+// 获取链的缓存并与主干同步，链被重新加载时重建缓存
+func (t *LedgerCache) chain(bcName string, chainCtx *ecom.ChainCtx) *chainCache {
+	if t == nil || (t.blockSize <= 0 && t.txSize <= 0) {
+		return nil
+	}
+
+	t.mutex.Lock()
+	cc, ok := t.chains[bcName]
+	if !ok || cc.chainCtx != chainCtx {
+		cc = newChainCache(bcName, chainCtx, t.newView(chainCtx), chainCtx.GetLog(), t.blockSize, t.txSize)
+		t.chains[bcName] = cc
+	}
+	t.mutex.Unlock()
+
+	cc.sync()
+	return cc
+}
+
+type cachedTx struct {
+	tx     *lpb.Transaction
+	height int64
+}
+
+type chainCache struct {
+	bcName string
+	// 链被重新加载后chainCtx变化
+	chainCtx  *ecom.ChainCtx
+	ledger    ledgerView
+	log       logs.Logger
+	blockSize int
+	txSize    int
+
+	mutex sync.Mutex
+	// 上次同步时的主干末端
+	tipId     []byte
+	tipHeight int64
+	// blockid => *lpb.InternalBlock
+	blocks *cache.LRUCache
+	// height => blockid
+	heights *cache.LRUCache
+	// txid => *cachedTx
+	txs *cache.LRUCache
+}
+
+func newChainCache(bcName string, chainCtx *ecom.ChainCtx, ledger ledgerView, log logs.Logger,
+	blockSize, txSize int) *chainCache {
+	return &chainCache{
+		bcName:    bcName,
+		chainCtx:  chainCtx,
+		ledger:    ledger,
+		log:       log,
+		blockSize: blockSize,
+		txSize:    txSize,
+		blocks:    cache.NewLRUCache(blockSize),
+		heights:   cache.NewLRUCache(blockSize),
+		txs:       cache.NewLRUCache(txSize),
+	}
+}
+
+// 主干末端变化且不是由原末端延伸时，清除可能被切换的缓存
+func (t *chainCache) sync() {
+	meta := t.ledger.GetMeta()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if bytes.Equal(meta.GetTipBlockid(), t.tipId) {
+		return
+	}
+	if t.tipId != nil && !t.extended(meta) {
+		irreversible := t.ledger.IrreversibleHeight()
+		t.invalidate(irreversible)
+		t.log.Info("ledger cache invalidated by trunk switch", "bcname", t.bcName,
+			"old_height", t.tipHeight, "new_height", meta.GetTrunkHeight(), "from_height", irreversible)
+	}
+	t.tipId = meta.GetTipBlockid()
+	t.tipHeight = meta.GetTrunkHeight()
+}
+
+// 从新的主干末端回溯，判断是否经过原末端
+func (t *chainCache) extended(meta *lpb.LedgerMeta) bool {
+	height := meta.GetTrunkHeight()
+	if height <= t.tipHeight || height-t.tipHeight > maxTipWalk {
+		return false
+	}
+
+	blockId := meta.GetTipBlockid()
+	for ; height > t.tipHeight; height-- {
+		block, err := t.ledger.QueryBlockHeader(blockId)
+		if err != nil {
+			return false
+		}
+		blockId = block.GetPreHash()
+	}
+	return bytes.Equal(blockId, t.tipId)
+}
+
+// 清除高度不低于height的缓存，调用方持有锁
+func (t *chainCache) invalidate(height int64) {
+	for _, key := range t.blocks.Keys() {
+		if value, ok := t.blocks.Get(key); ok && value.(*lpb.InternalBlock).GetHeight() >= height {
+			t.blocks.Del(key)
+		}
+	}
+	for _, key := range t.heights.Keys() {
+		if key.(int64) >= height {
+			t.heights.Del(key)
+		}
+	}
+	for _, key := range t.txs.Keys() {
+		if value, ok := t.txs.Get(key); ok && value.(*cachedTx).height >= height {
+			t.txs.Del(key)
+		}
+	}
+}
+
+// 读取账本期间主干末端没有被同步过时才写入缓存，避免写入切换前读到的数据
+func (t *chainCache) snapshot() []byte {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.tipId
+}
+
+func (t *chainCache) getBlock(blockId []byte) *lpb.InternalBlock {
+	if t.blockSize <= 0 {
+		return nil
+	}
+	value, ok := t.blocks.Get(string(blockId))
+	t.count(cacheTypeBlock, ok)
+	if !ok {
+		return nil
+	}
+	return value.(*lpb.InternalBlock)
+}
+
+func (t *chainCache) getBlockByHeight(height int64) *lpb.InternalBlock {
+	if t.blockSize <= 0 {
+		return nil
+	}
+	value, ok := t.heights.Get(height)
+	if ok {
+		value, ok = t.blocks.Get(value.(string))
+	}
+	t.count(cacheTypeHeight, ok)
+	if !ok {
+		return nil
+	}
+	return value.(*lpb.InternalBlock)
+}
+
+func (t *chainCache) addBlock(tipId []byte, block *lpb.InternalBlock) {
+	// 末端区块的NextHash还会变化
+	if t.blockSize <= 0 || !block.GetInTrunk() || len(block.GetNextHash()) == 0 {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if !bytes.Equal(tipId, t.tipId) {
+		return
+	}
+	t.blocks.Add(string(block.GetBlockid()), block)
+	t.heights.Add(block.GetHeight(), string(block.GetBlockid()))
+}
+
+func (t *chainCache) getTx(txId []byte) *cachedTx {
+	if t.txSize <= 0 {
+		return nil
+	}
+	value, ok := t.txs.Get(string(txId))
+	t.count(cacheTypeTx, ok)
+	if !ok {
+		return nil
+	}
+	return value.(*cachedTx)
+}
+
+func (t *chainCache) addTx(tipId []byte, tx *lpb.Transaction, height int64) {
+	if t.txSize <= 0 {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if !bytes.Equal(tipId, t.tipId) {
+		return
+	}
+	t.txs.Add(string(tx.GetTxid()), &cachedTx{tx: tx, height: height})
+}
+
+func (t *chainCache) count(typ string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	metrics.LedgerCacheCounter.WithLabelValues(t.bcName, typ, result).Inc()
+}
+
+// 缓存命中时构造查询结果，状态为主干
+func cachedBlockInfo(block *lpb.InternalBlock, needContent bool) *xpb.BlockInfo {
+	out := &xpb.BlockInfo{Status: lpb.BlockStatus_BLOCK_TRUNK}
+	if needContent {
+		out.Block = block
+	}
+	return out
+}
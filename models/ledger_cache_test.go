@@ -0,0 +1,255 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	xctx "github.com/xuperchain/xupercore/kernel/common/xcontext"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) GetLogId() string                           { return "test" }
+func (nopLogger) SetCommField(key string, value interface{}) {}
+func (nopLogger) SetInfoField(key string, value interface{}) {}
+func (nopLogger) Error(msg string, ctx ...interface{})       {}
+func (nopLogger) Warn(msg string, ctx ...interface{})        {}
+func (nopLogger) Info(msg string, ctx ...interface{})        {}
+func (nopLogger) Trace(msg string, ctx ...interface{})       {}
+func (nopLogger) Debug(msg string, ctx ...interface{})       {}
+
+// fakeLedger 内存中的区块树，主干末端由tip指定
+type fakeLedger struct {
+	blocks       map[string]*lpb.InternalBlock
+	tip          *lpb.InternalBlock
+	irreversible int64
+}
+
+// 创世区块高度为0
+func newFakeLedger() *fakeLedger {
+	genesis := &lpb.InternalBlock{Blockid: []byte("genesis"), Height: 0, InTrunk: true}
+	return &fakeLedger{
+		blocks: map[string]*lpb.InternalBlock{"genesis": genesis},
+		tip:    genesis,
+	}
+}
+
+// 从parent开始产生n个区块并切换主干末端，名字为<branch><height>
+func (t *fakeLedger) grow(parent *lpb.InternalBlock, branch string, n int) *lpb.InternalBlock {
+	for i := 0; i < n; i++ {
+		block := &lpb.InternalBlock{
+			Blockid: []byte(fmt.Sprintf("%s%d", branch, parent.Height+1)),
+			PreHash: parent.Blockid,
+			Height:  parent.Height + 1,
+			InTrunk: true,
+		}
+		t.blocks[string(block.Blockid)] = block
+		parent = block
+	}
+	t.tip = parent
+	return parent
+}
+
+// 主干上高度为height的区块，NextHash已确定
+func (t *fakeLedger) trunkBlock(height int64) *lpb.InternalBlock {
+	var next *lpb.InternalBlock
+	for block := t.tip; block != nil; block = t.blocks[string(block.PreHash)] {
+		if block.Height == height {
+			out := *block
+			if next != nil {
+				out.NextHash = next.Blockid
+			}
+			return &out
+		}
+		next = block
+	}
+	return nil
+}
+
+func (t *fakeLedger) GetMeta() *lpb.LedgerMeta {
+	return &lpb.LedgerMeta{TipBlockid: t.tip.Blockid, TrunkHeight: t.tip.Height}
+}
+
+func (t *fakeLedger) QueryBlockHeader(blockId []byte) (*lpb.InternalBlock, error) {
+	block, ok := t.blocks[string(blockId)]
+	if !ok {
+		return nil, fmt.Errorf("block %s not found", blockId)
+	}
+	return block, nil
+}
+
+func (t *fakeLedger) IrreversibleHeight() int64 {
+	return t.irreversible
+}
+
+// 缓存主干上[from, to]高度的区块和每个区块中的一笔交易
+func fillCache(t *testing.T, cc *chainCache, ledger *fakeLedger, from, to int64) {
+	cc.sync()
+	tipId := cc.snapshot()
+	for height := from; height <= to; height++ {
+		block := ledger.trunkBlock(height)
+		cc.addBlock(tipId, block)
+		cc.addTx(tipId, &lpb.Transaction{Txid: block.Blockid}, height)
+		if cc.getBlockByHeight(height) == nil {
+			t.Fatalf("block %d should be cached", height)
+		}
+	}
+}
+
+func cachedHeights(cc *chainCache, from, to int64) (blocks, txs []int64) {
+	for height := from; height <= to; height++ {
+		if block := cc.getBlockByHeight(height); block != nil {
+			blocks = append(blocks, height)
+			if cc.getTx(block.Blockid) != nil {
+				txs = append(txs, height)
+			}
+		}
+	}
+	return
+}
+
+func newTestChainCache(ledger *fakeLedger) *chainCache {
+	return newChainCache("xuper", nil, ledger, nopLogger{}, 1000, 1000)
+}
+
+func TestLedgerCacheExtend(t *testing.T) {
+	ledger := newFakeLedger()
+	ledger.grow(ledger.tip, "a", 10)
+	cc := newTestChainCache(ledger)
+	fillCache(t, cc, ledger, 1, 9)
+
+	ledger.irreversible = 3
+	ledger.grow(ledger.tip, "a", 5)
+	cc.sync()
+	if blocks, txs := cachedHeights(cc, 1, 15); len(blocks) != 9 || len(txs) != 9 {
+		t.Errorf("cache should be kept when trunk extended, blocks %v txs %v", blocks, txs)
+	}
+	if string(cc.tipId) != "a15" || cc.tipHeight != 15 {
+		t.Errorf("unexpected tip %s %d", cc.tipId, cc.tipHeight)
+	}
+}
+
+func TestLedgerCacheForkSwitch(t *testing.T) {
+	ledger := newFakeLedger()
+	ledger.grow(ledger.tip, "a", 10)
+	cc := newTestChainCache(ledger)
+	fillCache(t, cc, ledger, 1, 9)
+
+	// 从高度7分叉，新主干更长
+	ledger.irreversible = 5
+	ledger.grow(ledger.blocks["a7"], "b", 5)
+	cc.sync()
+	blocks, txs := cachedHeights(cc, 1, 12)
+	if fmt.Sprint(blocks) != "[1 2 3 4]" || fmt.Sprint(txs) != "[1 2 3 4]" {
+		t.Errorf("cache from irreversible height should be invalidated, blocks %v txs %v", blocks, txs)
+	}
+	if cc.getBlock([]byte("a8")) != nil {
+		t.Error("block of the old branch should be invalidated")
+	}
+}
+
+func TestLedgerCacheRollback(t *testing.T) {
+	ledger := newFakeLedger()
+	ledger.grow(ledger.tip, "a", 10)
+	cc := newTestChainCache(ledger)
+	fillCache(t, cc, ledger, 1, 9)
+
+	// 主干回滚到更低的高度
+	ledger.irreversible = 6
+	ledger.tip = ledger.blocks["a8"]
+	cc.sync()
+	if blocks, _ := cachedHeights(cc, 1, 10); fmt.Sprint(blocks) != "[1 2 3 4 5]" {
+		t.Errorf("cache from irreversible height should be invalidated, blocks %v", blocks)
+	}
+}
+
+func TestLedgerCacheWalkLimit(t *testing.T) {
+	ledger := newFakeLedger()
+	ledger.grow(ledger.tip, "a", 10)
+	cc := newTestChainCache(ledger)
+	fillCache(t, cc, ledger, 1, 9)
+
+	// 延伸超过maxTipWalk时不回溯，按主干切换处理
+	ledger.irreversible = 8
+	ledger.grow(ledger.tip, "a", maxTipWalk+1)
+	cc.sync()
+	if blocks, _ := cachedHeights(cc, 1, 10); fmt.Sprint(blocks) != "[1 2 3 4 5 6 7]" {
+		t.Errorf("cache from irreversible height should be invalidated, blocks %v", blocks)
+	}
+
+	// 正好maxTipWalk时回溯判断为延伸
+	fillCache(t, cc, ledger, 8, 9)
+	ledger.grow(ledger.tip, "a", maxTipWalk)
+	cc.sync()
+	if blocks, _ := cachedHeights(cc, 1, 10); len(blocks) != 9 {
+		t.Errorf("cache should be kept when trunk extended, blocks %v", blocks)
+	}
+}
+
+func TestLedgerCacheSnapshotRace(t *testing.T) {
+	ledger := newFakeLedger()
+	ledger.grow(ledger.tip, "a", 10)
+	cc := newTestChainCache(ledger)
+	cc.sync()
+
+	// 读取账本期间主干切换，读到的旧主干数据不写入缓存
+	tipId := cc.snapshot()
+	block := ledger.trunkBlock(8)
+	ledger.grow(ledger.blocks["a7"], "b", 5)
+	cc.sync()
+	cc.addBlock(tipId, block)
+	cc.addTx(tipId, &lpb.Transaction{Txid: []byte("tx")}, 8)
+	if cc.getBlock(block.Blockid) != nil || cc.getBlockByHeight(8) != nil || cc.getTx([]byte("tx")) != nil {
+		t.Error("data read before trunk switch should not be cached")
+	}
+
+	// 末端区块和非主干区块不缓存
+	tipId = cc.snapshot()
+	cc.addBlock(tipId, ledger.trunkBlock(12))
+	cc.addBlock(tipId, &lpb.InternalBlock{Blockid: []byte("orphan"), Height: 3, NextHash: []byte("x")})
+	if cc.getBlockByHeight(12) != nil || cc.getBlock([]byte("orphan")) != nil {
+		t.Error("tip block and block not in trunk should not be cached")
+	}
+	cc.addBlock(tipId, ledger.trunkBlock(11))
+	if cc.getBlockByHeight(11) == nil {
+		t.Error("confirmed trunk block should be cached")
+	}
+}
+
+func TestLedgerCacheReload(t *testing.T) {
+	ledgers := make(map[*ecom.ChainCtx]*fakeLedger)
+	cache := NewLedgerCache(100, 100)
+	cache.newView = func(chainCtx *ecom.ChainCtx) ledgerView {
+		return ledgers[chainCtx]
+	}
+	newChain := func() *ecom.ChainCtx {
+		chainCtx := &ecom.ChainCtx{BaseCtx: xctx.BaseCtx{XLog: nopLogger{}}}
+		ledger := newFakeLedger()
+		ledger.grow(ledger.tip, "a", 10)
+		ledgers[chainCtx] = ledger
+		return chainCtx
+	}
+
+	first := newChain()
+	cc := cache.chain("xuper", first)
+	fillCache(t, cc, ledgers[first], 1, 9)
+	if cache.chain("xuper", first) != cc {
+		t.Fatal("cache of the same chain should be reused")
+	}
+
+	// 链重新加载后重建缓存
+	second := newChain()
+	reloaded := cache.chain("xuper", second)
+	if reloaded == cc {
+		t.Fatal("cache should be rebuilt when chain reloaded")
+	}
+	if blocks, _ := cachedHeights(reloaded, 1, 10); len(blocks) != 0 {
+		t.Errorf("rebuilt cache should be empty, blocks %v", blocks)
+	}
+
+	if NewLedgerCache(0, 0).chain("xuper", first) != nil {
+		t.Error("cache should be disabled when sizes are 0")
+	}
+}
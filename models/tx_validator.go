@@ -0,0 +1,475 @@
+package models
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/def"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/xmodel"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	kledger "github.com/xuperchain/xupercore/kernel/ledger"
+	aclBase "github.com/xuperchain/xupercore/kernel/permission/acl/base"
+	aclUtils "github.com/xuperchain/xupercore/kernel/permission/acl/utils"
+	cryptoBase "github.com/xuperchain/xupercore/lib/crypto/client/base"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// 交易校验项，和pb.TxCheckType保持一致
+type TxCheckType int32
+
+const (
+	TxCheckFormat TxCheckType = iota
+	TxCheckSignature
+	TxCheckPermission
+	TxCheckUtxo
+	TxCheckReadSet
+	TxCheckVerify
+)
+
+// 交易校验失败项，Index为失败的签名或者输入下标，整笔交易维度的失败为-1
+type TxCheckFailure struct {
+	Check  TxCheckType
+	Index  int
+	Reason string
+}
+
+// 交易预校验，和PostTx执行相同的节点侧校验，但是不提交到交易池也不广播
+// 和VerifyTx遇到第一个错误就返回不同，这里会尽可能收集所有的校验失败项
+type txValidator struct {
+	state    txState
+	acl      aclBase.AclManager
+	crypto   cryptoBase.CryptoClient
+	tx       *lpb.Transaction
+	failures []*TxCheckFailure
+	// 已经通过签名校验的地址或者合约账户
+	verifiedID map[string]bool
+	// 尚未上链的交易输出，utxo key => 输出，例如和交易一起提交的服务费交易
+	pending map[string]*protos.TxOutput
+}
+
+// txState 交易校验用到的账本和状态查询
+type txState interface {
+	NoFee() bool
+	TrunkHeight() int64
+	HasTx(txid []byte) (bool, error)
+	QueryTx(txid []byte) (*lpb.Transaction, bool, error)
+	GetUtxo(utxoKey []byte) ([]byte, error)
+	VerifyTx(tx *lpb.Transaction) (bool, error)
+	CreateXMReader() kledger.XMReader
+}
+
+type chainState struct {
+	chainCtx *ecom.ChainCtx
+}
+
+func (t *chainState) NoFee() bool {
+	return t.chainCtx.Ledger.GetNoFee()
+}
+
+func (t *chainState) TrunkHeight() int64 {
+	return t.chainCtx.Ledger.GetMeta().GetTrunkHeight()
+}
+
+func (t *chainState) HasTx(txid []byte) (bool, error) {
+	return t.chainCtx.State.HasTx(txid)
+}
+
+func (t *chainState) QueryTx(txid []byte) (*lpb.Transaction, bool, error) {
+	return t.chainCtx.State.QueryTx(txid)
+}
+
+func (t *chainState) GetUtxo(utxoKey []byte) ([]byte, error) {
+	return t.chainCtx.State.GetLDB().Get(utxoKey)
+}
+
+func (t *chainState) VerifyTx(tx *lpb.Transaction) (bool, error) {
+	return t.chainCtx.State.VerifyTx(tx)
+}
+
+func (t *chainState) CreateXMReader() kledger.XMReader {
+	return t.chainCtx.State.CreateXMReader()
+}
+
+func newTxValidator(chainCtx *ecom.ChainCtx, tx *lpb.Transaction) *txValidator {
+	return newStateTxValidator(&chainState{chainCtx: chainCtx}, chainCtx.Acl, chainCtx.Crypto, tx)
+}
+
+func newStateTxValidator(state txState, acl aclBase.AclManager, crypto cryptoBase.CryptoClient,
+	tx *lpb.Transaction) *txValidator {
+	return &txValidator{
+		state:      state,
+		acl:        acl,
+		crypto:     crypto,
+		tx:         tx,
+		failures:   make([]*TxCheckFailure, 0),
+		verifiedID: make(map[string]bool),
+	}
+}
+
+func (t *txValidator) validate() []*TxCheckFailure {
+	if !t.checkFormat() {
+		// 格式错误的交易没有继续校验的意义
+		return t.failures
+	}
+
+	t.checkSignature()
+	t.checkPermission()
+	t.checkUtxo()
+	t.checkReadSet()
+
+	// 分项校验都通过后，再做一次和PostTx完全一致的校验，覆盖合约权限、读写集重放等检查
+	if len(t.failures) == 0 {
+		ok, err := t.state.VerifyTx(t.tx)
+		if err != nil || !ok {
+			t.addFailure(TxCheckVerify, -1, "verify tx failed.err:%v", err)
+		}
+	}
+
+	return t.failures
+}
+
+func (t *txValidator) addFailure(check TxCheckType, index int, format string, args ...interface{}) {
+	t.failures = append(t.failures, &TxCheckFailure{
+		Check:  check,
+		Index:  index,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// 校验交易格式、版本和txid，返回false表示无需继续校验
+func (t *txValidator) checkFormat() bool {
+	tx := t.tx
+	if tx.GetVersion() > state.BetaTxVersion || tx.GetVersion() <= state.RootTxVersion {
+		t.addFailure(TxCheckFormat, -1, "invalid tx version.version:%d", tx.GetVersion())
+		return false
+	}
+	if tx.GetCoinbase() || tx.GetAutogen() || len(tx.GetBlockid()) > 0 {
+		t.addFailure(TxCheckFormat, -1, "coinbase, autogen or packed tx can not be posted")
+		return false
+	}
+	// 只调用合约、不转账的交易可以没有utxo输入
+	if len(tx.GetTxInputs()) == 0 && len(tx.GetTxOutputs()) > 0 && !t.state.NoFee() {
+		t.addFailure(TxCheckFormat, -1, "tx inputs can not be empty while has outputs")
+	}
+
+	txId, err := txhash.MakeTransactionID(tx)
+	if err != nil {
+		t.addFailure(TxCheckFormat, -1, "make txid failed.err:%v", err)
+		return false
+	}
+	if !bytes.Equal(tx.GetTxid(), txId) {
+		t.addFailure(TxCheckFormat, -1, "txid not match.expect:%x", txId)
+	}
+	if exist, _ := t.state.HasTx(tx.GetTxid()); exist {
+		t.addFailure(TxCheckFormat, -1, "tx already exist in unconfirmed table")
+	} else if _, _, err := t.state.QueryTx(tx.GetTxid()); err == nil {
+		t.addFailure(TxCheckFormat, -1, "tx already exist in ledger")
+	}
+
+	return true
+}
+
+// 背书前的校验：格式、发起人签名和utxo，背书签名尚未加入，不校验auth_require签名
+// pending中的交易输出视为可用的utxo
+func (t *txValidator) precheck(pending []*lpb.Transaction) []*TxCheckFailure {
+	t.pending = make(map[string]*protos.TxOutput)
+	for _, ptx := range pending {
+		for offset, output := range ptx.GetTxOutputs() {
+			key := utxo.GenUtxoKeyWithPrefix(output.GetToAddr(), ptx.GetTxid(), int32(offset))
+			t.pending[key] = output
+		}
+	}
+
+	if !t.checkFormat() {
+		return t.failures
+	}
+	if t.tx.GetXuperSign() != nil {
+		t.addFailure(TxCheckSignature, -1, "xuper sign is not supported")
+		return t.failures
+	}
+	digestHash, err := txhash.MakeTxDigestHash(t.tx)
+	if err != nil {
+		t.addFailure(TxCheckSignature, -1, "make tx digest hash failed.err:%v", err)
+		return t.failures
+	}
+	t.checkInitiatorSign(digestHash)
+	t.checkUtxo()
+	return t.failures
+}
+
+// 校验发起人、auth_require和多签的签名
+func (t *txValidator) checkSignature() {
+	tx := t.tx
+	digestHash, err := txhash.MakeTxDigestHash(tx)
+	if err != nil {
+		t.addFailure(TxCheckSignature, -1, "make tx digest hash failed.err:%v", err)
+		return
+	}
+
+	if tx.GetXuperSign() != nil {
+		t.checkXuperSign(digestHash)
+		return
+	}
+
+	if len(tx.GetAuthRequire()) != len(tx.GetAuthRequireSigns()) {
+		t.addFailure(TxCheckSignature, -1, "auth require signs count not match.need:%d got:%d",
+			len(tx.GetAuthRequire()), len(tx.GetAuthRequireSigns()))
+	}
+	t.checkInitiatorSign(digestHash)
+
+	// 校验auth_require签名
+	for idx, authReq := range tx.GetAuthRequire() {
+		if idx >= len(tx.GetAuthRequireSigns()) {
+			break
+		}
+		splitRes := strings.Split(authReq, "/")
+		addr := splitRes[len(splitRes)-1]
+		if t.verifiedID[addr] {
+			continue
+		}
+		ok, err := aclUtils.IdentifyAK(addr, tx.GetAuthRequireSigns()[idx], digestHash)
+		if err != nil || !ok {
+			t.addFailure(TxCheckSignature, idx, "auth require sign verify failed.auth_require:%s err:%v",
+				authReq, err)
+			continue
+		}
+		t.verifiedID[addr] = true
+	}
+}
+
+// 校验发起人签名，发起人为合约账户时签名需要满足账户acl
+func (t *txValidator) checkInitiatorSign(digestHash []byte) {
+	tx := t.tx
+	if len(tx.GetInitiatorSigns()) < 1 {
+		t.addFailure(TxCheckSignature, -1, "initiator signs is empty")
+	}
+
+	switch aclUtils.IsAccount(tx.GetInitiator()) {
+	case 0:
+		if len(tx.GetInitiatorSigns()) > 0 {
+			ok, err := aclUtils.IdentifyAK(tx.GetInitiator(), tx.GetInitiatorSigns()[0], digestHash)
+			if err != nil || !ok {
+				t.addFailure(TxCheckSignature, 0, "initiator sign verify failed.err:%v", err)
+			} else {
+				t.verifiedID[tx.GetInitiator()] = true
+			}
+		}
+	case 1:
+		initiatorAddrs := make([]string, 0, len(tx.GetInitiatorSigns()))
+		for idx, sign := range tx.GetInitiatorSigns() {
+			addr, err := t.getAddress(sign.GetPublicKey())
+			if err != nil {
+				t.addFailure(TxCheckSignature, idx, "initiator sign public key invalid.err:%v", err)
+				continue
+			}
+			ok, err := aclUtils.IdentifyAK(addr, sign, digestHash)
+			if err != nil || !ok {
+				t.addFailure(TxCheckSignature, idx, "initiator sign verify failed.addr:%s err:%v", addr, err)
+				continue
+			}
+			t.verifiedID[addr] = true
+			initiatorAddrs = append(initiatorAddrs, tx.GetInitiator()+"/"+addr)
+		}
+		ok, err := aclUtils.IdentifyAccount(t.acl, tx.GetInitiator(), initiatorAddrs)
+		if err != nil || !ok {
+			t.addFailure(TxCheckPermission, -1, "initiator account acl not enough.account:%s err:%v",
+				tx.GetInitiator(), err)
+		}
+	default:
+		t.addFailure(TxCheckSignature, -1, "invalid initiator.initiator:%s", tx.GetInitiator())
+	}
+}
+
+func (t *txValidator) checkXuperSign(digestHash []byte) {
+	tx := t.tx
+	crypto := t.crypto
+
+	addrList := []string{tx.GetInitiator()}
+	uniqueAddrs := map[string]bool{tx.GetInitiator(): true}
+	for _, authReq := range tx.GetAuthRequire() {
+		splitRes := strings.Split(authReq, "/")
+		addr := splitRes[len(splitRes)-1]
+		if uniqueAddrs[addr] {
+			continue
+		}
+		uniqueAddrs[addr] = true
+		addrList = append(addrList, addr)
+	}
+
+	pubKeyJsons := tx.GetXuperSign().GetPublicKeys()
+	if len(addrList) != len(pubKeyJsons) {
+		t.addFailure(TxCheckSignature, -1, "xuper sign address and public key count not match.need:%d got:%d",
+			len(addrList), len(pubKeyJsons))
+		return
+	}
+
+	pubKeys := make([]*ecdsa.PublicKey, 0, len(pubKeyJsons))
+	for idx, pubKeyJson := range pubKeyJsons {
+		pubKey, err := crypto.GetEcdsaPublicKeyFromJsonStr(string(pubKeyJson))
+		if err != nil {
+			t.addFailure(TxCheckSignature, idx, "xuper sign public key invalid.err:%v", err)
+			continue
+		}
+		if ok, _ := crypto.VerifyAddressUsingPublicKey(addrList[idx], pubKey); !ok {
+			t.addFailure(TxCheckSignature, idx, "xuper sign address and public key not match.addr:%s",
+				addrList[idx])
+			continue
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if len(pubKeys) != len(pubKeyJsons) {
+		return
+	}
+
+	ok, err := crypto.VerifyXuperSignature(pubKeys, tx.GetXuperSign().GetSignature(), digestHash)
+	if err != nil || !ok {
+		t.addFailure(TxCheckSignature, -1, "xuper sign verify failed.err:%v", err)
+		return
+	}
+	for addr := range uniqueAddrs {
+		t.verifiedID[addr] = true
+	}
+}
+
+// 校验utxo输入的转出权限，合约转出的utxo在读写集重放时校验
+func (t *txValidator) checkPermission() {
+	tx := t.tx
+	conUtxoInputs, err := xmodel.ParseContractUtxoInputs(tx)
+	if err != nil {
+		t.addFailure(TxCheckPermission, -1, "parse contract utxo inputs failed.err:%v", err)
+		return
+	}
+	conUtxoKeys := make(map[string]bool, len(conUtxoInputs))
+	for _, input := range conUtxoInputs {
+		conUtxoKeys[utxo.GenUtxoKey(input.GetFromAddr(), input.GetRefTxid(), input.GetRefOffset())] = true
+	}
+
+	checked := make(map[string]bool)
+	for idx, input := range tx.GetTxInputs() {
+		if conUtxoKeys[utxo.GenUtxoKey(input.GetFromAddr(), input.GetRefTxid(), input.GetRefOffset())] {
+			continue
+		}
+		name := string(input.GetFromAddr())
+		if t.verifiedID[name] || checked[name] {
+			continue
+		}
+		checked[name] = true
+
+		switch aclUtils.IsAccount(name) {
+		case 1:
+			acl, err := t.acl.GetAccountACL(name)
+			if err != nil || acl == nil {
+				t.addFailure(TxCheckPermission, idx, "account not exist.account:%s err:%v", name, err)
+				continue
+			}
+			ok, err := aclUtils.IdentifyAccount(t.acl, name, tx.GetAuthRequire())
+			if err != nil || !ok {
+				t.addFailure(TxCheckPermission, idx, "auth require not satisfy account acl.account:%s err:%v",
+					name, err)
+			}
+		case 0:
+			t.addFailure(TxCheckPermission, idx, "address of utxo input has no signature.address:%s", name)
+		default:
+			t.addFailure(TxCheckPermission, idx, "invalid utxo input address.address:%s", name)
+		}
+	}
+}
+
+// 校验utxo输入未被花费、金额一致、未被冻结，且输入输出金额相等
+func (t *txValidator) checkUtxo() {
+	tx := t.tx
+	trunkHeight := t.state.TrunkHeight()
+
+	failCnt := len(t.failures)
+	inputSum := big.NewInt(0)
+	dedup := make(map[string]bool)
+	for idx, input := range tx.GetTxInputs() {
+		utxoKey := utxo.GenUtxoKeyWithPrefix(input.GetFromAddr(), input.GetRefTxid(), input.GetRefOffset())
+		if dedup[utxoKey] {
+			t.addFailure(TxCheckUtxo, idx, "duplicated utxo input.ref_txid:%x offset:%d",
+				input.GetRefTxid(), input.GetRefOffset())
+			continue
+		}
+		dedup[utxoKey] = true
+
+		if output, ok := t.pending[utxoKey]; ok {
+			if !bytes.Equal(output.GetAmount(), input.GetAmount()) {
+				t.addFailure(TxCheckUtxo, idx, "utxo amount not match.expect:%s got:%s",
+					new(big.Int).SetBytes(output.GetAmount()).String(),
+					new(big.Int).SetBytes(input.GetAmount()).String())
+				continue
+			}
+			inputSum.Add(inputSum, new(big.Int).SetBytes(output.GetAmount()))
+			continue
+		}
+
+		val, err := t.state.GetUtxo([]byte(utxoKey))
+		if err != nil {
+			if def.NormalizedKVError(err) == def.ErrKVNotFound {
+				t.addFailure(TxCheckUtxo, idx, "utxo not exist or already spent.ref_txid:%x offset:%d",
+					input.GetRefTxid(), input.GetRefOffset())
+			} else {
+				t.addFailure(TxCheckUtxo, idx, "read utxo failed.err:%v", err)
+			}
+			continue
+		}
+		item := &utxo.UtxoItem{}
+		if err := item.Loads(val); err != nil {
+			t.addFailure(TxCheckUtxo, idx, "load utxo failed.err:%v", err)
+			continue
+		}
+		if !bytes.Equal(item.Amount.Bytes(), input.GetAmount()) {
+			t.addFailure(TxCheckUtxo, idx, "utxo amount not match.expect:%s got:%s",
+				item.Amount.String(), new(big.Int).SetBytes(input.GetAmount()).String())
+			continue
+		}
+		if item.FrozenHeight > trunkHeight || item.FrozenHeight == -1 {
+			t.addFailure(TxCheckUtxo, idx, "utxo is frozen.frozen_height:%d", item.FrozenHeight)
+			continue
+		}
+		inputSum.Add(inputSum, item.Amount)
+	}
+
+	outputSum := big.NewInt(0)
+	for _, output := range tx.GetTxOutputs() {
+		outputSum.Add(outputSum, new(big.Int).SetBytes(output.GetAmount()))
+	}
+	// 部分输入校验失败时，金额对比没有意义
+	if len(t.failures) == failCnt && inputSum.Cmp(outputSum) != 0 {
+		t.addFailure(TxCheckUtxo, -1, "input amount not equal to output.input:%s output:%s",
+			inputSum.String(), outputSum.String())
+	}
+}
+
+// 校验读集的版本和最新状态一致
+func (t *txValidator) checkReadSet() {
+	reader := t.state.CreateXMReader()
+	for idx, input := range t.tx.GetTxInputsExt() {
+		data, err := reader.Get(input.GetBucket(), input.GetKey())
+		if err != nil {
+			t.addFailure(TxCheckReadSet, idx, "read state failed.bucket:%s key:%s err:%v",
+				input.GetBucket(), input.GetKey(), err)
+			continue
+		}
+		expect := xmodel.GetVersion(data)
+		if expect != xmodel.GetVersionOfTxInput(input) {
+			t.addFailure(TxCheckReadSet, idx, "read set version not match.bucket:%s key:%s expect:%s got:%s",
+				input.GetBucket(), input.GetKey(), expect, xmodel.GetVersionOfTxInput(input))
+		}
+	}
+}
+
+func (t *txValidator) getAddress(pubKeyJson string) (string, error) {
+	pubKey, err := t.crypto.GetEcdsaPublicKeyFromJsonStr(pubKeyJson)
+	if err != nil {
+		return "", err
+	}
+	return t.crypto.GetAddressFromPublicKey(pubKey)
+}
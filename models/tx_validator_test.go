@@ -0,0 +1,409 @@
+package models
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	kledger "github.com/xuperchain/xupercore/kernel/ledger"
+	"github.com/xuperchain/xupercore/lib/crypto/client"
+	cryptoBase "github.com/xuperchain/xupercore/lib/crypto/client/base"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// fakeTxState 内存中的utxo、交易和合约状态
+type fakeTxState struct {
+	noFee     bool
+	utxos     map[string]*utxo.UtxoItem
+	txs       map[string]bool
+	data      map[string]*kledger.VersionedData
+	verifyErr error
+}
+
+func newFakeTxState() *fakeTxState {
+	return &fakeTxState{
+		utxos: make(map[string]*utxo.UtxoItem),
+		txs:   make(map[string]bool),
+		data:  make(map[string]*kledger.VersionedData),
+	}
+}
+
+func (t *fakeTxState) addUtxo(addr, refTxid string, amount, frozenHeight int64) {
+	key := utxo.GenUtxoKeyWithPrefix([]byte(addr), []byte(refTxid), 0)
+	t.utxos[key] = &utxo.UtxoItem{Amount: big.NewInt(amount), FrozenHeight: frozenHeight}
+}
+
+func (t *fakeTxState) NoFee() bool {
+	return t.noFee
+}
+
+func (t *fakeTxState) TrunkHeight() int64 {
+	return 100
+}
+
+func (t *fakeTxState) HasTx(txid []byte) (bool, error) {
+	return t.txs[string(txid)], nil
+}
+
+func (t *fakeTxState) QueryTx(txid []byte) (*lpb.Transaction, bool, error) {
+	return nil, false, errors.New("tx not found")
+}
+
+func (t *fakeTxState) GetUtxo(utxoKey []byte) ([]byte, error) {
+	item, ok := t.utxos[string(utxoKey)]
+	if !ok {
+		return nil, errors.New("leveldb: not found")
+	}
+	return item.Dumps()
+}
+
+func (t *fakeTxState) VerifyTx(tx *lpb.Transaction) (bool, error) {
+	return t.verifyErr == nil, t.verifyErr
+}
+
+func (t *fakeTxState) CreateXMReader() kledger.XMReader {
+	return t
+}
+
+func (t *fakeTxState) Get(bucket string, key []byte) (*kledger.VersionedData, error) {
+	if data, ok := t.data[bucket+"/"+string(key)]; ok {
+		return data, nil
+	}
+	return &kledger.VersionedData{}, nil
+}
+
+func (t *fakeTxState) Select(bucket string, startKey []byte, endKey []byte) (kledger.XMIterator, error) {
+	return nil, errors.New("not supported")
+}
+
+type testKey struct {
+	priv *ecdsa.PrivateKey
+	addr string
+	pub  string
+}
+
+func newTestKey(t *testing.T, crypto cryptoBase.CryptoClient, seed string) *testKey {
+	priv, err := crypto.GenerateKeyBySeed([]byte(strings.Repeat(seed, 32)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr, err := crypto.GetAddressFromPublicKey(&priv.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := crypto.GetEcdsaPublicKeyJsonFormatStr(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testKey{priv: priv, addr: addr, pub: pub}
+}
+
+// 发起人签名
+func signTestTx(t *testing.T, crypto cryptoBase.CryptoClient, tx *lpb.Transaction, key *testKey) {
+	digestHash, err := txhash.MakeTxDigestHash(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign, err := crypto.SignECDSA(key.priv, digestHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.InitiatorSigns = []*protos.SignatureInfo{{PublicKey: key.pub, Sign: sign}}
+}
+
+func makeTestTxid(t *testing.T, tx *lpb.Transaction) {
+	txid, err := txhash.MakeTransactionID(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx.Txid = txid
+}
+
+func testInput(addr, refTxid string, amount int64) *protos.TxInput {
+	return &protos.TxInput{FromAddr: []byte(addr), RefTxid: []byte(refTxid), Amount: big.NewInt(amount).Bytes()}
+}
+
+func testOutput(addr string, amount int64) *protos.TxOutput {
+	return &protos.TxOutput{ToAddr: []byte(addr), Amount: big.NewInt(amount).Bytes()}
+}
+
+func failureString(failures []*TxCheckFailure) string {
+	out := make([]string, 0, len(failures))
+	for _, failure := range failures {
+		out = append(out, fmt.Sprintf("%d:%d", failure.Check, failure.Index))
+	}
+	return strings.Join(out, ",")
+}
+
+func expectString(expect ...TxCheckFailure) string {
+	out := make([]string, 0, len(expect))
+	for _, failure := range expect {
+		out = append(out, fmt.Sprintf("%d:%d", failure.Check, failure.Index))
+	}
+	return strings.Join(out, ",")
+}
+
+type txValidatorCase struct {
+	name string
+	// 签名前修改交易
+	modify func(tx *lpb.Transaction)
+	// 签名后、计算txid前修改交易
+	tamper func(tx *lpb.Transaction)
+	state  func(st *fakeTxState, tx *lpb.Transaction)
+	expect []TxCheckFailure
+}
+
+// alice的utxo u1转账10给bob，各用例在此基础上修改
+func runTxValidatorCase(t *testing.T, c txValidatorCase,
+	check func(v *txValidator) []*TxCheckFailure) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := newTestKey(t, crypto, "a")
+	bob := newTestKey(t, crypto, "b")
+	st := newFakeTxState()
+	st.addUtxo(alice.addr, "u1", 10, 0)
+	st.addUtxo(bob.addr, "u3", 5, 0)
+
+	tx := &lpb.Transaction{
+		Version:   1,
+		Initiator: alice.addr,
+		Nonce:     "nonce",
+		TxInputs:  []*protos.TxInput{testInput(alice.addr, "u1", 10)},
+		TxOutputs: []*protos.TxOutput{testOutput(bob.addr, 10)},
+	}
+	if c.modify != nil {
+		c.modify(tx)
+	}
+	signTestTx(t, crypto, tx, alice)
+	if c.tamper != nil {
+		c.tamper(tx)
+	}
+	makeTestTxid(t, tx)
+	if c.state != nil {
+		c.state(st, tx)
+	}
+
+	failures := check(newStateTxValidator(st, nil, crypto, tx))
+	if got, expect := failureString(failures), expectString(c.expect...); got != expect {
+		t.Errorf("%s: failures [%s], expect [%s]", c.name, got, expect)
+		for _, failure := range failures {
+			t.Logf("%s: %s", c.name, failure.Reason)
+		}
+	}
+}
+
+func TestTxValidatorValidate(t *testing.T) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := newTestKey(t, crypto, "a").addr
+	bob := newTestKey(t, crypto, "b").addr
+	readSet := []*protos.TxInputExt{{Bucket: "counter", Key: []byte("k"), RefTxid: []byte("v1")}}
+
+	cases := []txValidatorCase{
+		{name: "transfer"},
+		{
+			name: "contract only",
+			modify: func(tx *lpb.Transaction) {
+				tx.TxInputs = nil
+				tx.TxOutputs = nil
+				tx.TxInputsExt = readSet
+			},
+			state: func(st *fakeTxState, tx *lpb.Transaction) {
+				st.data["counter/k"] = &kledger.VersionedData{RefTxid: []byte("v1")}
+			},
+		},
+		{
+			name:   "outputs without inputs",
+			modify: func(tx *lpb.Transaction) { tx.TxInputs = nil },
+			expect: []TxCheckFailure{{Check: TxCheckFormat, Index: -1}, {Check: TxCheckUtxo, Index: -1}},
+		},
+		{
+			name:   "outputs without inputs no fee",
+			modify: func(tx *lpb.Transaction) { tx.TxInputs = nil },
+			state:  func(st *fakeTxState, tx *lpb.Transaction) { st.noFee = true },
+			expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: -1}},
+		},
+		{
+			name:   "invalid version",
+			modify: func(tx *lpb.Transaction) { tx.Version = 4 },
+			expect: []TxCheckFailure{{Check: TxCheckFormat, Index: -1}},
+		},
+		{
+			name:   "coinbase",
+			modify: func(tx *lpb.Transaction) { tx.Coinbase = true },
+			expect: []TxCheckFailure{{Check: TxCheckFormat, Index: -1}},
+		},
+		{
+			name:   "txid not match",
+			state:  func(st *fakeTxState, tx *lpb.Transaction) { tx.Txid = []byte("txid") },
+			expect: []TxCheckFailure{{Check: TxCheckFormat, Index: -1}},
+		},
+		{
+			name:   "tx exist",
+			state:  func(st *fakeTxState, tx *lpb.Transaction) { st.txs[string(tx.Txid)] = true },
+			expect: []TxCheckFailure{{Check: TxCheckFormat, Index: -1}},
+		},
+		{
+			name:   "bad initiator sign",
+			tamper: func(tx *lpb.Transaction) { tx.InitiatorSigns[0].Sign = []byte("sign") },
+			expect: []TxCheckFailure{{Check: TxCheckSignature, Index: 0}, {Check: TxCheckPermission, Index: 0}},
+		},
+		{
+			name:   "no initiator sign",
+			tamper: func(tx *lpb.Transaction) { tx.InitiatorSigns = nil },
+			expect: []TxCheckFailure{{Check: TxCheckSignature, Index: -1}, {Check: TxCheckPermission, Index: 0}},
+		},
+		{
+			name:   "auth require not signed",
+			modify: func(tx *lpb.Transaction) { tx.AuthRequire = []string{bob} },
+			expect: []TxCheckFailure{{Check: TxCheckSignature, Index: -1}},
+		},
+		{
+			name: "input without sign",
+			modify: func(tx *lpb.Transaction) {
+				tx.TxInputs = append(tx.TxInputs, testInput(bob, "u3", 5))
+				tx.TxOutputs = append(tx.TxOutputs, testOutput(alice, 5))
+			},
+			expect: []TxCheckFailure{{Check: TxCheckPermission, Index: 1}},
+		},
+		{
+			name:   "utxo not exist",
+			modify: func(tx *lpb.Transaction) { tx.TxInputs[0].RefTxid = []byte("u2") },
+			expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: 0}},
+		},
+		{
+			name:   "utxo amount not match",
+			state:  func(st *fakeTxState, tx *lpb.Transaction) { st.addUtxo(alice, "u1", 9, 0) },
+			expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: 0}},
+		},
+		{
+			name:   "utxo frozen",
+			state:  func(st *fakeTxState, tx *lpb.Transaction) { st.addUtxo(alice, "u1", 10, 101) },
+			expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: 0}},
+		},
+		{
+			name: "duplicated input",
+			modify: func(tx *lpb.Transaction) {
+				tx.TxInputs = append(tx.TxInputs, testInput(alice, "u1", 10))
+				tx.TxOutputs = append(tx.TxOutputs, testOutput(bob, 10))
+			},
+			expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: 1}},
+		},
+		{
+			name:   "input amount not equal to output",
+			modify: func(tx *lpb.Transaction) { tx.TxOutputs[0] = testOutput(bob, 9) },
+			expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: -1}},
+		},
+		{
+			name:   "read set changed",
+			modify: func(tx *lpb.Transaction) { tx.TxInputsExt = readSet },
+			state: func(st *fakeTxState, tx *lpb.Transaction) {
+				st.data["counter/k"] = &kledger.VersionedData{RefTxid: []byte("v2")}
+			},
+			expect: []TxCheckFailure{{Check: TxCheckReadSet, Index: 0}},
+		},
+		{
+			name:   "verify failed",
+			state:  func(st *fakeTxState, tx *lpb.Transaction) { st.verifyErr = errors.New("contract permission denied") },
+			expect: []TxCheckFailure{{Check: TxCheckVerify, Index: -1}},
+		},
+	}
+	for _, c := range cases {
+		runTxValidatorCase(t, c, func(v *txValidator) []*TxCheckFailure {
+			return v.validate()
+		})
+	}
+}
+
+func TestTxValidatorPrecheck(t *testing.T) {
+	crypto, err := client.CreateCryptoClient(client.CryptoTypeDefault)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := newTestKey(t, crypto, "a").addr
+	bob := newTestKey(t, crypto, "b").addr
+	// 和交易一起提交的服务费交易，输出给alice
+	feeTx := func(amount int64) *lpb.Transaction {
+		return &lpb.Transaction{Txid: []byte("fee"), TxOutputs: []*protos.TxOutput{testOutput(alice, amount)}}
+	}
+	payFee := func(tx *lpb.Transaction) { tx.TxInputs[0].RefTxid = []byte("fee") }
+
+	cases := []struct {
+		txValidatorCase
+		pending []*lpb.Transaction
+	}{
+		{txValidatorCase: txValidatorCase{name: "transfer"}},
+		{
+			txValidatorCase: txValidatorCase{name: "pay with pending output", modify: payFee},
+			pending:         []*lpb.Transaction{feeTx(10)},
+		},
+		{
+			txValidatorCase: txValidatorCase{
+				name:   "pending output missing",
+				modify: payFee,
+				expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: 0}},
+			},
+		},
+		{
+			txValidatorCase: txValidatorCase{
+				name:   "pending amount not match",
+				modify: payFee,
+				expect: []TxCheckFailure{{Check: TxCheckUtxo, Index: 0}},
+			},
+			pending: []*lpb.Transaction{feeTx(9)},
+		},
+		{
+			txValidatorCase: txValidatorCase{
+				name: "contract only",
+				modify: func(tx *lpb.Transaction) {
+					tx.TxInputs = nil
+					tx.TxOutputs = nil
+				},
+			},
+		},
+		{
+			// 背书签名尚未加入，不校验auth_require签名
+			txValidatorCase: txValidatorCase{
+				name:   "auth require not signed",
+				modify: func(tx *lpb.Transaction) { tx.AuthRequire = []string{bob} },
+			},
+		},
+		{
+			txValidatorCase: txValidatorCase{
+				name:   "bad initiator sign",
+				tamper: func(tx *lpb.Transaction) { tx.InitiatorSigns[0].Sign = []byte("sign") },
+				expect: []TxCheckFailure{{Check: TxCheckSignature, Index: 0}},
+			},
+		},
+		{
+			txValidatorCase: txValidatorCase{
+				name:   "xuper sign",
+				tamper: func(tx *lpb.Transaction) { tx.XuperSign = &lpb.XuperSignature{} },
+				expect: []TxCheckFailure{{Check: TxCheckSignature, Index: -1}},
+			},
+		},
+		{
+			txValidatorCase: txValidatorCase{
+				name:   "coinbase",
+				modify: func(tx *lpb.Transaction) { tx.Coinbase = true },
+				expect: []TxCheckFailure{{Check: TxCheckFormat, Index: -1}},
+			},
+		},
+	}
+	for _, c := range cases {
+		pending := c.pending
+		runTxValidatorCase(t, c.txValidatorCase, func(v *txValidator) []*TxCheckFailure {
+			return v.precheck(pending)
+		})
+	}
+}
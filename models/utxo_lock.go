@@ -0,0 +1,102 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+)
+
+// UtxoLockSign 临时锁定utxo时用户对请求的签名
+// Timestamp和Nonce都为空时是旧格式签名，只对链名、账户、金额和是否锁定签名
+type UtxoLockSign struct {
+	PublicKey string
+	Sign      []byte
+	// 签名时的unix时间戳，单位秒
+	Timestamp int64
+	Nonce     string
+}
+
+func (t *UtxoLockSign) legacy() bool {
+	return t.Timestamp == 0 && t.Nonce == ""
+}
+
+// 签名内容，新格式在旧格式之后追加时间戳和随机数
+func (t *UtxoLockSign) content(bcName, account string, need *big.Int, isLock bool) string {
+	content := bcName + account + need.String() + strconv.FormatBool(isLock)
+	if t.legacy() {
+		return content
+	}
+	return content + strconv.FormatInt(t.Timestamp, 10) + t.Nonce
+}
+
+// UtxoLockGuard 校验锁定utxo签名的有效期，并记录有效期内用过的签名防止重放
+type UtxoLockGuard struct {
+	// 开启后拒绝旧格式签名
+	strict bool
+	window time.Duration
+
+	mutex sync.Mutex
+	// 签名内容 => 过期时间
+	used    map[string]time.Time
+	pruneAt time.Time
+	now     func() time.Time
+}
+
+// window必须大于0，否则所有新格式签名都会被判定为过期
+func NewUtxoLockGuard(strict bool, window time.Duration) (*UtxoLockGuard, error) {
+	if window <= 0 {
+		return nil, fmt.Errorf("utxo lock sign window must be positive: %v", window)
+	}
+	return &UtxoLockGuard{
+		strict: strict,
+		window: window,
+		used:   make(map[string]time.Time),
+		now:    time.Now,
+	}, nil
+}
+
+// 签名时间和节点时间相差超过window时拒绝
+func (t *UtxoLockGuard) checkFresh(sign *UtxoLockSign) error {
+	if sign.legacy() {
+		if t.strict {
+			return ecom.ErrUnauthorized.More("utxo lock sign requires timestamp and nonce")
+		}
+		return nil
+	}
+
+	diff := t.now().Sub(time.Unix(sign.Timestamp, 0))
+	if diff > t.window || diff < -t.window {
+		return ecom.ErrUnauthorized.More("utxo lock sign expired")
+	}
+	return nil
+}
+
+// 签名内容在有效期内只能使用一次，已经使用过时返回false
+func (t *UtxoLockGuard) use(sign *UtxoLockSign, content string) bool {
+	if sign.legacy() {
+		return true
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	now := t.now()
+	if now.After(t.pruneAt) {
+		for key, expire := range t.used {
+			if now.After(expire) {
+				delete(t.used, key)
+			}
+		}
+		t.pruneAt = now.Add(t.window)
+	}
+
+	if _, ok := t.used[content]; ok {
+		return false
+	}
+	// 超过有效期的签名会被checkFresh拒绝，不需要继续记录
+	t.used[content] = time.Unix(sign.Timestamp, 0).Add(t.window)
+	return true
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func newTestGuard(t *testing.T, strict bool, now *time.Time) *UtxoLockGuard {
+	guard, err := NewUtxoLockGuard(strict, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	guard.now = func() time.Time { return *now }
+	return guard
+}
+
+func TestNewUtxoLockGuard(t *testing.T) {
+	for _, window := range []time.Duration{0, -time.Second} {
+		if _, err := NewUtxoLockGuard(false, window); err == nil {
+			t.Errorf("window %v should be invalid", window)
+		}
+	}
+}
+
+func TestUtxoLockGuardFresh(t *testing.T) {
+	now := time.Unix(1622505600, 0)
+	signAt := func(d time.Duration) *UtxoLockSign {
+		return &UtxoLockSign{Timestamp: now.Add(d).Unix(), Nonce: "n"}
+	}
+	cases := []struct {
+		name   string
+		strict bool
+		sign   *UtxoLockSign
+		ok     bool
+	}{
+		{name: "now", sign: signAt(0), ok: true},
+		{name: "in window", sign: signAt(-time.Minute), ok: true},
+		{name: "stale", sign: signAt(-time.Minute - time.Second)},
+		{name: "future in window", sign: signAt(time.Minute), ok: true},
+		{name: "future", sign: signAt(time.Minute + time.Second)},
+		{name: "legacy", sign: &UtxoLockSign{}, ok: true},
+		{name: "legacy strict", strict: true, sign: &UtxoLockSign{}},
+		{name: "strict", strict: true, sign: signAt(0), ok: true},
+		// 只有随机数也是新格式，时间戳为0已过期
+		{name: "nonce without timestamp", sign: &UtxoLockSign{Nonce: "n"}},
+	}
+	for _, c := range cases {
+		err := newTestGuard(t, c.strict, &now).checkFresh(c.sign)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: expect ok %v, got %v", c.name, c.ok, err)
+		}
+	}
+}
+
+func TestUtxoLockGuardReplay(t *testing.T) {
+	now := time.Unix(1622505600, 0)
+	guard := newTestGuard(t, false, &now)
+	need := big.NewInt(10)
+	sign := &UtxoLockSign{Timestamp: now.Unix(), Nonce: "a"}
+	content := sign.content("xuper", "alice", need, true)
+
+	if !guard.use(sign, content) {
+		t.Fatal("first use should be accepted")
+	}
+	if guard.use(sign, content) {
+		t.Fatal("replayed sign should be rejected")
+	}
+	other := &UtxoLockSign{Timestamp: now.Unix(), Nonce: "b"}
+	if !guard.use(other, other.content("xuper", "alice", need, true)) {
+		t.Fatal("sign with another nonce should be accepted")
+	}
+
+	// 旧格式签名不记录
+	legacy := &UtxoLockSign{}
+	legacyContent := legacy.content("xuper", "alice", need, true)
+	if !guard.use(legacy, legacyContent) || !guard.use(legacy, legacyContent) {
+		t.Fatal("legacy sign should not be recorded")
+	}
+	if len(guard.used) != 2 {
+		t.Errorf("expect 2 used signs, got %d", len(guard.used))
+	}
+}
+
+func TestUtxoLockGuardPrune(t *testing.T) {
+	now := time.Unix(1622505600, 0)
+	guard := newTestGuard(t, false, &now)
+	need := big.NewInt(10)
+	old := &UtxoLockSign{Timestamp: now.Unix(), Nonce: "old"}
+	oldContent := old.content("xuper", "alice", need, true)
+	guard.use(old, oldContent)
+
+	// 过期后下次使用时清理
+	now = now.Add(time.Minute + time.Second)
+	fresh := &UtxoLockSign{Timestamp: now.Unix(), Nonce: "fresh"}
+	if !guard.use(fresh, fresh.content("xuper", "alice", need, true)) {
+		t.Fatal("fresh sign should be accepted")
+	}
+	if _, ok := guard.used[oldContent]; ok || len(guard.used) != 1 {
+		t.Errorf("expired sign should be pruned, used %v", guard.used)
+	}
+	// 过期的签名由checkFresh拒绝，不会因为清理而被重放
+	if err := guard.checkFresh(old); err == nil {
+		t.Error("expired sign should be rejected")
+	}
+
+	// 清理间隔内不重复清理
+	now = now.Add(time.Second)
+	guard.used["expired"] = now.Add(-time.Second)
+	guard.use(&UtxoLockSign{Timestamp: now.Unix(), Nonce: "next"}, "next")
+	if _, ok := guard.used["expired"]; !ok {
+		t.Error("should not prune before pruneAt")
+	}
+}
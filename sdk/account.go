@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+
+	sutils "github.com/xuperchain/xuperos/common/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 账户目录下的文件名，和xchain-cli生成的一致
+const (
+	AddressFile    = "address"
+	PublicKeyFile  = "public.key"
+	PrivateKeyFile = "private.key"
+)
+
+// Account 用于发起交易和签名的地址和密钥，密钥为json格式
+type Account struct {
+	Address    string
+	PublicKey  string
+	PrivateKey string
+}
+
+// Keystore 按名称获取账户，服务可以实现该接口接入自己的密钥管理
+type Keystore interface {
+	Account(name string) (*Account, error)
+}
+
+// DirKeystore 目录形式的keystore，每个子目录是一个账户
+type DirKeystore string
+
+// Account 加载子目录name中的账户
+func (t DirKeystore) Account(name string) (*Account, error) {
+	return LoadAccount(filepath.Join(string(t), name))
+}
+
+// Accounts 按子目录名的顺序加载全部账户
+func (t DirKeystore) Accounts() ([]*Account, error) {
+	dir, err := ioutil.ReadDir(string(t))
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*Account, 0, len(dir))
+	for _, fi := range dir {
+		if !fi.IsDir() {
+			continue
+		}
+		account, err := t.Account(fi.Name())
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
+}
+
+// LoadAccount 从目录加载账户的地址和密钥
+func LoadAccount(dir string) (*Account, error) {
+	address, err := ReadKeyFile(filepath.Join(dir, AddressFile))
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := ReadKeyFile(filepath.Join(dir, PublicKeyFile))
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := ReadKeyFile(filepath.Join(dir, PrivateKeyFile))
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		Address:    address,
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+	}, nil
+}
+
+// ReadKeyFile 读取地址或密钥文件，去掉首尾空白
+func ReadKeyFile(file string) (string, error) {
+	buf, err := ioutil.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimSpace(buf)), nil
+}
+
+// Sign 使用账户私钥对交易签名
+func (c *Client) Sign(account *Account, tx *pb.Transaction) (*pb.SignatureInfo, error) {
+	sign, err := sutils.ComputeTxSign(c.crypto, tx, []byte(account.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignatureInfo{
+		PublicKey: account.PublicKey,
+		Sign:      sign,
+	}, nil
+}
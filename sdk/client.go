@@ -0,0 +1,238 @@
+// Package sdk 节点的go客户端，封装连接管理和交易的构造、签名、提交
+package sdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding"
+	_ "google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+
+	crypto_client "github.com/xuperchain/xupercore/lib/crypto/client"
+	crypto_base "github.com/xuperchain/xupercore/lib/crypto/client/base"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 多节点连接使用的resolver scheme序号，每个客户端独立注册
+var schemeSeq int64
+
+// Config 客户端配置
+type Config struct {
+	// 节点地址，配置多个时按顺序连接第一个可用的节点
+	Hosts []string
+	// 链名
+	Chain string
+	// 密码学类型，和链的配置一致
+	Crypto string
+	// 为空时不加密连接
+	TLS *TLSConfig
+	// grpc消息压缩算法，为空时不压缩，节点需要支持该算法
+	Compress string
+	// 接收消息的大小上限，单位：字节
+	MaxRecvMsgSize int
+	// 等待交易上链时查询交易状态的间隔
+	WaitInterval time.Duration
+	// 需要背书服务合规检查时设置
+	ComplianceCheck *ComplianceCheckConfig
+}
+
+// TLSConfig 连接的TLS配置，同时设置CertFile和KeyFile时使用双向认证
+type TLSConfig struct {
+	// 根证书，为空时使用系统根证书
+	CAFile string
+	// 客户端证书和私钥，为空时不提供客户端证书，节点clientAuth为none时可以不配置
+	CertFile string
+	KeyFile  string
+	// 兼容旧配置的证书目录，未单独配置的文件使用目录下的cert.crt、key.pem和private.key
+	CertDir    string
+	ServerName string
+}
+
+// ComplianceCheckConfig 背书服务合规检查配置
+type ComplianceCheckConfig struct {
+	// 背书服务地址
+	EndorserHost string
+	// 合规检查的费用和收款地址
+	Fee     int64
+	FeeAddr string
+	// 背书服务的地址，需要加入交易的auth require
+	ServiceAddr string
+	// 连接背书服务的TLS配置，和节点连接无关，为空时不加密
+	TLS *TLSConfig
+}
+
+// DefaultConfig 连接本机节点的默认配置
+func DefaultConfig() *Config {
+	return &Config{
+		Hosts:          []string{"127.0.0.1:36301"},
+		Chain:          "xuper",
+		Crypto:         "default",
+		MaxRecvMsgSize: 128 << 20,
+		WaitInterval:   time.Second,
+	}
+}
+
+// Client 节点客户端，可以并发使用
+type Client struct {
+	conf   *Config
+	conn   *grpc.ClientConn
+	crypto crypto_base.CryptoClient
+
+	xclient     pb.XchainClient
+	eventClient pb.EventServiceClient
+}
+
+// NewClient 按配置连接节点，连接在第一次请求时建立
+func NewClient(conf *Config) (*Client, error) {
+	if conf == nil || len(conf.Hosts) == 0 {
+		return nil, errors.New("no host to connect")
+	}
+	if conf.Chain == "" {
+		return nil, errors.New("chain name is required")
+	}
+	if conf.Compress != "" && encoding.GetCompressor(conf.Compress) == nil {
+		return nil, fmt.Errorf("unsupported compressor: %s", conf.Compress)
+	}
+	if conf.WaitInterval <= 0 {
+		conf.WaitInterval = time.Second
+	}
+
+	cryptoClient, err := crypto_client.CreateCryptoClient(conf.Crypto)
+	if err != nil {
+		return nil, fmt.Errorf("create crypto client failed: %v", err)
+	}
+
+	opts, err := DialOptions(conf)
+	if err != nil {
+		return nil, err
+	}
+	target := conf.Hosts[0]
+	if len(conf.Hosts) > 1 {
+		// pick_first按顺序尝试，当前节点不可用时切换到下一个，
+		// 同一个客户端的请求发往同一节点，避免锁定的utxo和提交的交易不在一个节点上
+		r := manual.NewBuilderWithScheme(fmt.Sprintf("xuperos-sdk-%d", atomic.AddInt64(&schemeSeq, 1)))
+		addrs := make([]resolver.Address, 0, len(conf.Hosts))
+		for _, host := range conf.Hosts {
+			addrs = append(addrs, resolver.Address{Addr: host})
+		}
+		r.InitialState(resolver.State{Addresses: addrs})
+		opts = append(opts, grpc.WithResolvers(r))
+		target = r.Scheme() + ":///" + conf.Chain
+	}
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		conf:        conf,
+		conn:        conn,
+		crypto:      cryptoClient,
+		xclient:     pb.NewXchainClient(conn),
+		eventClient: pb.NewEventServiceClient(conn),
+	}, nil
+}
+
+// DialOptions 连接节点的公共参数，指定压缩算法时请求和响应都压缩
+func DialOptions(conf *Config) ([]grpc.DialOption, error) {
+	callOpts := []grpc.CallOption{}
+	if conf.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(conf.MaxRecvMsgSize))
+	}
+	if conf.Compress != "" {
+		callOpts = append(callOpts, grpc.UseCompressor(conf.Compress))
+	}
+	opts := []grpc.DialOption{grpc.WithDefaultCallOptions(callOpts...)}
+
+	if conf.TLS == nil {
+		return append(opts, grpc.WithInsecure()), nil
+	}
+	creds, err := NewTLSCredentials(conf.TLS)
+	if err != nil {
+		return nil, err
+	}
+	return append(opts, grpc.WithTransportCredentials(creds)), nil
+}
+
+// NewTLSCredentials 读取证书生成TLS凭证
+func NewTLSCredentials(conf *TLSConfig) (credentials.TransportCredentials, error) {
+	caFile, certFile, keyFile := conf.CAFile, conf.CertFile, conf.KeyFile
+	if conf.CertDir != "" {
+		if caFile == "" {
+			caFile = filepath.Join(conf.CertDir, "cert.crt")
+		}
+		if certFile == "" && keyFile == "" {
+			certFile = filepath.Join(conf.CertDir, "key.pem")
+			keyFile = filepath.Join(conf.CertDir, "private.key")
+		}
+	}
+
+	tlsConf := &tls.Config{
+		ServerName: conf.ServerName,
+	}
+	if caFile != "" {
+		bs, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+		tlsConf.RootCAs = certPool
+	}
+	if certFile != "" || keyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConf.Certificates = []tls.Certificate{certificate}
+	}
+	return credentials.NewTLS(tlsConf), nil
+}
+
+// Close 关闭连接
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Chain 客户端访问的链名
+func (c *Client) Chain() string {
+	return c.conf.Chain
+}
+
+// Config 客户端配置，不要修改
+func (c *Client) Config() *Config {
+	return c.conf
+}
+
+// Crypto 签名使用的密码学客户端
+func (c *Client) Crypto() crypto_base.CryptoClient {
+	return c.crypto
+}
+
+// Conn 节点连接，用于创建sdk没有封装的其他服务的客户端
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// XchainClient 节点的原始rpc客户端，用于sdk没有封装的接口
+func (c *Client) XchainClient() pb.XchainClient {
+	return c.xclient
+}
+
+// EventClient 事件订阅的rpc客户端
+func (c *Client) EventClient() pb.EventServiceClient {
+	return c.eventClient
+}
@@ -0,0 +1,100 @@
+package sdk
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// 生成自签名证书，写入cert.crt、key.pem和private.key
+func writeTestCert(t *testing.T, dir string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IsCA:         true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	files := map[string][]byte{
+		"cert.crt":    cert,
+		"key.pem":     cert,
+		"private.key": pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestNewTLSCredentials(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sdk-tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestCert(t, dir)
+	empty, err := ioutil.TempDir("", "sdk-tls-empty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(empty)
+
+	cases := []struct {
+		name string
+		conf TLSConfig
+		ok   bool
+	}{
+		{name: "system roots", ok: true},
+		{name: "server only", conf: TLSConfig{CAFile: filepath.Join(dir, "cert.crt")}, ok: true},
+		{name: "cert dir", conf: TLSConfig{CertDir: dir}, ok: true},
+		{name: "mutual", ok: true, conf: TLSConfig{
+			CertFile: filepath.Join(dir, "key.pem"),
+			KeyFile:  filepath.Join(dir, "private.key"),
+		}},
+		{name: "files override cert dir", ok: true, conf: TLSConfig{
+			CertDir: empty,
+			CAFile:  filepath.Join(dir, "cert.crt"),
+			// 单独配置客户端证书时不使用目录下的文件
+			CertFile: filepath.Join(dir, "key.pem"),
+			KeyFile:  filepath.Join(dir, "private.key"),
+		}},
+		{name: "missing ca", conf: TLSConfig{CAFile: filepath.Join(empty, "cert.crt")}},
+		{name: "invalid ca", conf: TLSConfig{CAFile: filepath.Join(dir, "private.key")}},
+		{name: "key only", conf: TLSConfig{KeyFile: filepath.Join(dir, "private.key")}},
+		{name: "empty cert dir", conf: TLSConfig{CertDir: empty}},
+	}
+	for _, c := range cases {
+		c.conf.ServerName = "localhost"
+		creds, err := NewTLSCredentials(&c.conf)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: expect ok %v, got %v", c.name, c.ok, err)
+			continue
+		}
+		if err == nil && creds.Info().ServerName != "localhost" {
+			t.Errorf("%s: unexpected server name %s", c.name, creds.Info().ServerName)
+		}
+	}
+}
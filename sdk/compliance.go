@@ -0,0 +1,230 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+const endorseTimeout = 15 * time.Second
+
+// 合规检查流程：
+// 1. 预执行并选出交易费、合规检查费用和转账需要的utxo
+// 2. 用选出的utxo生成支付合规检查费用的交易，找零转回转出地址
+// 3. 用2的找零生成实际交易，由背书服务检查后签名
+func (c *Client) submitWithComplianceCheck(ctx context.Context, req *Request, authRequire ...*Account) (
+	string, *pb.InvokeResponse, error) {
+	preExeRes, err := c.preExecWithSelectUtxo(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+
+	feeTx, err := c.ComplianceFeeTx(req, c.conf.ComplianceCheck, preExeRes.GetUtxoOutput(), authRequire...)
+	if err != nil {
+		return "", nil, err
+	}
+	tx, err := c.complianceRealTx(req, preExeRes.GetResponse(), feeTx, authRequire...)
+	if err != nil {
+		return "", nil, err
+	}
+	endorserSign, err := c.EndorseTx(ctx, tx, feeTx)
+	if err != nil {
+		return "", nil, err
+	}
+	tx.AuthRequireSigns = append(tx.AuthRequireSigns, endorserSign)
+	tx.Txid = nil
+
+	txid, err := c.PostTx(ctx, tx)
+	return txid, preExeRes.GetResponse(), err
+}
+
+func (c *Client) preExecWithSelectUtxo(ctx context.Context, req *Request) (*pb.PreExecWithSelectUTXOResponse, error) {
+	// 转账、交易费和合规检查费用一起选出
+	_, totalNeed, err := txOutputs(req, 0)
+	if err != nil {
+		return nil, err
+	}
+	extraAmount := c.conf.ComplianceCheck.Fee + totalNeed.Int64()
+
+	preExeReq := &pb.InvokeRPCRequest{
+		Header:      newHeader(),
+		Bcname:      c.conf.Chain,
+		Requests:    req.invokeRequests(),
+		Initiator:   req.initiator(),
+		AuthRequire: c.complianceAuthRequire(req),
+	}
+	preSelUtxoReq := &pb.PreExecWithSelectUTXORequest{
+		Header:      newHeader(),
+		Bcname:      c.conf.Chain,
+		Address:     req.from(),
+		TotalAmount: extraAmount,
+		Request:     preExeReq,
+	}
+	preExeRes, err := c.xclient.PreExecWithSelectUTXO(ctx, preSelUtxoReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := headerError(preExeRes.GetHeader()); err != nil {
+		return nil, err
+	}
+
+	if err := checkFee(req.Fee, preExeRes.GetResponse().GetGasUsed()); err != nil {
+		return nil, err
+	}
+	return preExeRes, nil
+}
+
+// ComplianceFeeTx 用选出的utxo生成支付合规检查费用的交易，找零转回转出地址，
+// check为合规检查配置，客户端没有配置合规检查时也可以单独生成
+func (c *Client) ComplianceFeeTx(req *Request, check *ComplianceCheckConfig, utxoOutput *pb.UtxoOutput,
+	authRequire ...*Account) (*pb.Transaction, error) {
+	if check == nil {
+		return nil, errors.New("compliance check not configured")
+	}
+	from := req.from()
+	totalNeed := big.NewInt(check.Fee)
+	txInputs, deltaTxOutput, err := utxoToInputs(utxoOutput, from, totalNeed)
+	if err != nil {
+		return nil, err
+	}
+	txOutputs, _, err := accountsToOutputs([]*pb.TxDataAccount{
+		{Address: check.FeeAddr, Amount: totalNeed.String()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if deltaTxOutput != nil {
+		txOutputs = append(txOutputs, deltaTxOutput)
+	}
+
+	tx := &pb.Transaction{
+		Desc:        []byte(""),
+		Version:     req.version(),
+		Coinbase:    false,
+		Timestamp:   time.Now().UnixNano(),
+		TxInputs:    txInputs,
+		TxOutputs:   txOutputs,
+		Nonce:       utils.GenNonce(),
+		Initiator:   req.initiator(),
+		AuthRequire: req.authRequire(),
+	}
+	if err := c.SignTx(tx, req.Initiator, authRequire...); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+// 用合规检查交易的找零生成实际交易，auth require中加入背书服务地址
+func (c *Client) complianceRealTx(req *Request, preExeRes *pb.InvokeResponse,
+	feeTx *pb.Transaction, authRequire ...*Account) (*pb.Transaction, error) {
+	from := req.from()
+	utxoOutput := &pb.UtxoOutput{}
+	totalSelected := big.NewInt(0)
+	for index, txOutput := range feeTx.GetTxOutputs() {
+		if string(txOutput.ToAddr) != from {
+			continue
+		}
+		utxoOutput.UtxoList = append(utxoOutput.UtxoList, &pb.Utxo{
+			Amount:    txOutput.Amount,
+			ToAddr:    txOutput.ToAddr,
+			RefTxid:   feeTx.Txid,
+			RefOffset: int32(index),
+		})
+		totalSelected.Add(totalSelected, big.NewInt(0).SetBytes(txOutput.Amount))
+	}
+	utxoOutput.TotalSelected = totalSelected.String()
+
+	outputs, totalNeed, err := txOutputs(req, 0)
+	if err != nil {
+		return nil, err
+	}
+	txInputs, deltaTxOutput, err := utxoToInputs(utxoOutput, from, totalNeed)
+	if err != nil {
+		return nil, err
+	}
+	if deltaTxOutput != nil {
+		outputs = append([]*pb.TxOutput{deltaTxOutput}, outputs...)
+	}
+
+	tx := &pb.Transaction{
+		Desc:      req.desc(),
+		Version:   req.version(),
+		Coinbase:  false,
+		Timestamp: time.Now().UnixNano(),
+		TxInputs:  txInputs,
+		TxOutputs: outputs,
+		Initiator: req.initiator(),
+		Nonce:     utils.GenNonce(),
+	}
+	tx.TxInputs = append(tx.TxInputs, preExeRes.GetUtxoInputs()...)
+	tx.TxOutputs = append(tx.TxOutputs, preExeRes.GetUtxoOutputs()...)
+	tx.TxInputsExt = preExeRes.GetInputs()
+	tx.TxOutputsExt = preExeRes.GetOutputs()
+	tx.ContractRequests = preExeRes.GetRequests()
+	tx.AuthRequire = c.complianceAuthRequire(req)
+
+	if err := c.SignTx(tx, req.Initiator, authRequire...); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func (c *Client) complianceAuthRequire(req *Request) []string {
+	authRequire := append([]string{}, req.authRequire()...)
+	return append(authRequire, c.conf.ComplianceCheck.ServiceAddr)
+}
+
+// EndorseTx 请求背书服务检查交易并签名，feeTx为支付合规检查费用的交易
+func (c *Client) EndorseTx(ctx context.Context, tx, feeTx *pb.Transaction) (*pb.SignatureInfo, error) {
+	check := c.conf.ComplianceCheck
+	if check == nil {
+		return nil, errors.New("compliance check not configured")
+	}
+	requestData, err := json.Marshal(&pb.TxStatus{
+		Bcname: c.conf.Chain,
+		Tx:     tx,
+	})
+	if err != nil {
+		return nil, err
+	}
+	endorserReq := &pb.EndorserRequest{
+		Header:      newHeader(),
+		RequestName: "ComplianceCheck",
+		BcName:      c.conf.Chain,
+		Fee:         feeTx,
+		RequestData: requestData,
+	}
+
+	// 背书服务单独配置TLS，不一定支持压缩
+	opts, err := DialOptions(&Config{
+		TLS:            check.TLS,
+		MaxRecvMsgSize: c.conf.MaxRecvMsgSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.Dial(check.EndorserHost, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(ctx, endorseTimeout)
+	defer cancel()
+
+	endorserRes, err := pb.NewXendorserClient(conn).EndorserCall(ctx, endorserReq)
+	if err != nil {
+		return nil, err
+	}
+	if err := headerError(endorserRes.GetHeader()); err != nil {
+		return nil, err
+	}
+	return endorserRes.GetEndorserSign(), nil
+}
@@ -0,0 +1,201 @@
+package sdk
+
+import (
+	"encoding/json"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+const (
+	defaultDesc = "Maybe common transfer transaction"
+
+	kernelModule = "xkernel"
+)
+
+// Request 一笔交易的内容，转账、合约调用和部署都由Request描述
+type Request struct {
+	// 交易发起者，负责签名和锁定utxo
+	Initiator *Account
+	// 合约账户，设置时从合约账户转出，auth require为 合约账户/发起者地址
+	ContractAccount string
+	// 交易的initiator使用发起者地址而不是合约账户，合约账户需要多个地址签名时设置
+	AKInitiator bool
+
+	To           string
+	Amount       string
+	FrozenHeight int64
+	// 额外的转账输出，一笔交易转给多个地址时使用
+	Outputs []*pb.TxDataAccount
+	Fee     string
+	Desc    []byte
+	// 为0时使用当前交易版本
+	Version int32
+
+	// 合约调用，调用非系统合约且To为合约名时，Amount转给合约
+	Invokes []*pb.InvokeRequest
+	// 多签时需要签名的地址，为空时只需要发起者签名
+	AuthRequire []string
+
+	// 选择utxo时锁定，避免并发构造的交易选中同一utxo，需要发起者签名，对合约账户无效
+	LockUtxo bool
+	// 按数量选取转出地址的零散utxo，扣除转账和交易费后全部转回转出地址
+	MergeUtxo bool
+}
+
+// NewTransfer 普通转账
+func NewTransfer(from *Account, to, amount string) *Request {
+	return &Request{
+		Initiator: from,
+		To:        to,
+		Amount:    amount,
+	}
+}
+
+// NewInvoke 调用合约，args为合约方法的参数
+func NewInvoke(from *Account, module, contract, method string, args map[string][]byte) *Request {
+	return &Request{
+		Initiator: from,
+		Invokes: []*pb.InvokeRequest{
+			{
+				ModuleName:   module,
+				ContractName: contract,
+				MethodName:   method,
+				Args:         args,
+			},
+		},
+	}
+}
+
+// Deploy 部署合约的参数
+type Deploy struct {
+	// 部署合约的合约账户
+	Account  string
+	Name     string
+	Code     []byte
+	Desc     *pb.WasmCodeDesc
+	InitArgs map[string][]byte
+	// evm合约的abi
+	Abi []byte
+}
+
+// NewDeploy 部署合约，由合约账户发起
+func NewDeploy(from *Account, deploy *Deploy) (*Request, error) {
+	desc, err := proto.Marshal(deploy.Desc)
+	if err != nil {
+		return nil, err
+	}
+	initArgs, err := json.Marshal(deploy.InitArgs)
+	if err != nil {
+		return nil, err
+	}
+	args := map[string][]byte{
+		"account_name":  []byte(deploy.Account),
+		"contract_name": []byte(deploy.Name),
+		"contract_code": deploy.Code,
+		"contract_desc": desc,
+		"init_args":     initArgs,
+		"contract_abi":  deploy.Abi,
+	}
+	req := NewInvoke(from, kernelModule, "$contract", "deployContract", args)
+	req.ContractAccount = deploy.Account
+	return req, nil
+}
+
+// WithFee 设置交易费，合约调用的交易费不能少于消耗的gas
+func (r *Request) WithFee(fee string) *Request {
+	r.Fee = fee
+	return r
+}
+
+// WithDesc 设置交易描述
+func (r *Request) WithDesc(desc []byte) *Request {
+	r.Desc = desc
+	return r
+}
+
+// WithContractAccount 设置转出的合约账户
+func (r *Request) WithContractAccount(account string) *Request {
+	r.ContractAccount = account
+	return r
+}
+
+// WithLockUtxo 选择utxo时锁定
+func (r *Request) WithLockUtxo() *Request {
+	r.LockUtxo = true
+	return r
+}
+
+// WithAKInitiator 交易的initiator使用发起者地址
+func (r *Request) WithAKInitiator() *Request {
+	r.AKInitiator = true
+	return r
+}
+
+// WithAuthRequire 设置多签地址
+func (r *Request) WithAuthRequire(addrs []string) *Request {
+	r.AuthRequire = addrs
+	return r
+}
+
+// WithFrozenHeight 设置转账的冻结高度
+func (r *Request) WithFrozenHeight(height int64) *Request {
+	r.FrozenHeight = height
+	return r
+}
+
+// 转出地址，合约账户或者发起者地址
+func (r *Request) from() string {
+	if r.ContractAccount != "" {
+		return r.ContractAccount
+	}
+	return r.Initiator.Address
+}
+
+// 交易的initiator，默认和转出地址一致
+func (r *Request) initiator() string {
+	if r.AKInitiator {
+		return r.Initiator.Address
+	}
+	return r.from()
+}
+
+func (r *Request) authRequire() []string {
+	if len(r.AuthRequire) > 0 {
+		return r.AuthRequire
+	}
+	if r.ContractAccount != "" {
+		return []string{r.ContractAccount + "/" + r.Initiator.Address}
+	}
+	return []string{r.Initiator.Address}
+}
+
+func (r *Request) invokeRequests() []*pb.InvokeRequest {
+	reqs := make([]*pb.InvokeRequest, 0, len(r.Invokes))
+	for _, invoke := range r.Invokes {
+		req := proto.Clone(invoke).(*pb.InvokeRequest)
+		// 转账给合约
+		if req.GetModuleName() != kernelModule && r.To != "" && r.To == req.GetContractName() {
+			req.Amount = r.Amount
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs
+}
+
+func (r *Request) desc() []byte {
+	if len(r.Desc) == 0 {
+		return []byte(defaultDesc)
+	}
+	return r.Desc
+}
+
+func (r *Request) version() int32 {
+	if r.Version == 0 {
+		return utxo.TxVersion
+	}
+	return r.Version
+}
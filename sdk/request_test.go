@@ -0,0 +1,141 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+func TestRequestAddresses(t *testing.T) {
+	alice := &Account{Address: "alice"}
+	account := "XC1111111111111111@xuper"
+	cases := []struct {
+		name        string
+		req         *Request
+		from        string
+		initiator   string
+		authRequire []string
+	}{
+		{
+			name:        "transfer",
+			req:         NewTransfer(alice, "bob", "1"),
+			from:        "alice",
+			initiator:   "alice",
+			authRequire: []string{"alice"},
+		},
+		{
+			name:        "contract account",
+			req:         NewTransfer(alice, "bob", "1").WithContractAccount(account),
+			from:        account,
+			initiator:   account,
+			authRequire: []string{account + "/alice"},
+		},
+		{
+			name:        "contract account with ak initiator",
+			req:         NewTransfer(alice, "bob", "1").WithContractAccount(account).WithAKInitiator(),
+			from:        account,
+			initiator:   "alice",
+			authRequire: []string{account + "/alice"},
+		},
+		{
+			name: "multisig",
+			req: NewTransfer(alice, "bob", "1").WithContractAccount(account).WithAKInitiator().
+				WithAuthRequire([]string{account + "/alice", account + "/carol"}),
+			from:        account,
+			initiator:   "alice",
+			authRequire: []string{account + "/alice", account + "/carol"},
+		},
+	}
+	for _, c := range cases {
+		if got := c.req.from(); got != c.from {
+			t.Errorf("%s: from %s, expect %s", c.name, got, c.from)
+		}
+		if got := c.req.initiator(); got != c.initiator {
+			t.Errorf("%s: initiator %s, expect %s", c.name, got, c.initiator)
+		}
+		if got := c.req.authRequire(); !reflect.DeepEqual(got, c.authRequire) {
+			t.Errorf("%s: auth require %v, expect %v", c.name, got, c.authRequire)
+		}
+	}
+}
+
+func TestRequestInvokeRequests(t *testing.T) {
+	alice := &Account{Address: "alice"}
+	req := NewInvoke(alice, "wasm", "counter", "increase", nil)
+	req.To = "counter"
+	req.Amount = "10"
+	reqs := req.invokeRequests()
+	if len(reqs) != 1 || reqs[0].Amount != "10" {
+		t.Fatalf("amount should be transferred to the contract: %v", reqs)
+	}
+	// 不修改调用方的请求
+	if req.Invokes[0].Amount != "" {
+		t.Error("invoke request of the caller is modified")
+	}
+
+	// 系统合约不转账
+	deploy, err := NewDeploy(alice, &Deploy{Account: "XC1111111111111111@xuper", Name: "counter", Desc: &pb.WasmCodeDesc{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deploy.To = "counter"
+	deploy.Amount = "10"
+	if reqs := deploy.invokeRequests(); reqs[0].Amount != "" {
+		t.Errorf("amount should not be transferred to kernel contract: %v", reqs[0])
+	}
+	if deploy.ContractAccount != "XC1111111111111111@xuper" {
+		t.Errorf("deploy should be from the contract account, got %s", deploy.ContractAccount)
+	}
+}
+
+func TestRequestDefaults(t *testing.T) {
+	req := NewTransfer(&Account{Address: "alice"}, "bob", "1")
+	if string(req.desc()) != defaultDesc {
+		t.Errorf("default desc %s", req.desc())
+	}
+	if req.version() != utxo.TxVersion {
+		t.Errorf("default version %d", req.version())
+	}
+	req.WithDesc([]byte("memo")).Version = 3
+	if string(req.desc()) != "memo" || req.version() != 3 {
+		t.Errorf("desc %s version %d", req.desc(), req.version())
+	}
+	if req.LockUtxo {
+		t.Error("utxo should not be locked by default")
+	}
+}
+
+func TestTxOutputs(t *testing.T) {
+	req := NewTransfer(&Account{Address: "alice"}, "bob", "10").WithFee("5")
+	req.Outputs = []*pb.TxDataAccount{
+		{Address: "carol", Amount: "3"},
+		{Address: "dave", Amount: "0"},
+	}
+	outputs, totalNeed, err := txOutputs(req, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if totalNeed.String() != "18" {
+		t.Errorf("total need %s, expect 18", totalNeed)
+	}
+	// 0的输出被去掉，交易费在最后
+	if len(outputs) != 3 || string(outputs[0].ToAddr) != "carol" ||
+		string(outputs[1].ToAddr) != "bob" || string(outputs[2].ToAddr) != utxo.FeePlaceholder {
+		t.Errorf("unexpected outputs %v", outputs)
+	}
+	if len(req.Outputs) != 2 {
+		t.Error("outputs of the request are modified")
+	}
+
+	req.Amount = "-1"
+	if _, _, err := txOutputs(req, 0); err != ErrNegativeAmount {
+		t.Errorf("expect ErrNegativeAmount, got %v", err)
+	}
+	req.Amount = "abc"
+	if _, _, err := txOutputs(req, 0); err != ErrInvalidAmount {
+		t.Errorf("expect ErrInvalidAmount, got %v", err)
+	}
+}
@@ -0,0 +1,373 @@
+package sdk
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo"
+	"github.com/xuperchain/xupercore/kernel/contract"
+	cryptoHash "github.com/xuperchain/xupercore/lib/crypto/hash"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	sutils "github.com/xuperchain/xuperos/common/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+var (
+	// ErrInvalidAmount error
+	ErrInvalidAmount = errors.New("Invalid amount number")
+	// ErrNegativeAmount error
+	ErrNegativeAmount = errors.New("Amount in transaction can not be negative number")
+	// ErrSelectUtxo error
+	ErrSelectUtxo = errors.New("Select utxo error")
+	// ErrFeeNotEnough 交易费少于合约消耗的gas
+	ErrFeeNotEnough = errors.New("Fee not enough")
+	// ErrNeedFee 合约消耗了gas但没有设置交易费
+	ErrNeedFee = errors.New("You need add fee")
+	// ErrTxFurcation 交易所在的区块不在主干上
+	ErrTxFurcation = errors.New("tx is on the furcation")
+)
+
+// 业务错误在header中返回，rpc错误直接返回
+func headerError(header *pb.Header) error {
+	if header.GetError() != pb.XChainErrorEnum_SUCCESS {
+		return fmt.Errorf("%s, logid:%s", header.GetError(), header.GetLogid())
+	}
+	return nil
+}
+
+func newHeader() *pb.Header {
+	return &pb.Header{
+		Logid: utils.GenLogId(),
+	}
+}
+
+// PreExec 预执行合约调用，合约返回错误状态时返回error
+func (c *Client) PreExec(ctx context.Context, req *Request) (*pb.InvokeResponse, error) {
+	preExeReq := &pb.InvokeRPCRequest{
+		Header:      newHeader(),
+		Bcname:      c.conf.Chain,
+		Requests:    req.invokeRequests(),
+		Initiator:   req.initiator(),
+		AuthRequire: req.authRequire(),
+	}
+	preExeRes, err := c.xclient.PreExec(ctx, preExeReq)
+	if err != nil {
+		return nil, fmt.Errorf("PreExe contract response : %v, logid:%s", err, preExeReq.Header.Logid)
+	}
+	if err := headerError(preExeRes.GetHeader()); err != nil {
+		return nil, err
+	}
+	for _, res := range preExeRes.GetResponse().GetResponses() {
+		if res.Status >= contract.StatusErrorThreshold {
+			return nil, fmt.Errorf("contract error status:%d message:%s", res.Status, res.Message)
+		}
+	}
+	return preExeRes.GetResponse(), nil
+}
+
+// BuildTx 预执行并组装未签名的交易，用于离线签名或者多签，设置了LockUtxo时锁定选出的utxo
+func (c *Client) BuildTx(ctx context.Context, req *Request) (*pb.Transaction, *pb.InvokeResponse, error) {
+	preExeRes, err := c.PreExec(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := &pb.Transaction{
+		Desc:      req.desc(),
+		Coinbase:  false,
+		Nonce:     utils.GenNonce(),
+		Timestamp: time.Now().UnixNano(),
+		Version:   req.version(),
+		Initiator: req.initiator(),
+	}
+	tx.TxInputs = append(tx.TxInputs, preExeRes.GetUtxoInputs()...)
+	tx.TxOutputs = append(tx.TxOutputs, preExeRes.GetUtxoOutputs()...)
+
+	txOutputs, totalNeed, err := txOutputs(req, preExeRes.GetGasUsed())
+	if err != nil {
+		return nil, nil, err
+	}
+	tx.TxOutputs = append(tx.TxOutputs, txOutputs...)
+
+	txInputs, deltaTxOutput, err := c.SelectUtxo(ctx, req, totalNeed)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx.TxInputs = append(tx.TxInputs, txInputs...)
+	if deltaTxOutput != nil {
+		tx.TxOutputs = append(tx.TxOutputs, deltaTxOutput)
+	}
+
+	// 填充contract预执行结果
+	tx.TxInputsExt = preExeRes.GetInputs()
+	tx.TxOutputsExt = preExeRes.GetOutputs()
+	tx.ContractRequests = preExeRes.GetRequests()
+	tx.AuthRequire = req.authRequire()
+	return tx, preExeRes, nil
+}
+
+// 转账和交易费的输出，合约消耗gas时交易费不能少于gas
+func txOutputs(req *Request, gasUsed int64) ([]*pb.TxOutput, *big.Int, error) {
+	accounts := append([]*pb.TxDataAccount{}, req.Outputs...)
+	if req.To != "" {
+		accounts = append(accounts, &pb.TxDataAccount{
+			Address:      req.To,
+			Amount:       req.Amount,
+			FrozenHeight: req.FrozenHeight,
+		})
+	}
+	if err := checkFee(req.Fee, gasUsed); err != nil {
+		return nil, nil, err
+	}
+	if req.Fee != "" && req.Fee != "0" {
+		accounts = append(accounts, &pb.TxDataAccount{
+			Address: utxo.FeePlaceholder,
+			Amount:  req.Fee,
+		})
+	}
+
+	return accountsToOutputs(accounts)
+}
+
+// 合约消耗gas时交易费不能少于gas，错误中带上gas方便调整交易费
+func checkFee(fee string, gasUsed int64) error {
+	if gasUsed <= 0 {
+		return nil
+	}
+	if fee == "" || fee == "0" {
+		return fmt.Errorf("%w, gas used: %d", ErrNeedFee, gasUsed)
+	}
+	amount, err := strconv.ParseInt(fee, 10, 64)
+	if err != nil {
+		return ErrInvalidAmount
+	}
+	if amount < gasUsed {
+		return fmt.Errorf("%w, gas used: %d", ErrFeeNotEnough, gasUsed)
+	}
+	return nil
+}
+
+func accountsToOutputs(accounts []*pb.TxDataAccount) ([]*pb.TxOutput, *big.Int, error) {
+	bigZero := big.NewInt(0)
+	totalNeed := big.NewInt(0)
+	txOutputs := []*pb.TxOutput{}
+	for _, acc := range accounts {
+		amount, ok := big.NewInt(0).SetString(acc.Amount, 10)
+		if !ok {
+			return nil, nil, ErrInvalidAmount
+		}
+		cmpRes := amount.Cmp(bigZero)
+		if cmpRes < 0 {
+			return nil, nil, ErrNegativeAmount
+		} else if cmpRes == 0 {
+			// trim 0 output
+			continue
+		}
+		totalNeed.Add(totalNeed, amount)
+		txOutputs = append(txOutputs, &pb.TxOutput{
+			ToAddr:       []byte(acc.Address),
+			Amount:       amount.Bytes(),
+			FrozenHeight: acc.FrozenHeight,
+		})
+	}
+	return txOutputs, totalNeed, nil
+}
+
+// SelectUtxo 选择转出地址的utxo并转为交易输入，多出的部分转回转出地址，
+// 设置了MergeUtxo时按数量选取，设置了LockUtxo时锁定选出的utxo
+func (c *Client) SelectUtxo(ctx context.Context, req *Request, totalNeed *big.Int) (
+	[]*pb.TxInput, *pb.TxOutput, error) {
+	utxoInput := &pb.UtxoInput{
+		Header:    newHeader(),
+		Bcname:    c.conf.Chain,
+		Address:   req.from(),
+		TotalNeed: totalNeed.String(),
+		NeedLock:  req.LockUtxo,
+	}
+	if req.MergeUtxo {
+		// 按数量选取时节点按0校验锁定签名
+		utxoInput.TotalNeed = "0"
+	}
+	if req.LockUtxo {
+		utxoInput.Publickey = req.Initiator.PublicKey
+		utxoInput.Timestamp = time.Now().Unix()
+		utxoInput.Nonce = utils.GenNonce()
+		sign, err := c.selectUtxoSign(req.Initiator, utxoInput)
+		if err != nil {
+			return nil, nil, err
+		}
+		utxoInput.UserSign = sign
+	}
+
+	var utxoOutput *pb.UtxoOutput
+	var err error
+	if req.MergeUtxo {
+		utxoOutput, err = c.xclient.SelectUTXOBySize(ctx, utxoInput)
+	} else {
+		utxoOutput, err = c.xclient.SelectUTXO(ctx, utxoInput)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, err)
+	}
+	if err := headerError(utxoOutput.GetHeader()); err != nil {
+		return nil, nil, fmt.Errorf("%v, details:%v", ErrSelectUtxo, err)
+	}
+	return utxoToInputs(utxoOutput, req.from(), totalNeed)
+}
+
+// utxo转为交易输入，选出的总额大于需要的数额时生成转回from的输出
+func utxoToInputs(utxoOutput *pb.UtxoOutput, from string, totalNeed *big.Int) (
+	[]*pb.TxInput, *pb.TxOutput, error) {
+	var txInputs []*pb.TxInput
+	for _, utxo := range utxoOutput.GetUtxoList() {
+		txInputs = append(txInputs, &pb.TxInput{
+			RefTxid:   utxo.RefTxid,
+			RefOffset: utxo.RefOffset,
+			FromAddr:  utxo.ToAddr,
+			Amount:    utxo.Amount,
+		})
+	}
+
+	utxoTotal, ok := big.NewInt(0).SetString(utxoOutput.GetTotalSelected(), 10)
+	if !ok {
+		return nil, nil, ErrSelectUtxo
+	}
+	if utxoTotal.Cmp(totalNeed) < 0 {
+		return nil, nil, fmt.Errorf("%v, selected %s less than need %s", ErrSelectUtxo, utxoTotal, totalNeed)
+	}
+	var txOutput *pb.TxOutput
+	if utxoTotal.Cmp(totalNeed) > 0 {
+		delta := utxoTotal.Sub(utxoTotal, totalNeed)
+		txOutput = &pb.TxOutput{
+			ToAddr: []byte(from),
+			Amount: delta.Bytes(),
+		}
+	}
+	return txInputs, txOutput, nil
+}
+
+// 锁定utxo的签名，时间戳和随机数防止签名被重放
+func (c *Client) selectUtxoSign(account *Account, in *pb.UtxoInput) ([]byte, error) {
+	privateKey, err := c.crypto.GetEcdsaPrivateKeyFromJsonStr(account.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	hashStr := in.GetBcname() + in.GetAddress() + in.GetTotalNeed() + strconv.FormatBool(in.GetNeedLock()) +
+		strconv.FormatInt(in.GetTimestamp(), 10) + in.GetNonce()
+	return c.crypto.SignECDSA(privateKey, cryptoHash.DoubleSha256([]byte(hashStr)))
+}
+
+// SignTx 发起者签名并生成txid，authRequire为空时使用发起者的签名作为auth require签名
+func (c *Client) SignTx(tx *pb.Transaction, initiator *Account, authRequire ...*Account) error {
+	initSign, err := c.Sign(initiator, tx)
+	if err != nil {
+		return err
+	}
+	tx.InitiatorSigns = []*pb.SignatureInfo{initSign}
+
+	tx.AuthRequireSigns = nil
+	if len(authRequire) == 0 {
+		tx.AuthRequireSigns = append(tx.AuthRequireSigns, initSign)
+	}
+	for _, account := range authRequire {
+		sign, err := c.Sign(account, tx)
+		if err != nil {
+			return err
+		}
+		tx.AuthRequireSigns = append(tx.AuthRequireSigns, sign)
+	}
+
+	tx.Txid, err = sutils.MakeTxId(tx)
+	if err != nil {
+		return fmt.Errorf("make txid failed: %v", err)
+	}
+	return nil
+}
+
+// PostTx 提交签名完成的交易，返回16进制的txid
+func (c *Client) PostTx(ctx context.Context, tx *pb.Transaction) (string, error) {
+	if len(tx.Txid) == 0 {
+		txid, err := sutils.MakeTxId(tx)
+		if err != nil {
+			return "", fmt.Errorf("make txid failed: %v", err)
+		}
+		tx.Txid = txid
+	}
+	txStatus := &pb.TxStatus{
+		Header: newHeader(),
+		Bcname: c.conf.Chain,
+		Status: pb.TransactionStatus_UNCONFIRM,
+		Tx:     tx,
+		Txid:   tx.Txid,
+	}
+	reply, err := c.xclient.PostTx(ctx, txStatus)
+	if err != nil {
+		return "", err
+	}
+	if reply.GetHeader().GetError() != pb.XChainErrorEnum_SUCCESS {
+		return "", fmt.Errorf("Failed to post tx:%s, logid:%s", reply.GetHeader().GetError(),
+			reply.GetHeader().GetLogid())
+	}
+	return hex.EncodeToString(tx.Txid), nil
+}
+
+// WaitTx 等待交易上链，交易还没有同步到节点时继续等待，直到ctx结束
+func (c *Client) WaitTx(ctx context.Context, txid string) (*pb.Transaction, error) {
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid txid: %v", err)
+	}
+
+	ticker := time.NewTicker(c.conf.WaitInterval)
+	defer ticker.Stop()
+	for {
+		txStatus, err := c.xclient.QueryTx(ctx, &pb.TxStatus{
+			Header: newHeader(),
+			Bcname: c.conf.Chain,
+			Txid:   rawTxid,
+		})
+		if err != nil && status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		switch txStatus.GetStatus() {
+		case pb.TransactionStatus_CONFIRM:
+			return txStatus.GetTx(), nil
+		case pb.TransactionStatus_FURCATION:
+			return nil, ErrTxFurcation
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Submit 构造、签名并提交交易，authRequire为多签时其他地址的账户，
+// 只有设置了LockUtxo时才锁定utxo，配置了合规检查时先由背书服务签名
+func (c *Client) Submit(ctx context.Context, req *Request, authRequire ...*Account) (
+	string, *pb.InvokeResponse, error) {
+	if c.conf.ComplianceCheck != nil {
+		return c.submitWithComplianceCheck(ctx, req, authRequire...)
+	}
+
+	tx, preExeRes, err := c.BuildTx(ctx, req)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := c.SignTx(tx, req.Initiator, authRequire...); err != nil {
+		return "", nil, err
+	}
+	txid, err := c.PostTx(ctx, tx)
+	return txid, preExeRes, err
+}
@@ -0,0 +1,73 @@
+package sdk
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+func TestCheckFee(t *testing.T) {
+	cases := []struct {
+		fee     string
+		gasUsed int64
+		err     error
+	}{
+		{fee: "", gasUsed: 0},
+		{fee: "0", gasUsed: 0},
+		{fee: "", gasUsed: 10, err: ErrNeedFee},
+		{fee: "0", gasUsed: 10, err: ErrNeedFee},
+		{fee: "9", gasUsed: 10, err: ErrFeeNotEnough},
+		{fee: "10", gasUsed: 10},
+		{fee: "abc", gasUsed: 10, err: ErrInvalidAmount},
+	}
+	for _, c := range cases {
+		err := checkFee(c.fee, c.gasUsed)
+		if !errors.Is(err, c.err) {
+			t.Errorf("fee %q gas %d: expect %v, got %v", c.fee, c.gasUsed, c.err, err)
+		}
+	}
+	// 错误中带上gas
+	if err := checkFee("9", 10); !strings.Contains(err.Error(), "gas used: 10") {
+		t.Errorf("gas used should be in the error: %v", err)
+	}
+}
+
+func TestUtxoToInputs(t *testing.T) {
+	utxoOutput := &pb.UtxoOutput{
+		UtxoList: []*pb.Utxo{
+			{RefTxid: []byte("tx1"), ToAddr: []byte("alice"), Amount: big.NewInt(6).Bytes()},
+			{RefTxid: []byte("tx2"), RefOffset: 1, ToAddr: []byte("alice"), Amount: big.NewInt(5).Bytes()},
+		},
+		TotalSelected: "11",
+	}
+
+	inputs, change, err := utxoToInputs(utxoOutput, "alice", big.NewInt(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(inputs) != 2 || string(inputs[1].RefTxid) != "tx2" || inputs[1].RefOffset != 1 ||
+		string(inputs[1].FromAddr) != "alice" {
+		t.Errorf("unexpected inputs %v", inputs)
+	}
+	if change == nil || string(change.ToAddr) != "alice" || big.NewInt(0).SetBytes(change.Amount).Int64() != 3 {
+		t.Errorf("unexpected change %v", change)
+	}
+
+	// 正好够时没有找零
+	if _, change, err := utxoToInputs(utxoOutput, "alice", big.NewInt(11)); err != nil || change != nil {
+		t.Errorf("expect no change, got %v %v", change, err)
+	}
+
+	// 选出的不够
+	if _, _, err := utxoToInputs(utxoOutput, "alice", big.NewInt(12)); err == nil {
+		t.Error("expect error when selected utxo is not enough")
+	}
+
+	utxoOutput.TotalSelected = ""
+	if _, _, err := utxoToInputs(utxoOutput, "alice", big.NewInt(1)); err != ErrSelectUtxo {
+		t.Errorf("expect ErrSelectUtxo, got %v", err)
+	}
+}
@@ -8,48 +8,9 @@ import (
 
 	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/models"
 )
 
-// 为了完全兼容老版本pb结构，转换交易结构
-func TxToXledger(tx *pb.Transaction) *xldgpb.Transaction {
-	if tx == nil {
-		return nil
-	}
-
-	prtBuf, err := proto.Marshal(tx)
-	if err != nil {
-		return nil
-	}
-
-	var newTx xldgpb.Transaction
-	err = proto.Unmarshal(prtBuf, &newTx)
-	if err != nil {
-		return nil
-	}
-
-	return &newTx
-}
-
-// 为了完全兼容老版本pb结构，转换交易结构
-func TxToXchain(tx *xldgpb.Transaction) *pb.Transaction {
-	if tx == nil {
-		return nil
-	}
-
-	prtBuf, err := proto.Marshal(tx)
-	if err != nil {
-		return nil
-	}
-
-	var newTx pb.Transaction
-	err = proto.Unmarshal(prtBuf, &newTx)
-	if err != nil {
-		return nil
-	}
-
-	return &newTx
-}
-
 // 为了完全兼容老版本pb结构，转换区块结构
 func BlockToXledger(block *pb.InternalBlock) *xldgpb.InternalBlock {
 	if block == nil {
@@ -351,11 +312,15 @@ func UtxoMetaToXchain(meta *xldgpb.UtxoMeta) *pb.UtxoMeta {
 	return &tmp
 }
 
-func ConvertEventSubType(typ pb.SubscribeType) protos.SubscribeType {
-	switch typ {
-	case pb.SubscribeType_BLOCK:
-		return protos.SubscribeType_BLOCK
+func TxCheckFailuresToXchain(failures []*models.TxCheckFailure) []*pb.TxCheckFailure {
+	tmpList := make([]*pb.TxCheckFailure, 0, len(failures))
+	for _, failure := range failures {
+		tmpList = append(tmpList, &pb.TxCheckFailure{
+			Check:  pb.TxCheckType(failure.Check),
+			Index:  int32(failure.Index),
+			Reason: failure.Reason,
+		})
 	}
 
-	return protos.SubscribeType_BLOCK
+	return tmpList
 }
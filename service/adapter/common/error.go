@@ -1,28 +1,45 @@
 package common
 
 import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 )
 
+const (
+	// ErrorDomain grpc错误详情ErrorInfo中的错误域
+	ErrorDomain = "xuperos"
+	// MetadataNextHeight 流式接口中断时ErrorInfo中继续拉取的高度
+	MetadataNextHeight = "next_height"
+)
+
 // 错误映射配置
 var StdErrToXchainErrMap = map[int]pb.XChainErrorEnum{
 	ecom.ErrSuccess.Code:                  pb.XChainErrorEnum_SUCCESS,
 	ecom.ErrInternal.Code:                 pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrUnknown.Code:                  pb.XChainErrorEnum_UNKNOW_ERROR,
-	ecom.ErrForbidden.Code:                pb.XChainErrorEnum_CONNECT_REFUSE,
-	ecom.ErrUnauthorized.Code:             pb.XChainErrorEnum_CONNECT_REFUSE,
-	ecom.ErrParameter.Code:                pb.XChainErrorEnum_CONNECT_REFUSE,
+	ecom.ErrForbidden.Code:                pb.XChainErrorEnum_SERVICE_REFUSED_ERROR,
+	ecom.ErrUnauthorized.Code:             pb.XChainErrorEnum_UTXO_SIGN_ERROR,
+	ecom.ErrParameter.Code:                pb.XChainErrorEnum_VALIDATE_ERROR,
 	ecom.ErrNewEngineCtxFailed.Code:       pb.XChainErrorEnum_UNKNOW_ERROR,
-	ecom.ErrNotEngineType.Code:            pb.XChainErrorEnum_CONNECT_REFUSE,
+	ecom.ErrNotEngineType.Code:            pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrLoadEngConfFailed.Code:        pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrNewLogFailed.Code:             pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrNewChainCtxFailed.Code:        pb.XChainErrorEnum_UNKNOW_ERROR,
-	ecom.ErrChainExist.Code:               pb.XChainErrorEnum_CONNECT_REFUSE,
+	ecom.ErrChainExist.Code:               pb.XChainErrorEnum_ROOT_BLOCK_EXIST_ERROR,
 	ecom.ErrChainNotExist.Code:            pb.XChainErrorEnum_BLOCKCHAIN_NOTEXIST,
-	ecom.ErrChainAlreadyExist.Code:        pb.XChainErrorEnum_CONNECT_REFUSE,
+	ecom.ErrChainAlreadyExist.Code:        pb.XChainErrorEnum_ROOT_BLOCK_EXIST_ERROR,
 	ecom.ErrChainStatus.Code:              pb.XChainErrorEnum_NOT_READY_ERROR,
-	ecom.ErrRootChainNotExist.Code:        pb.XChainErrorEnum_CONNECT_REFUSE,
+	ecom.ErrRootChainNotExist.Code:        pb.XChainErrorEnum_BLOCKCHAIN_NOTEXIST,
 	ecom.ErrLoadChainFailed.Code:          pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrContractNewCtxFailed.Code:     pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrContractInvokeFailed.Code:     pb.XChainErrorEnum_UNKNOW_ERROR,
@@ -32,10 +49,225 @@ var StdErrToXchainErrMap = map[int]pb.XChainErrorEnum{
 	ecom.ErrTxNotExist.Code:               pb.XChainErrorEnum_TX_NOT_FOUND_ERROR,
 	ecom.ErrTxNotEnough.Code:              pb.XChainErrorEnum_NOT_ENOUGH_UTXO_ERROR,
 	ecom.ErrSubmitTxFailed.Code:           pb.XChainErrorEnum_UNKNOW_ERROR,
-	ecom.ErrBlockNotExist.Code:            pb.XChainErrorEnum_BLOCK_EXIST_ERROR,
+	ecom.ErrGenerateTimerTxFailed.Code:    pb.XChainErrorEnum_UNKNOW_ERROR,
+	ecom.ErrBlockNotExist.Code:            pb.XChainErrorEnum_BLOCK_NOT_FOUND_ERROR,
 	ecom.ErrProcBlockFailed.Code:          pb.XChainErrorEnum_UNKNOW_ERROR,
+	ecom.ErrGenesisBlockDiff.Code:         pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrNewNetEventFailed.Code:        pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrNewNetworkFailed.Code:         pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrSendMessageFailed.Code:        pb.XChainErrorEnum_UNKNOW_ERROR,
 	ecom.ErrNetworkNoResponse.Code:        pb.XChainErrorEnum_UNKNOW_ERROR,
+	ecom.ErrConsensusStatus.Code:          pb.XChainErrorEnum_NOT_READY_ERROR,
+}
+
+// 标准错误对应的grpc状态码，未配置的按错误分类处理
+var StdErrToGrpcCodeMap = map[int]codes.Code{
+	ecom.ErrSuccess.Code:              codes.OK,
+	ecom.ErrUnknown.Code:              codes.Unknown,
+	ecom.ErrForbidden.Code:            codes.PermissionDenied,
+	ecom.ErrUnauthorized.Code:         codes.Unauthenticated,
+	ecom.ErrParameter.Code:            codes.InvalidArgument,
+	ecom.ErrChainExist.Code:           codes.AlreadyExists,
+	ecom.ErrChainNotExist.Code:        codes.NotFound,
+	ecom.ErrChainAlreadyExist.Code:    codes.AlreadyExists,
+	ecom.ErrChainStatus.Code:          codes.Unavailable,
+	ecom.ErrRootChainNotExist.Code:    codes.NotFound,
+	ecom.ErrContractInvokeFailed.Code: codes.FailedPrecondition,
+	ecom.ErrTxVerifyFailed.Code:       codes.InvalidArgument,
+	ecom.ErrTxAlreadyExist.Code:       codes.AlreadyExists,
+	ecom.ErrTxNotExist.Code:           codes.NotFound,
+	ecom.ErrTxNotEnough.Code:          codes.FailedPrecondition,
+	ecom.ErrBlockNotExist.Code:        codes.NotFound,
+	ecom.ErrSendMessageFailed.Code:    codes.Unavailable,
+	ecom.ErrNetworkNoResponse.Code:    codes.Unavailable,
+	ecom.ErrConsensusStatus.Code:      codes.Unavailable,
+}
+
+// ConvertErr 转化错误类型为原接口错误
+func ConvertErr(stdErr *ecom.Error) pb.XChainErrorEnum {
+	if stdErr == nil {
+		return pb.XChainErrorEnum_UNKNOW_ERROR
+	}
+	if errCode, ok := StdErrToXchainErrMap[stdErr.Code]; ok {
+		return errCode
+	}
+	return pb.XChainErrorEnum_UNKNOW_ERROR
+}
+
+// GrpcCode 转化错误类型为grpc状态码
+func GrpcCode(stdErr *ecom.Error) codes.Code {
+	if stdErr == nil {
+		return codes.Unknown
+	}
+	if code, ok := StdErrToGrpcCodeMap[stdErr.Code]; ok {
+		return code
+	}
+	switch stdErr.Status {
+	case ecom.ErrStatusSucc:
+		return codes.OK
+	case ecom.ErrStatusRefused:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
+
+// FieldViolation 单个请求字段的校验失败说明
+type FieldViolation struct {
+	Field string
+	Desc  string
+}
+
+// ParamError 带字段校验说明的参数错误，对外按ErrParameter处理
+type ParamError struct {
+	Violations []FieldViolation
+}
+
+func (t *ParamError) Error() string {
+	return t.StdErr().Error()
+}
+
+// StdErr 转化为标准错误，附加各字段的校验说明
+func (t *ParamError) StdErr() *ecom.Error {
+	descs := make([]string, 0, len(t.Violations))
+	for _, v := range t.Violations {
+		descs = append(descs, v.Field+" "+v.Desc)
+	}
+	return ecom.ErrParameter.More("%s", strings.Join(descs, "; "))
+}
+
+// ParamChecker 依次校验请求字段，收集所有不合法的字段
+// 字段名统一使用proto中定义的字段名，和网关json的字段名一致
+type ParamChecker struct {
+	fields     protoreflect.FieldDescriptors
+	violations []FieldViolation
+}
+
+// NewParamChecker req为校验的请求，字段名按req的proto定义规范化
+func NewParamChecker(req proto.Message) *ParamChecker {
+	t := &ParamChecker{}
+	if req != nil {
+		t.fields = proto.MessageReflect(req).Descriptor().Fields()
+	}
+	return t
+}
+
+// Check ok为false时记录字段的校验失败说明
+func (t *ParamChecker) Check(ok bool, field, desc string) *ParamChecker {
+	if !ok {
+		t.violations = append(t.violations, FieldViolation{Field: t.fieldName(field), Desc: desc})
+	}
+	return t
+}
+
+// 字段名或者json名转为proto字段名，不是请求的字段时保持不变
+func (t *ParamChecker) fieldName(field string) string {
+	if t.fields == nil {
+		return field
+	}
+	if fd := t.fields.ByName(protoreflect.Name(field)); fd != nil {
+		return string(fd.Name())
+	}
+	if fd := t.fields.ByJSONName(field); fd != nil {
+		return string(fd.Name())
+	}
+	return field
+}
+
+// Required 校验必填字段
+func (t *ParamChecker) Required(ok bool, field string) *ParamChecker {
+	return t.Check(ok, field, "is required")
+}
+
+// Err 有字段校验失败时返回*ParamError
+func (t *ParamChecker) Err() error {
+	if len(t.violations) == 0 {
+		return nil
+	}
+	return &ParamError{Violations: t.violations}
+}
+
+// CastError 转化为标准错误，参数错误保留字段校验说明
+func CastError(err error) *ecom.Error {
+	var paramErr *ParamError
+	if errors.As(err, &paramErr) {
+		return paramErr.StdErr()
+	}
+	return ecom.CastError(err)
+}
+
+// GrpcStatusError 生成带错误详情的grpc错误，ErrorInfo中附带原接口错误码，
+// 参数错误附带BadRequest字段校验说明
+func GrpcStatusError(err error, logid string) error {
+	// 已经是grpc错误的直接返回，如转发其他节点的请求
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	stdErr := CastError(err)
+	st := status.New(GrpcCode(stdErr), stdErr.Error())
+
+	details := []proto.Message{newErrorInfo(stdErr, logid)}
+	var paramErr *ParamError
+	if errors.As(err, &paramErr) {
+		badReq := &errdetails.BadRequest{}
+		for _, v := range paramErr.Violations {
+			badReq.FieldViolations = append(badReq.FieldViolations,
+				&errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Desc})
+		}
+		details = append(details, badReq)
+	}
+
+	stWithDetails, e := st.WithDetails(details...)
+	if e != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
+}
+
+// GrpcUnavailableError 节点暂时不能服务时中断流式接口，返回Unavailable，
+// ErrorInfo中附带继续拉取的高度，客户端可以换节点或稍后从该高度重试
+func GrpcUnavailableError(err error, logid string, nextHeight int64) error {
+	stdErr := CastError(err)
+	st := status.New(codes.Unavailable, stdErr.Error())
+
+	info := newErrorInfo(stdErr, logid)
+	info.Metadata[MetadataNextHeight] = strconv.FormatInt(nextHeight, 10)
+	stWithDetails, e := st.WithDetails(info)
+	if e != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
+}
+
+// NextHeight 从流式接口中断的错误中取继续拉取的高度
+func NextHeight(err error) (int64, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unavailable {
+		return 0, false
+	}
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || info.GetDomain() != ErrorDomain {
+			continue
+		}
+		height, err := strconv.ParseInt(info.GetMetadata()[MetadataNextHeight], 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		return height, true
+	}
+	return 0, false
+}
+
+// ErrorInfo中附带原接口错误码和logid
+func newErrorInfo(stdErr *ecom.Error, logid string) *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason: ConvertErr(stdErr).String(),
+		Domain: ErrorDomain,
+		Metadata: map[string]string{
+			"status": strconv.Itoa(stdErr.Status),
+			"code":   strconv.Itoa(stdErr.Code),
+			"logid":  logid,
+		},
+	}
 }
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+func TestConvertErr(t *testing.T) {
+	cases := []struct {
+		err    *ecom.Error
+		legacy pb.XChainErrorEnum
+		code   codes.Code
+	}{
+		{ecom.ErrSuccess, pb.XChainErrorEnum_SUCCESS, codes.OK},
+		{ecom.ErrParameter, pb.XChainErrorEnum_VALIDATE_ERROR, codes.InvalidArgument},
+		{ecom.ErrUnauthorized, pb.XChainErrorEnum_UTXO_SIGN_ERROR, codes.Unauthenticated},
+		{ecom.ErrChainExist, pb.XChainErrorEnum_ROOT_BLOCK_EXIST_ERROR, codes.AlreadyExists},
+		{ecom.ErrChainAlreadyExist, pb.XChainErrorEnum_ROOT_BLOCK_EXIST_ERROR, codes.AlreadyExists},
+		{ecom.ErrBlockNotExist, pb.XChainErrorEnum_BLOCK_NOT_FOUND_ERROR, codes.NotFound},
+		{ecom.ErrTxNotExist, pb.XChainErrorEnum_TX_NOT_FOUND_ERROR, codes.NotFound},
+		{ecom.ErrInternal, pb.XChainErrorEnum_UNKNOW_ERROR, codes.Internal},
+		{nil, pb.XChainErrorEnum_UNKNOW_ERROR, codes.Unknown},
+	}
+	for _, c := range cases {
+		if got := ConvertErr(c.err); got != c.legacy {
+			t.Errorf("%v: legacy code %s, expect %s", c.err, got, c.legacy)
+		}
+		if got := GrpcCode(c.err); got != c.code {
+			t.Errorf("%v: grpc code %s, expect %s", c.err, got, c.code)
+		}
+	}
+}
+
+func TestParamCheckerFieldName(t *testing.T) {
+	err := NewParamChecker(&pb.BlocksRange{}).
+		Required(false, "bcname").
+		Check(false, "startHeight", "must not be negative").
+		Check(false, "unknown", "is invalid").
+		Err()
+	violations := err.(*ParamError).Violations
+	expect := []string{"bcname", "start_height", "unknown"}
+	for i, v := range violations {
+		if v.Field != expect[i] {
+			t.Errorf("field %s, expect %s", v.Field, expect[i])
+		}
+	}
+
+	if err := NewParamChecker(&pb.InvokeRPCRequest{}).Required(false, "authRequire").Err(); err == nil ||
+		err.(*ParamError).Violations[0].Field != "auth_require" {
+		t.Errorf("json name should be converted to proto name: %v", err)
+	}
+	if err := NewParamChecker(nil).Required(true, "bcname").Err(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestGrpcStatusError(t *testing.T) {
+	err := GrpcStatusError(NewParamChecker(&pb.UtxoInput{}).Required(false, "totalNeed").Err(), "logid")
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.InvalidArgument {
+		t.Fatalf("unexpected status %v", err)
+	}
+	var info *errdetails.ErrorInfo
+	var badReq *errdetails.BadRequest
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badReq = d
+		}
+	}
+	if info == nil || info.Reason != pb.XChainErrorEnum_VALIDATE_ERROR.String() || info.Metadata["logid"] != "logid" {
+		t.Errorf("unexpected error info %v", info)
+	}
+	if badReq == nil || len(badReq.FieldViolations) != 1 || badReq.FieldViolations[0].Field != "totalNeed" {
+		t.Errorf("unexpected bad request %v", badReq)
+	}
+
+	// grpc错误原样返回
+	if GrpcStatusError(err, "other") != err {
+		t.Error("grpc status error should be returned as is")
+	}
+	if _, ok := NextHeight(err); ok {
+		t.Error("only unavailable error has next height")
+	}
+}
+
+func TestGrpcUnavailableError(t *testing.T) {
+	err := GrpcUnavailableError(ecom.ErrForbidden.More("node is draining"), "logid", 100)
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unavailable {
+		t.Fatalf("unexpected status %v", err)
+	}
+	if height, ok := NextHeight(err); !ok || height != 100 {
+		t.Errorf("expect next height 100, got %d %v", height, ok)
+	}
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	if !ok || info.Reason != pb.XChainErrorEnum_SERVICE_REFUSED_ERROR.String() || info.Metadata["logid"] != "logid" {
+		t.Errorf("unexpected error info %v", st.Details())
+	}
+	if _, ok := NextHeight(status.Error(codes.Unavailable, "connection refused")); ok {
+		t.Error("unavailable error without details has no next height")
+	}
+}
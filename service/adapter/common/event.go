@@ -0,0 +1,99 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// FilteredBlock json格式的pb.FilteredBlock，事件内容按字符串输出，
+// xchain-cli watch、网关订阅、webhook推送和事件导出使用相同格式
+type FilteredBlock struct {
+	Bcname      string                 `json:"bcname,omitempty"`
+	Blockid     string                 `json:"blockid,omitempty"`
+	BlockHeight int64                  `json:"block_height,omitempty"`
+	Txs         []*FilteredTransaction `json:"txs,omitempty"`
+}
+
+// FilteredTransaction pb.FilteredTransaction
+type FilteredTransaction struct {
+	Txid   string           `json:"txid,omitempty"`
+	Events []*ContractEvent `json:"events,omitempty"`
+}
+
+// ContractEvent pb.ContractEvent
+type ContractEvent struct {
+	Contract string `json:"contract,omitempty"`
+	Name     string `json:"name,omitempty"`
+	Body     string `json:"body,omitempty"`
+}
+
+// FromFilteredBlockPB convert pb.FilteredBlock to FilteredBlock
+func FromFilteredBlockPB(pbblock *pb.FilteredBlock) *FilteredBlock {
+	block := &FilteredBlock{
+		Bcname:      pbblock.Bcname,
+		Blockid:     pbblock.Blockid,
+		BlockHeight: pbblock.BlockHeight,
+		Txs:         make([]*FilteredTransaction, 0, len(pbblock.Txs)),
+	}
+
+	for _, pbtx := range pbblock.Txs {
+		block.Txs = append(block.Txs, &FilteredTransaction{
+			Txid:   pbtx.Txid,
+			Events: FromContractEventsPB(pbtx.Events),
+		})
+	}
+	return block
+}
+
+// FromContractEventsPB convert pb.ContractEvent list to ContractEvent list
+func FromContractEventsPB(pbevents []*pb.ContractEvent) []*ContractEvent {
+	events := make([]*ContractEvent, 0, len(pbevents))
+	for _, pbevent := range pbevents {
+		events = append(events, &ContractEvent{
+			Contract: pbevent.Contract,
+			Name:     pbevent.Name,
+			Body:     string(pbevent.Body),
+		})
+	}
+	return events
+}
+
+// FormatCursor 游标的字符串格式<block_height>-<tx_index>-<event_index>，
+// 用于SSE的事件id和续订提示，游标为nil时返回空串
+func FormatCursor(cursor *pb.EventCursor) string {
+	if cursor == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d-%d", cursor.GetBlockHeight(), cursor.GetTxIndex(), cursor.GetEventIndex())
+}
+
+// ParseCursor 解析FormatCursor格式的游标，空串返回nil
+func ParseCursor(s string) (*pb.EventCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, "-")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("bad cursor %q", s)
+	}
+	var nums [3]int64
+	for i, part := range parts {
+		bits := 32
+		if i == 0 {
+			bits = 64
+		}
+		n, err := strconv.ParseInt(part, 10, bits)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("bad cursor %q", s)
+		}
+		nums[i] = n
+	}
+	return &pb.EventCursor{
+		BlockHeight: nums[0],
+		TxIndex:     int32(nums[1]),
+		EventIndex:  int32(nums[2]),
+	}, nil
+}
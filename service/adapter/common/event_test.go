@@ -0,0 +1,52 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+func TestFromFilteredBlockPB(t *testing.T) {
+	block := FromFilteredBlockPB(&pb.FilteredBlock{
+		Bcname:      "xuper",
+		Blockid:     "b1",
+		BlockHeight: 10,
+		Txs: []*pb.FilteredTransaction{
+			{Txid: "t1", Events: []*pb.ContractEvent{{Contract: "counter", Name: "increase", Body: []byte("1")}}},
+			{Txid: "t2"},
+		},
+	})
+	data, err := json.Marshal(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `{"bcname":"xuper","blockid":"b1","block_height":10,"txs":[` +
+		`{"txid":"t1","events":[{"contract":"counter","name":"increase","body":"1"}]},{"txid":"t2"}]}`
+	if string(data) != expect {
+		t.Errorf("got %s, expect %s", data, expect)
+	}
+}
+
+func TestCursor(t *testing.T) {
+	cursor := &pb.EventCursor{BlockHeight: 100, TxIndex: 2, EventIndex: 1}
+	s := FormatCursor(cursor)
+	if s != "100-2-1" {
+		t.Errorf("unexpected cursor %s", s)
+	}
+	parsed, err := ParseCursor(s)
+	if err != nil || parsed.BlockHeight != 100 || parsed.TxIndex != 2 || parsed.EventIndex != 1 {
+		t.Errorf("parse %s got %v %v", s, parsed, err)
+	}
+	if FormatCursor(nil) != "" {
+		t.Error("nil cursor should be formatted as empty")
+	}
+	if parsed, err := ParseCursor(""); parsed != nil || err != nil {
+		t.Errorf("empty cursor should be nil, got %v %v", parsed, err)
+	}
+	for _, bad := range []string{"100/2/1", "100-2", "100-2-1-0", "a-2-1", "-1-2-1", "100-2147483648-0"} {
+		if _, err := ParseCursor(bad); err == nil {
+			t.Errorf("cursor %s should be invalid", bad)
+		}
+	}
+}
@@ -1,51 +1,26 @@
 package common
 
 import (
-	"fmt"
-
-	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/utxo/txhash"
-	crypto_base "github.com/xuperchain/xupercore/lib/crypto/client/base"
-	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"strings"
 )
 
-// 适配原结构计算txid
-func MakeTxId(tx *pb.Transaction) ([]byte, error) {
-	// 转化结构
-	xldgTx := TxToXledger(tx)
-	if xldgTx == nil {
-		return nil, fmt.Errorf("tx convert fail")
-	}
-	// 计算txid
-	txId, err := txhash.MakeTransactionID(xldgTx)
-	if err != nil {
-		return nil, err
-	}
-	return txId, nil
-}
+// ForwardedForKey 网关转发请求时携带客户端地址的grpc metadata key
+const ForwardedForKey = "x-forwarded-for"
 
-// 适配原结构签名
-func ComputeTxSign(cryptoClient crypto_base.CryptoClient, tx *pb.Transaction, jsonSK []byte) ([]byte, error) {
-	// 转换结构
-	xldgTx := TxToXledger(tx)
-	if xldgTx == nil {
-		return nil, fmt.Errorf("tx convert fail")
-	}
-	txSign, err := txhash.ProcessSignTx(cryptoClient, xldgTx, jsonSK)
-	if err != nil {
-		return nil, err
-	}
-	return txSign, nil
-}
+// LogIdKey 网关转发请求时携带logid的grpc metadata key，请求header中没有logid时使用
+const LogIdKey = "x-log-id"
 
-func MakeTxDigestHash(tx *pb.Transaction) ([]byte, error) {
-	// 转换结构
-	xldgTx := TxToXledger(tx)
-	if xldgTx == nil {
-		return nil, fmt.Errorf("tx convert fail")
+// MultiAddrToHost 将/ip4/127.0.0.1/tcp/47101格式的地址转为127.0.0.1:47101
+func MultiAddrToHost(addr string) string {
+	parts := strings.Split(addr, "/")
+	if len(parts) < 5 || parts[0] != "" || parts[3] != "tcp" {
+		return ""
 	}
-	digestHash, err := txhash.MakeTxDigestHash(xldgTx)
-	if err != nil {
-		return nil, err
+	switch parts[1] {
+	case "ip4", "dns4", "dns", "dns6":
+		return parts[2] + ":" + parts[4]
+	case "ip6":
+		return "[" + parts[2] + "]:" + parts[4]
 	}
-	return digestHash, nil
+	return ""
 }
@@ -0,0 +1,155 @@
+package event
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 单次订阅关注的地址数上限
+const maxAccountFilterAddrs = 100
+
+var _ Topic = (*AccountTopic)(nil)
+
+// AccountTopic 账户余额变动事件，按交易统计每个地址的utxo收支
+// 游标为(区块高度,交易序号,地址在该交易中的序号)
+type AccountTopic struct {
+	chainMG event.ChainManager
+}
+
+func NewAccountTopic(chainMG event.ChainManager) *AccountTopic {
+	return &AccountTopic{
+		chainMG: chainMG,
+	}
+}
+
+func (t *AccountTopic) ParseFilter(buf []byte) (interface{}, error) {
+	filter := new(pb.AccountFilter)
+	err := proto.Unmarshal(buf, filter)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (t *AccountTopic) NewIterator(ifilter interface{}, start *pb.EventCursor) (event.Iterator, error) {
+	filter, ok := ifilter.(*pb.AccountFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for account event")
+	}
+	if len(filter.GetAddresses()) == 0 {
+		return nil, errors.New("addresses unset")
+	}
+	if len(filter.GetAddresses()) > maxAccountFilterAddrs {
+		return nil, errors.New("too many addresses")
+	}
+
+	store, err := t.chainMG.GetBlockStore(filter.GetBcname())
+	if err != nil {
+		return nil, err
+	}
+	begin, end, err := parseRange(store, filter.GetRange(), start)
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make(map[string]bool, len(filter.GetAddresses()))
+	for _, addr := range filter.GetAddresses() {
+		addrs[addr] = true
+	}
+	expand := func(x interface{}) ([]*pb.Event, error) {
+		block := x.(*lpb.InternalBlock)
+		events := make([]*pb.Event, 0)
+		for i, tx := range block.GetTransactions() {
+			for j, accountEvent := range toAccountEvents(filter.GetBcname(), block, tx, addrs) {
+				cursor := &pb.EventCursor{
+					BlockHeight: block.GetHeight(),
+					TxIndex:     int32(i),
+					EventIndex:  int32(j),
+				}
+				ev, err := newEvent(cursor, accountEvent)
+				if err != nil {
+					return nil, err
+				}
+				events = append(events, ev)
+			}
+		}
+		return events, nil
+	}
+	biter := event.NewBlockIterator(store, begin, end)
+	return newFlatIterator(biter, expand, start, -1), nil
+}
+
+// 统计交易中关注地址的utxo收支，按地址出现顺序输出
+func toAccountEvents(bcname string, block *lpb.InternalBlock, tx *lpb.Transaction,
+	addrs map[string]bool) []*pb.AccountEvent {
+
+	changes := make(map[string]*pb.AccountEvent)
+	order := make([]string, 0)
+	getEvent := func(addr string) *pb.AccountEvent {
+		if ev, ok := changes[addr]; ok {
+			return ev
+		}
+		ev := &pb.AccountEvent{
+			Bcname:      bcname,
+			Blockid:     hex.EncodeToString(block.GetBlockid()),
+			BlockHeight: block.GetHeight(),
+			Txid:        hex.EncodeToString(tx.GetTxid()),
+			Address:     addr,
+		}
+		changes[addr] = ev
+		order = append(order, addr)
+		return ev
+	}
+
+	deltas := make(map[string]*big.Int)
+	for _, input := range tx.GetTxInputs() {
+		addr := string(input.GetFromAddr())
+		if !addrs[addr] {
+			continue
+		}
+		amount := new(big.Int).SetBytes(input.GetAmount())
+		ev := getEvent(addr)
+		ev.Debits = append(ev.Debits, &pb.UtxoChange{
+			Txid:   hex.EncodeToString(input.GetRefTxid()),
+			Offset: input.GetRefOffset(),
+			Amount: amount.String(),
+		})
+		if deltas[addr] == nil {
+			deltas[addr] = new(big.Int)
+		}
+		deltas[addr].Sub(deltas[addr], amount)
+	}
+	for i, output := range tx.GetTxOutputs() {
+		addr := string(output.GetToAddr())
+		if !addrs[addr] {
+			continue
+		}
+		amount := new(big.Int).SetBytes(output.GetAmount())
+		ev := getEvent(addr)
+		ev.Credits = append(ev.Credits, &pb.UtxoChange{
+			Txid:   hex.EncodeToString(tx.GetTxid()),
+			Offset: int32(i),
+			Amount: amount.String(),
+		})
+		if deltas[addr] == nil {
+			deltas[addr] = new(big.Int)
+		}
+		deltas[addr].Add(deltas[addr], amount)
+	}
+
+	events := make([]*pb.AccountEvent, 0, len(order))
+	for _, addr := range order {
+		ev := changes[addr]
+		ev.Delta = deltas[addr].String()
+		events = append(events, ev)
+	}
+	return events
+}
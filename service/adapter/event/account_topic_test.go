@@ -0,0 +1,79 @@
+package event
+
+import (
+	"math/big"
+	"testing"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestToAccountEvents(t *testing.T) {
+	amount := func(n int64) []byte {
+		return big.NewInt(n).Bytes()
+	}
+	block := &lpb.InternalBlock{Blockid: []byte{0xbb}, Height: 10}
+	// alice转给bob 30，找零给自己，carol同时转给bob
+	tx := &lpb.Transaction{
+		Txid: []byte{0x01},
+		TxInputs: []*protos.TxInput{
+			{FromAddr: []byte("alice"), RefTxid: []byte{0xa1}, RefOffset: 0, Amount: amount(50)},
+			{FromAddr: []byte("carol"), RefTxid: []byte{0xc1}, RefOffset: 2, Amount: amount(5)},
+			{FromAddr: []byte("alice"), RefTxid: []byte{0xa2}, RefOffset: 1, Amount: amount(10)},
+		},
+		TxOutputs: []*protos.TxOutput{
+			{ToAddr: []byte("bob"), Amount: amount(30)},
+			{ToAddr: []byte("alice"), Amount: amount(30)},
+			{ToAddr: []byte("bob"), Amount: amount(5)},
+		},
+	}
+
+	cases := []struct {
+		name  string
+		addrs []string
+		// 按输出顺序期望的地址和余额变化
+		expect [][2]string
+	}{
+		{name: "none", addrs: []string{"dave"}},
+		{name: "payee", addrs: []string{"bob"}, expect: [][2]string{{"bob", "35"}}},
+		{name: "payer with change", addrs: []string{"alice"}, expect: [][2]string{{"alice", "-30"}}},
+		// 先统计支出再统计收入，顺序与关注地址的顺序无关
+		{name: "all", addrs: []string{"bob", "carol", "alice"},
+			expect: [][2]string{{"alice", "-30"}, {"carol", "-5"}, {"bob", "35"}}},
+	}
+	for _, c := range cases {
+		addrs := make(map[string]bool)
+		for _, addr := range c.addrs {
+			addrs[addr] = true
+		}
+		events := toAccountEvents("xuper", block, tx, addrs)
+		if len(events) != len(c.expect) {
+			t.Errorf("%s: expect %d events, got %d", c.name, len(c.expect), len(events))
+			continue
+		}
+		for i, ev := range events {
+			if ev.GetAddress() != c.expect[i][0] || ev.GetDelta() != c.expect[i][1] {
+				t.Errorf("%s: event %d got %s %s", c.name, i, ev.GetAddress(), ev.GetDelta())
+			}
+			if ev.GetBcname() != "xuper" || ev.GetBlockid() != "bb" || ev.GetBlockHeight() != 10 ||
+				ev.GetTxid() != "01" {
+				t.Errorf("%s: event %d bad position %v", c.name, i, ev)
+			}
+		}
+	}
+
+	// 支出记录引用的utxo，收入记录本交易的输出序号
+	events := toAccountEvents("xuper", block, tx, map[string]bool{"alice": true, "bob": true})
+	alice, bob := events[0], events[1]
+	if len(alice.GetDebits()) != 2 || alice.GetDebits()[1].GetTxid() != "a2" ||
+		alice.GetDebits()[1].GetOffset() != 1 || alice.GetDebits()[1].GetAmount() != "10" {
+		t.Errorf("unexpected debits %v", alice.GetDebits())
+	}
+	if len(alice.GetCredits()) != 1 || alice.GetCredits()[0].GetOffset() != 1 {
+		t.Errorf("unexpected alice credits %v", alice.GetCredits())
+	}
+	if len(bob.GetDebits()) != 0 || len(bob.GetCredits()) != 2 || bob.GetCredits()[1].GetTxid() != "01" ||
+		bob.GetCredits()[1].GetOffset() != 2 || bob.GetCredits()[1].GetAmount() != "5" {
+		t.Errorf("unexpected bob credits %v", bob.GetCredits())
+	}
+}
@@ -0,0 +1,66 @@
+package event
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+	"github.com/xuperchain/xupercore/protos"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+var _ Topic = (*BlockTopic)(nil)
+
+// BlockTopic 区块事件，复用内核实现，游标为(区块高度,0,0)
+type BlockTopic struct {
+	topic *event.BlockTopic
+}
+
+func NewBlockTopic(chainMG event.ChainManager) *BlockTopic {
+	return &BlockTopic{
+		topic: event.NewBlockTopic(chainMG),
+	}
+}
+
+func (t *BlockTopic) ParseFilter(buf []byte) (interface{}, error) {
+	filter := new(protos.BlockFilter)
+	err := proto.Unmarshal(buf, filter)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (t *BlockTopic) NewIterator(ifilter interface{}, start *pb.EventCursor) (event.Iterator, error) {
+	filter, ok := ifilter.(*protos.BlockFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for block event")
+	}
+
+	if start != nil {
+		if filter.Range == nil {
+			filter.Range = &protos.BlockRange{}
+		}
+		n, err := strconv.ParseInt(filter.Range.Start, 10, 64)
+		if filter.Range.Start == "" || err != nil || start.GetBlockHeight() > n {
+			filter.Range.Start = strconv.FormatInt(start.GetBlockHeight(), 10)
+		}
+	}
+
+	biter, err := t.topic.NewFilterIterator(filter)
+	if err != nil {
+		return nil, err
+	}
+	expand := func(x interface{}) ([]*pb.Event, error) {
+		block := x.(*protos.FilteredBlock)
+		ev, err := newEvent(&pb.EventCursor{BlockHeight: block.GetBlockHeight()}, block)
+		if err != nil {
+			return nil, err
+		}
+		return []*pb.Event{ev}, nil
+	}
+	return newFlatIterator(biter, expand, start, -1), nil
+}
@@ -0,0 +1,120 @@
+package event
+
+import (
+	"regexp"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/contract/sandbox"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+// txMatcher 交易过滤规则，各字段为正则表达式，为空表示不过滤，与内核区块事件的过滤规则一致
+type txMatcher struct {
+	contract    *regexp.Regexp
+	eventName   *regexp.Regexp
+	initiator   *regexp.Regexp
+	authRequire *regexp.Regexp
+	fromAddr    *regexp.Regexp
+	toAddr      *regexp.Regexp
+}
+
+func newTxMatcher(contract, eventName, initiator, authRequire, fromAddr, toAddr string) (*txMatcher, error) {
+	m := &txMatcher{}
+	var err error
+	if m.contract, err = compileString(contract); err != nil {
+		return nil, err
+	}
+	if m.eventName, err = compileString(eventName); err != nil {
+		return nil, err
+	}
+	if m.initiator, err = compileString(initiator); err != nil {
+		return nil, err
+	}
+	if m.authRequire, err = compileString(authRequire); err != nil {
+		return nil, err
+	}
+	if m.fromAddr, err = compileString(fromAddr); err != nil {
+		return nil, err
+	}
+	if m.toAddr, err = compileString(toAddr); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *txMatcher) matchTx(tx *lpb.Transaction) bool {
+	if !matchString(m.initiator, tx.GetInitiator()) {
+		return false
+	}
+	if m.authRequire != nil && !matchAny(m.authRequire, tx.GetAuthRequire()) {
+		return false
+	}
+	if m.contract != nil {
+		names := make([]string, 0, len(tx.GetContractRequests()))
+		for _, req := range tx.GetContractRequests() {
+			names = append(names, req.GetContractName())
+		}
+		if !matchAny(m.contract, names) {
+			return false
+		}
+	}
+	if m.fromAddr != nil {
+		addrs := make([]string, 0, len(tx.GetTxInputs()))
+		for _, input := range tx.GetTxInputs() {
+			addrs = append(addrs, string(input.GetFromAddr()))
+		}
+		if !matchAny(m.fromAddr, addrs) {
+			return false
+		}
+	}
+	if m.toAddr != nil {
+		addrs := make([]string, 0, len(tx.GetTxOutputs()))
+		for _, output := range tx.GetTxOutputs() {
+			addrs = append(addrs, string(output.GetToAddr()))
+		}
+		if !matchAny(m.toAddr, addrs) {
+			return false
+		}
+	}
+	return true
+}
+
+// 解析交易中符合事件名过滤的合约事件
+func (m *txMatcher) matchEvents(tx *lpb.Transaction) []*protos.ContractEvent {
+	events, err := sandbox.ParseContractEvents(tx)
+	if err != nil {
+		return nil
+	}
+
+	var ret []*protos.ContractEvent
+	for _, event := range events {
+		if matchString(m.eventName, event.GetName()) {
+			ret = append(ret, event)
+		}
+	}
+	return ret
+}
+
+func (m *txMatcher) hasEventFilter() bool {
+	return m.eventName != nil
+}
+
+func compileString(regstr string) (*regexp.Regexp, error) {
+	if regstr == "" {
+		return nil, nil
+	}
+	return regexp.Compile(regstr)
+}
+
+func matchString(filter *regexp.Regexp, target string) bool {
+	return filter == nil || filter.MatchString(target)
+}
+
+func matchAny(filter *regexp.Regexp, targets []string) bool {
+	for _, target := range targets {
+		if filter.MatchString(target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package event
+
+import (
+	"testing"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/protos"
+)
+
+func TestTxMatcher(t *testing.T) {
+	tx := &lpb.Transaction{
+		Initiator:   "alice",
+		AuthRequire: []string{"bob", "carol"},
+		ContractRequests: []*protos.InvokeRequest{
+			{ContractName: "counter"},
+			{ContractName: "$xkernel"},
+		},
+		TxInputs:  []*protos.TxInput{{FromAddr: []byte("alice")}},
+		TxOutputs: []*protos.TxOutput{{ToAddr: []byte("dave")}, {ToAddr: []byte("alice")}},
+	}
+	cases := []struct {
+		name  string
+		rules [6]string
+		match bool
+	}{
+		{name: "no filter", match: true},
+		{name: "contract", rules: [6]string{0: "^counter$"}, match: true},
+		{name: "any contract", rules: [6]string{0: "kernel"}, match: true},
+		{name: "other contract", rules: [6]string{0: "^erc20$"}},
+		{name: "event name does not filter tx", rules: [6]string{1: "^increase$"}, match: true},
+		{name: "initiator", rules: [6]string{2: "^alice$"}, match: true},
+		{name: "other initiator", rules: [6]string{2: "^bob$"}},
+		{name: "auth require", rules: [6]string{3: "^carol$"}, match: true},
+		{name: "other auth require", rules: [6]string{3: "^alice$"}},
+		{name: "from", rules: [6]string{4: "^alice$"}, match: true},
+		{name: "other from", rules: [6]string{4: "^dave$"}},
+		{name: "to", rules: [6]string{5: "^dave$"}, match: true},
+		{name: "other to", rules: [6]string{5: "^bob$"}},
+		{name: "all rules", rules: [6]string{"counter", "", "alice", "bob", "alice", "dave"}, match: true},
+		{name: "one rule not match", rules: [6]string{"counter", "", "alice", "bob", "alice", "eve"}},
+	}
+	for _, c := range cases {
+		m, err := newTxMatcher(c.rules[0], c.rules[1], c.rules[2], c.rules[3], c.rules[4], c.rules[5])
+		if err != nil {
+			t.Fatalf("%s: %v", c.name, err)
+		}
+		if m.matchTx(tx) != c.match {
+			t.Errorf("%s: expect match %v", c.name, c.match)
+		}
+	}
+
+	// 没有合约调用的交易不匹配合约过滤
+	m, _ := newTxMatcher("counter", "", "", "", "", "")
+	if m.matchTx(&lpb.Transaction{Initiator: "alice"}) {
+		t.Error("transfer should not match contract filter")
+	}
+	if _, err := newTxMatcher("(", "", "", "", "", ""); err == nil {
+		t.Error("invalid regexp should fail")
+	}
+	if m.hasEventFilter() {
+		t.Error("matcher without event name should not filter events")
+	}
+}
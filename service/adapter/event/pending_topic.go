@@ -0,0 +1,340 @@
+package event
+
+import (
+	"encoding/hex"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+const (
+	// 交易池轮询间隔，用于发现其他节点广播来的交易
+	pendingPollInterval = 500 * time.Millisecond
+	// 每个订阅缓存的事件数，消费跟不上时断开订阅
+	pendingQueueSize = 1024
+)
+
+var (
+	_ Topic = (*PendingTxTopic)(nil)
+
+	errPendingTxSlow = errors.New("pending tx subscriber is too slow, subscribe again")
+)
+
+// PendingTxFeed 交易进入交易池的共享事件源，各服务的订阅共用
+// 本节点提交的交易由Publish立即推送，其他节点广播来的交易由每条链一个轮询协程对比交易池发现，
+// 同一批发现的交易按时间戳和txid排序推送
+type PendingTxFeed struct {
+	// 返回读取链交易池的函数，测试时替换
+	reader   func(bcname string) (pendingReader, error)
+	interval time.Duration
+
+	mutex  sync.Mutex
+	chains map[string]*pendingChain
+}
+
+// pendingReader 读取交易池中的全部交易
+type pendingReader func() ([]*lpb.Transaction, error)
+
+// pendingChain 一条链的订阅，没有订阅时停止轮询
+type pendingChain struct {
+	bcname string
+	read   pendingReader
+	subs   map[*pendingTxIterator]bool
+	// 已推送或订阅前已在交易池中的交易
+	seen map[string]bool
+	// 本轮轮询期间Publish的交易，轮询结果可能不包含它们
+	published map[string]bool
+	exitCh    chan struct{}
+}
+
+func NewPendingTxFeed(engine ecom.Engine) *PendingTxFeed {
+	reader := func(bcname string) (pendingReader, error) {
+		chain, err := engine.Get(bcname)
+		if err != nil {
+			return nil, err
+		}
+		return func() ([]*lpb.Transaction, error) {
+			return chain.Context().State.GetUnconfirmedTx(false)
+		}, nil
+	}
+	return &PendingTxFeed{
+		reader:   reader,
+		interval: pendingPollInterval,
+		chains:   make(map[string]*pendingChain),
+	}
+}
+
+// Publish 本节点提交到交易池的交易，没有订阅时忽略
+func (t *PendingTxFeed) Publish(bcname string, tx *lpb.Transaction) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	pc, ok := t.chains[bcname]
+	if !ok {
+		return
+	}
+	txid := hex.EncodeToString(tx.GetTxid())
+	pc.published[txid] = true
+	if pc.seen[txid] {
+		return
+	}
+	pc.seen[txid] = true
+	t.dispatch(pc, []*lpb.Transaction{tx})
+}
+
+func (t *PendingTxFeed) subscribe(bcname string, iter *pendingTxIterator) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	pc, ok := t.chains[bcname]
+	if !ok {
+		read, err := t.reader(bcname)
+		if err != nil {
+			return err
+		}
+		// 订阅前已在交易池中的交易不推送
+		pending, err := read()
+		if err != nil {
+			return err
+		}
+		pc = &pendingChain{
+			bcname:    bcname,
+			read:      read,
+			subs:      make(map[*pendingTxIterator]bool),
+			seen:      make(map[string]bool, len(pending)),
+			published: make(map[string]bool),
+			exitCh:    make(chan struct{}),
+		}
+		for _, tx := range pending {
+			pc.seen[hex.EncodeToString(tx.GetTxid())] = true
+		}
+		t.chains[bcname] = pc
+		go t.poll(pc)
+	}
+	pc.subs[iter] = true
+	return nil
+}
+
+func (t *PendingTxFeed) unsubscribe(bcname string, iter *pendingTxIterator) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	pc, ok := t.chains[bcname]
+	if !ok || !pc.subs[iter] {
+		return
+	}
+	t.remove(pc, iter, nil)
+}
+
+// 需要持有锁，err不为空时作为订阅的错误
+func (t *PendingTxFeed) remove(pc *pendingChain, iter *pendingTxIterator, err error) {
+	delete(pc.subs, iter)
+	iter.err = err
+	close(iter.ch)
+	if len(pc.subs) == 0 {
+		close(pc.exitCh)
+		delete(t.chains, pc.bcname)
+	}
+}
+
+// 轮询交易池，推送不在去重表中的交易
+func (t *PendingTxFeed) poll(pc *pendingChain) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pc.exitCh:
+			return
+		case <-ticker.C:
+		}
+
+		t.mutex.Lock()
+		pc.published = make(map[string]bool)
+		t.mutex.Unlock()
+		pending, err := pc.read()
+
+		t.mutex.Lock()
+		select {
+		case <-pc.exitCh:
+			t.mutex.Unlock()
+			return
+		default:
+		}
+		if err != nil {
+			for iter := range pc.subs {
+				t.remove(pc, iter, err)
+			}
+			t.mutex.Unlock()
+			return
+		}
+		t.diff(pc, pending)
+		t.mutex.Unlock()
+	}
+}
+
+// 需要持有锁，已离开交易池的交易从去重表中删除
+func (t *PendingTxFeed) diff(pc *pendingChain, pending []*lpb.Transaction) {
+	seen := make(map[string]bool, len(pending)+len(pc.published))
+	var added []*lpb.Transaction
+	for _, tx := range pending {
+		txid := hex.EncodeToString(tx.GetTxid())
+		seen[txid] = true
+		if !pc.seen[txid] {
+			added = append(added, tx)
+		}
+	}
+	// 轮询期间Publish的交易可能不在结果中，保留避免重复推送
+	for txid := range pc.published {
+		seen[txid] = true
+	}
+	pc.seen = seen
+
+	sort.Slice(added, func(i, j int) bool {
+		if added[i].GetTimestamp() != added[j].GetTimestamp() {
+			return added[i].GetTimestamp() < added[j].GetTimestamp()
+		}
+		return hex.EncodeToString(added[i].GetTxid()) < hex.EncodeToString(added[j].GetTxid())
+	})
+	t.dispatch(pc, added)
+}
+
+// 需要持有锁，订阅的缓存满时断开订阅
+func (t *PendingTxFeed) dispatch(pc *pendingChain, txs []*lpb.Transaction) {
+	for _, tx := range txs {
+		var ev *pb.Event
+		for iter := range pc.subs {
+			if !iter.matcher.matchTx(tx) {
+				continue
+			}
+			if ev == nil {
+				var err error
+				ev, err = newEvent(nil, toPendingTxEvent(pc.bcname, tx))
+				if err != nil {
+					t.remove(pc, iter, err)
+					continue
+				}
+			}
+			select {
+			case iter.ch <- ev:
+			default:
+				t.remove(pc, iter, errPendingTxSlow)
+			}
+		}
+	}
+}
+
+func toPendingTxEvent(bcname string, tx *lpb.Transaction) *pb.PendingTxEvent {
+	ev := &pb.PendingTxEvent{
+		Bcname:      bcname,
+		Txid:        hex.EncodeToString(tx.GetTxid()),
+		Initiator:   tx.GetInitiator(),
+		AuthRequire: tx.GetAuthRequire(),
+		Timestamp:   tx.GetTimestamp(),
+	}
+	for _, req := range tx.GetContractRequests() {
+		ev.Contracts = append(ev.Contracts, req.GetContractName())
+	}
+	return ev
+}
+
+// PendingTxTopic 交易进入交易池事件，推送订阅后新进入的交易
+// 未上链的交易没有确定的位置，事件不带游标，也不支持断点续订
+type PendingTxTopic struct {
+	feed *PendingTxFeed
+}
+
+func NewPendingTxTopic(feed *PendingTxFeed) *PendingTxTopic {
+	return &PendingTxTopic{
+		feed: feed,
+	}
+}
+
+func (t *PendingTxTopic) ParseFilter(buf []byte) (interface{}, error) {
+	filter := new(pb.PendingTxFilter)
+	err := proto.Unmarshal(buf, filter)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (t *PendingTxTopic) NewIterator(ifilter interface{}, start *pb.EventCursor) (event.Iterator, error) {
+	filter, ok := ifilter.(*pb.PendingTxFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for pending tx event")
+	}
+	if start != nil {
+		return nil, errors.New("pending tx event does not support cursor")
+	}
+
+	matcher, err := newTxMatcher(filter.GetContract(), "", filter.GetInitiator(),
+		filter.GetAuthRequire(), "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	iter := &pendingTxIterator{
+		feed:    t.feed,
+		bcname:  filter.GetBcname(),
+		matcher: matcher,
+		ch:      make(chan *pb.Event, pendingQueueSize),
+		exitCh:  make(chan struct{}),
+	}
+	if err := t.feed.subscribe(iter.bcname, iter); err != nil {
+		return nil, err
+	}
+	return iter, nil
+}
+
+type pendingTxIterator struct {
+	feed    *PendingTxFeed
+	bcname  string
+	matcher *txMatcher
+
+	// 由feed写入，断开订阅时关闭
+	ch   chan *pb.Event
+	data *pb.Event
+	// ch关闭前设置
+	err error
+
+	exitCh    chan struct{}
+	closeOnce sync.Once
+}
+
+func (t *pendingTxIterator) Next() bool {
+	select {
+	case <-t.exitCh:
+		return false
+	case ev, ok := <-t.ch:
+		if !ok {
+			return false
+		}
+		t.data = ev
+		return true
+	}
+}
+
+func (t *pendingTxIterator) Data() interface{} {
+	return t.data
+}
+
+// Next返回false后调用，ch关闭后err不再变化
+func (t *pendingTxIterator) Error() error {
+	return t.err
+}
+
+func (t *pendingTxIterator) Close() {
+	t.closeOnce.Do(func() {
+		close(t.exitCh)
+		t.feed.unsubscribe(t.bcname, t)
+	})
+}
@@ -0,0 +1,203 @@
+package event
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 交易池由测试直接设置，轮询间隔足够长，由测试调用diff模拟一次轮询
+func newTestFeed(pool *[]*lpb.Transaction, readErr *error) *PendingTxFeed {
+	return &PendingTxFeed{
+		reader: func(bcname string) (pendingReader, error) {
+			if bcname != "xuper" {
+				return nil, errors.New("chain not exist")
+			}
+			return func() ([]*lpb.Transaction, error) {
+				return *pool, *readErr
+			}, nil
+		},
+		interval: time.Hour,
+		chains:   make(map[string]*pendingChain),
+	}
+}
+
+func newTestTx(id byte, timestamp int64, initiator string) *lpb.Transaction {
+	return &lpb.Transaction{
+		Txid:      []byte{id},
+		Timestamp: timestamp,
+		Initiator: initiator,
+	}
+}
+
+func subscribePending(t *testing.T, feed *PendingTxFeed, filter *pb.PendingTxFilter) *pendingTxIterator {
+	iter, err := NewPendingTxTopic(feed).NewIterator(filter, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return iter.(*pendingTxIterator)
+}
+
+// 取出已推送的交易id，不阻塞
+func drainPending(t *testing.T, iter *pendingTxIterator) []string {
+	var txids []string
+	for len(iter.ch) > 0 {
+		if !iter.Next() {
+			break
+		}
+		ev := new(pb.PendingTxEvent)
+		if err := proto.Unmarshal(iter.Data().(*pb.Event).GetPayload(), ev); err != nil {
+			t.Fatal(err)
+		}
+		txids = append(txids, ev.GetTxid())
+	}
+	return txids
+}
+
+func expectTxids(t *testing.T, name string, got []string, expect ...byte) {
+	if len(got) != len(expect) {
+		t.Errorf("%s: expect %d txs, got %v", name, len(expect), got)
+		return
+	}
+	for i, id := range expect {
+		if got[i] != hex.EncodeToString([]byte{id}) {
+			t.Errorf("%s: expect %x at %d, got %v", name, id, i, got)
+			return
+		}
+	}
+}
+
+func TestPendingTxFeed(t *testing.T) {
+	var readErr error
+	pool := []*lpb.Transaction{newTestTx(1, 1, "alice")}
+	feed := newTestFeed(&pool, &readErr)
+
+	if _, err := NewPendingTxTopic(feed).NewIterator(&pb.PendingTxFilter{Bcname: "foo"}, nil); err == nil {
+		t.Error("subscribe unknown chain should fail")
+	}
+	if _, err := NewPendingTxTopic(feed).NewIterator(&pb.PendingTxFilter{Bcname: "xuper"},
+		&pb.EventCursor{}); err == nil {
+		t.Error("cursor should not be supported")
+	}
+
+	all := subscribePending(t, feed, &pb.PendingTxFilter{Bcname: "xuper"})
+	alice := subscribePending(t, feed, &pb.PendingTxFilter{Bcname: "xuper", Initiator: "^alice$"})
+	pc := feed.chains["xuper"]
+
+	// 本节点提交的交易立即推送，重复提交不重复推送
+	tx2 := newTestTx(2, 5, "alice")
+	feed.Publish("xuper", tx2)
+	feed.Publish("xuper", tx2)
+	feed.Publish("other", newTestTx(9, 1, "alice"))
+	expectTxids(t, "publish", drainPending(t, all), 2)
+	expectTxids(t, "publish alice", drainPending(t, alice), 2)
+
+	// 轮询发现的交易按时间戳和txid排序，订阅前已在交易池的交易不推送
+	pool = []*lpb.Transaction{
+		newTestTx(1, 1, "alice"),
+		tx2,
+		newTestTx(5, 3, "bob"),
+		newTestTx(4, 3, "alice"),
+		newTestTx(3, 2, "bob"),
+	}
+	feed.mutex.Lock()
+	feed.diff(pc, pool)
+	feed.mutex.Unlock()
+	expectTxids(t, "poll", drainPending(t, all), 3, 4, 5)
+	expectTxids(t, "poll alice", drainPending(t, alice), 4)
+
+	// 轮询期间提交的交易不在结果中时，下一轮也不重复推送
+	feed.mutex.Lock()
+	pc.published = make(map[string]bool)
+	feed.mutex.Unlock()
+	tx6 := newTestTx(6, 9, "bob")
+	feed.Publish("xuper", tx6)
+	feed.mutex.Lock()
+	feed.diff(pc, pool)
+	feed.diff(pc, append(pool, tx6))
+	feed.mutex.Unlock()
+	expectTxids(t, "published during poll", drainPending(t, all), 6)
+
+	// 最后一个订阅退出后停止轮询
+	all.Close()
+	alice.Close()
+	if all.Next() {
+		t.Error("closed iterator should not return events")
+	}
+	if _, ok := feed.chains["xuper"]; ok {
+		t.Error("chain should be removed without subscribers")
+	}
+	select {
+	case <-pc.exitCh:
+	default:
+		t.Error("poller should be stopped")
+	}
+}
+
+func TestPendingTxFeedSlow(t *testing.T) {
+	var readErr error
+	var pool []*lpb.Transaction
+	feed := newTestFeed(&pool, &readErr)
+
+	matcher, _ := newTxMatcher("", "", "", "", "", "")
+	slow := &pendingTxIterator{
+		feed:    feed,
+		bcname:  "xuper",
+		matcher: matcher,
+		ch:      make(chan *pb.Event, 1),
+		exitCh:  make(chan struct{}),
+	}
+	if err := feed.subscribe("xuper", slow); err != nil {
+		t.Fatal(err)
+	}
+	fast := subscribePending(t, feed, &pb.PendingTxFilter{Bcname: "xuper"})
+
+	// 缓存满的订阅被断开，不影响其他订阅
+	feed.Publish("xuper", newTestTx(1, 1, "alice"))
+	feed.Publish("xuper", newTestTx(2, 2, "alice"))
+	if !slow.Next() || slow.Next() {
+		t.Error("slow subscriber should get buffered event then stop")
+	}
+	if slow.Error() != errPendingTxSlow {
+		t.Errorf("expect slow error, got %v", slow.Error())
+	}
+	expectTxids(t, "fast", drainPending(t, fast), 1, 2)
+
+	slow.Close()
+	fast.Close()
+}
+
+func TestPendingTxFeedReadError(t *testing.T) {
+	brokenCh := make(chan struct{})
+	readErr := errors.New("pool broken")
+	feed := &PendingTxFeed{
+		reader: func(bcname string) (pendingReader, error) {
+			return func() ([]*lpb.Transaction, error) {
+				select {
+				case <-brokenCh:
+					return nil, readErr
+				default:
+					return nil, nil
+				}
+			}, nil
+		},
+		interval: time.Millisecond,
+		chains:   make(map[string]*pendingChain),
+	}
+	iter := subscribePending(t, feed, &pb.PendingTxFilter{Bcname: "xuper"})
+
+	// 读取交易池失败时断开全部订阅
+	close(brokenCh)
+	for iter.Next() {
+	}
+	if iter.Error() != readErr {
+		t.Errorf("expect read error, got %v", iter.Error())
+	}
+	iter.Close()
+}
@@ -0,0 +1,186 @@
+package event
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// Topic 事件源，迭代器Data()返回带游标的*pb.Event
+type Topic interface {
+	// ParseFilter 从bytes buffer反序列化过滤器，作为NewIterator的filter参数
+	ParseFilter(buf []byte) (interface{}, error)
+	// NewIterator 创建事件迭代器，start不为空时只推送游标大于start的事件
+	NewIterator(filter interface{}, start *pb.EventCursor) (event.Iterator, error)
+}
+
+// Router 按订阅类型分发事件
+type Router struct {
+	topics map[pb.SubscribeType]Topic
+}
+
+// pendingTxs为各服务共享的交易池事件源
+func NewRouter(engine ecom.Engine, pendingTxs *PendingTxFeed) *Router {
+	chainMG := event.NewChainManager(engine)
+	r := &Router{
+		topics: make(map[pb.SubscribeType]Topic),
+	}
+	r.topics[pb.SubscribeType_BLOCK] = NewBlockTopic(chainMG)
+	r.topics[pb.SubscribeType_TRANSACTION] = NewTxTopic(engine, chainMG)
+	r.topics[pb.SubscribeType_ACCOUNT] = NewAccountTopic(chainMG)
+	r.topics[pb.SubscribeType_PENDING_TX] = NewPendingTxTopic(pendingTxs)
+
+	return r
+}
+
+// Subscribe 根据订阅请求创建事件迭代器
+func (r *Router) Subscribe(req *pb.SubscribeRequest) (event.Iterator, error) {
+	topic, ok := r.topics[req.GetType()]
+	if !ok {
+		return nil, fmt.Errorf("subscribe type %s unsupported", req.GetType())
+	}
+	filter, err := topic.ParseFilter(req.GetFilter())
+	if err != nil {
+		return nil, fmt.Errorf("parse filter error: %s", err)
+	}
+	return topic.NewIterator(filter, req.GetStartCursor())
+}
+
+func newEvent(cursor *pb.EventCursor, msg proto.Message) (*pb.Event, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Event{
+		Payload: payload,
+		Cursor:  cursor,
+	}, nil
+}
+
+// CompareCursor 按区块高度、交易序号、事件序号依次比较游标
+func CompareCursor(a, b *pb.EventCursor) int {
+	switch {
+	case a.GetBlockHeight() != b.GetBlockHeight():
+		return compareInt64(a.GetBlockHeight(), b.GetBlockHeight())
+	case a.GetTxIndex() != b.GetTxIndex():
+		return compareInt64(int64(a.GetTxIndex()), int64(b.GetTxIndex()))
+	default:
+		return compareInt64(int64(a.GetEventIndex()), int64(b.GetEventIndex()))
+	}
+}
+
+func compareInt64(a, b int64) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+// 解析区块范围，start为空时从最新区块开始，end为空时不结束
+// 指定游标时从游标所在区块开始，该区块内已推送的事件由迭代器跳过
+func parseRange(store event.BlockStore, r *pb.BlockRange, cursor *pb.EventCursor) (int64, int64, error) {
+	var start, end int64
+	if r.GetStart() == "" {
+		n, err := store.TipBlockHeight()
+		if err != nil {
+			return 0, 0, err
+		}
+		start = n
+	} else {
+		n, err := strconv.ParseInt(r.GetStart(), 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("error %s when parse start block number", err)
+		}
+		start = n
+	}
+	if cursor != nil && (r.GetStart() == "" || cursor.GetBlockHeight() > start) {
+		start = cursor.GetBlockHeight()
+	}
+
+	if r.GetEnd() == "" {
+		end = -1
+	} else {
+		n, err := strconv.ParseInt(r.GetEnd(), 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("error %s when parse end block number", err)
+		}
+		end = n
+	}
+
+	return start, end, nil
+}
+
+// flatIterator 将上游迭代器的一条数据展开为多条事件，跳过游标不大于start的事件
+type flatIterator struct {
+	iter   event.Iterator
+	expand func(interface{}) ([]*pb.Event, error)
+	start  *pb.EventCursor
+	queue  []*pb.Event
+	data   *pb.Event
+	err    error
+	// 剩余可推送事件数，小于0表示不限制
+	remain int
+}
+
+func newFlatIterator(iter event.Iterator, expand func(interface{}) ([]*pb.Event, error),
+	start *pb.EventCursor, limit int) *flatIterator {
+
+	return &flatIterator{
+		iter:   iter,
+		expand: expand,
+		start:  start,
+		remain: limit,
+	}
+}
+
+func (t *flatIterator) Next() bool {
+	if t.remain == 0 || t.err != nil {
+		return false
+	}
+	for len(t.queue) == 0 {
+		if !t.iter.Next() {
+			return false
+		}
+		events, err := t.expand(t.iter.Data())
+		if err != nil {
+			t.err = err
+			return false
+		}
+		for _, ev := range events {
+			if t.start == nil || CompareCursor(ev.GetCursor(), t.start) > 0 {
+				t.queue = append(t.queue, ev)
+			}
+		}
+	}
+
+	t.data = t.queue[0]
+	t.queue = t.queue[1:]
+	if t.remain > 0 {
+		t.remain--
+	}
+	return true
+}
+
+func (t *flatIterator) Data() interface{} {
+	return t.data
+}
+
+func (t *flatIterator) Error() error {
+	if t.err != nil {
+		return t.err
+	}
+	return t.iter.Error()
+}
+
+func (t *flatIterator) Close() {
+	t.iter.Close()
+}
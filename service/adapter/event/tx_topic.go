@@ -0,0 +1,144 @@
+package event
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+var _ Topic = (*TxTopic)(nil)
+
+// TxTopic 单笔交易事件，游标为(区块高度,交易序号,0)
+type TxTopic struct {
+	engine  ecom.Engine
+	chainMG event.ChainManager
+}
+
+func NewTxTopic(engine ecom.Engine, chainMG event.ChainManager) *TxTopic {
+	return &TxTopic{
+		engine:  engine,
+		chainMG: chainMG,
+	}
+}
+
+func (t *TxTopic) ParseFilter(buf []byte) (interface{}, error) {
+	filter := new(pb.TxFilter)
+	err := proto.Unmarshal(buf, filter)
+	if err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+func (t *TxTopic) NewIterator(ifilter interface{}, start *pb.EventCursor) (event.Iterator, error) {
+	filter, ok := ifilter.(*pb.TxFilter)
+	if !ok {
+		return nil, errors.New("bad filter type for transaction event")
+	}
+	matcher, err := newTxMatcher(filter.GetContract(), filter.GetEventName(), filter.GetInitiator(),
+		filter.GetAuthRequire(), filter.GetFromAddr(), filter.GetToAddr())
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := t.chainMG.GetBlockStore(filter.GetBcname())
+	if err != nil {
+		return nil, err
+	}
+	begin, end, err := parseRange(store, filter.GetRange(), start)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := -1
+	if filter.GetTxid() != "" {
+		// 只推送指定交易，已上链时直接定位所在区块
+		limit = 1
+		height, found, err := t.locateTx(filter.GetBcname(), filter.GetTxid())
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			begin, end = height, height+1
+		}
+	}
+
+	expand := func(x interface{}) ([]*pb.Event, error) {
+		block := x.(*lpb.InternalBlock)
+		events := make([]*pb.Event, 0)
+		for i, tx := range block.GetTransactions() {
+			txid := hex.EncodeToString(tx.GetTxid())
+			if filter.GetTxid() != "" && txid != filter.GetTxid() {
+				continue
+			}
+			if !matcher.matchTx(tx) {
+				continue
+			}
+
+			txEvent := &pb.TxEvent{
+				Bcname:      filter.GetBcname(),
+				Blockid:     hex.EncodeToString(block.GetBlockid()),
+				BlockHeight: block.GetHeight(),
+				Txid:        txid,
+			}
+			// 有事件名过滤时，没有匹配事件的交易不推送
+			contractEvents := matcher.matchEvents(tx)
+			if matcher.hasEventFilter() && len(contractEvents) == 0 {
+				continue
+			}
+			if !filter.GetExcludeTxEvent() {
+				for _, e := range contractEvents {
+					txEvent.Events = append(txEvent.Events, &pb.ContractEvent{
+						Contract: e.GetContract(),
+						Name:     e.GetName(),
+						Body:     e.GetBody(),
+					})
+				}
+			}
+
+			cursor := &pb.EventCursor{
+				BlockHeight: block.GetHeight(),
+				TxIndex:     int32(i),
+			}
+			ev, err := newEvent(cursor, txEvent)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, ev)
+		}
+		return events, nil
+	}
+	biter := event.NewBlockIterator(store, begin, end)
+	return newFlatIterator(biter, expand, start, limit), nil
+}
+
+// 查询交易所在区块高度，交易未上链时found为false
+func (t *TxTopic) locateTx(bcname, txid string) (int64, bool, error) {
+	rawTxid, err := hex.DecodeString(txid)
+	if err != nil {
+		return 0, false, fmt.Errorf("bad txid %s", txid)
+	}
+	chain, err := t.engine.Get(bcname)
+	if err != nil {
+		return 0, false, fmt.Errorf("chain %s not found", bcname)
+	}
+
+	ledger := chain.Context().Ledger
+	tx, err := ledger.QueryTransaction(rawTxid)
+	if err != nil {
+		return 0, false, nil
+	}
+	block, err := ledger.QueryBlockHeader(tx.GetBlockid())
+	if err != nil {
+		return 0, false, err
+	}
+	return block.GetHeight(), true, nil
+}
@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/xuperchain/xupercore/lib/utils"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/xuperchain/xuperos/common/loglevel"
+	"github.com/xuperchain/xuperos/common/metrics"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+const (
+	// 客户端可以通过该header指定logid，响应中返回实际使用的logid
+	logIdHeader = "X-Log-Id"
+	maxLogIdLen = 64
+
+	// 未注册的路径统一计入other，避免指标基数随请求路径增长
+	otherRoute = "other"
+)
+
+// 指标中的method限定为标准方法
+var knownMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPost:    true,
+	http.MethodPut:     true,
+	http.MethodPatch:   true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+// responseRecorder 记录响应状态码和body大小，
+// 事件订阅依赖Flusher和Hijacker，需要透传
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+func (t *responseRecorder) WriteHeader(code int) {
+	if t.status == 0 {
+		t.status = code
+	}
+	t.ResponseWriter.WriteHeader(code)
+}
+
+func (t *responseRecorder) Write(buf []byte) (int, error) {
+	if t.status == 0 {
+		t.status = http.StatusOK
+	}
+	n, err := t.ResponseWriter.Write(buf)
+	t.size += int64(n)
+	return n, err
+}
+
+func (t *responseRecorder) Flush() {
+	if flusher, ok := t.ResponseWriter.(http.Flusher); ok {
+		if t.status == 0 {
+			t.status = http.StatusOK
+		}
+		flusher.Flush()
+	}
+}
+
+func (t *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := t.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, fmt.Errorf("response writer not support hijack")
+	}
+	// websocket升级后由连接自行响应
+	if t.status == 0 {
+		t.status = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
+func (t *responseRecorder) code() int {
+	if t.status == 0 {
+		return http.StatusOK
+	}
+	return t.status
+}
+
+// 使用客户端传入的logid，没有或者不合法时生成新的
+func requestLogId(r *http.Request) string {
+	logid := r.Header.Get(logIdHeader)
+	if len(logid) == 0 || len(logid) > maxLogIdLen {
+		return utils.GenLogId()
+	}
+	for _, c := range logid {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+			c == '_' || c == '-' || c == '.') {
+			return utils.GenLogId()
+		}
+	}
+	return logid
+}
+
+// 网关转发grpc请求时附加的metadata，由access在请求上下文中设置
+func forwardMetadata(_ context.Context, r *http.Request) metadata.MD {
+	md, _ := metadata.FromOutgoingContext(r.Context())
+	return md
+}
+
+// access 输出访问日志并统计请求指标，路由不在routes中的计入other
+func (t *Gateway) access(routes map[string]bool, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		begin := time.Now()
+		logid := requestLogId(r)
+		w.Header().Set(logIdHeader, logid)
+		// 通过grpc metadata传给rpc服务，请求header中没有logid时使用，便于关联日志
+		r = r.WithContext(metadata.AppendToOutgoingContext(r.Context(), acom.LogIdKey, logid))
+
+		rec := &responseRecorder{ResponseWriter: w}
+		h.ServeHTTP(rec, r)
+
+		cost := time.Since(begin)
+		route := r.URL.Path
+		if !routes[route] {
+			route = otherRoute
+		}
+		method := r.Method
+		if !knownMethods[method] {
+			method = otherRoute
+		}
+		metrics.GatewayRequestCounter.WithLabelValues(route, method, strconv.Itoa(rec.code())).Inc()
+		metrics.GatewayLatencyHistogram.WithLabelValues(route, method).Observe(cost.Seconds())
+
+		// 日志的log_id与rpc服务一致
+		log, err := loglevel.NewLogger(logid, "gateway")
+		if err != nil {
+			log = t.log
+		}
+		log.Info("access", "client_ip", r.RemoteAddr, "method", r.Method,
+			"path", r.URL.Path, "status", rec.code(), "size", rec.size,
+			"cost_time", fmt.Sprintf("%.3fms", float64(cost.Microseconds())/1000),
+			"origin", r.Header.Get("Origin"), "user_agent", r.UserAgent())
+	})
+}
@@ -0,0 +1,147 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+const anyOrigin = "*"
+
+// corsPolicy 按配置处理跨域请求，来源不匹配的预检请求返回403，
+// 不匹配的普通请求正常处理但不返回跨域响应头，由浏览器拦截
+type corsPolicy struct {
+	any     bool
+	origins map[string]bool
+	// 通配子域名，scheme://.example.com
+	wildcards []string
+
+	methods      map[string]bool
+	headers      map[string]bool
+	allowMethods string
+	allowHeaders string
+	credentials  bool
+	maxAge       string
+}
+
+func newCorsPolicy(conf sconf.GatewayCorsConf) (*corsPolicy, error) {
+	t := &corsPolicy{
+		origins:      make(map[string]bool),
+		methods:      make(map[string]bool),
+		headers:      make(map[string]bool),
+		allowMethods: strings.Join(conf.AllowMethods, ","),
+		allowHeaders: strings.Join(conf.AllowHeaders, ","),
+		credentials:  conf.AllowCredentials,
+	}
+	if conf.MaxAge > 0 {
+		t.maxAge = strconv.Itoa(conf.MaxAge)
+	}
+
+	for _, raw := range conf.AllowOrigins {
+		origin := strings.ToLower(strings.TrimSuffix(raw, "/"))
+		if origin == anyOrigin {
+			t.any = true
+			continue
+		}
+		idx := strings.Index(origin, "://")
+		if idx <= 0 || idx+3 == len(origin) {
+			return nil, fmt.Errorf("invalid cors origin: %s", raw)
+		}
+		host := origin[idx+3:]
+		if strings.HasPrefix(host, "*.") && !strings.Contains(host[2:], "*") {
+			t.wildcards = append(t.wildcards, origin[:idx+3]+host[1:])
+			continue
+		}
+		if strings.Contains(host, "*") || strings.Contains(host, "/") {
+			return nil, fmt.Errorf("invalid cors origin: %s", raw)
+		}
+		t.origins[origin] = true
+	}
+	if t.any && t.credentials {
+		return nil, fmt.Errorf("cors allowCredentials can not be used with origin *")
+	}
+
+	for _, method := range conf.AllowMethods {
+		t.methods[strings.ToUpper(method)] = true
+	}
+	for _, header := range conf.AllowHeaders {
+		t.headers[strings.ToLower(header)] = true
+	}
+	return t, nil
+}
+
+func (t *corsPolicy) allowOrigin(origin string) bool {
+	origin = strings.ToLower(origin)
+	if t.any || t.origins[origin] {
+		return true
+	}
+	for _, wildcard := range t.wildcards {
+		// 只匹配子域名，不匹配域名本身
+		idx := strings.Index(wildcard, "://") + 3
+		if strings.HasPrefix(origin, wildcard[:idx]) && strings.HasSuffix(origin, wildcard[idx:]) &&
+			len(origin) > len(wildcard) {
+			return true
+		}
+	}
+	return false
+}
+
+// 处理跨域请求，返回true时已经完成响应
+func (t *corsPolicy) handle(w http.ResponseWriter, r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return false
+	}
+	w.Header().Add("Vary", "Origin")
+
+	preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+	if !t.allowOrigin(origin) {
+		if preflight {
+			http.Error(w, "cors origin not allowed", http.StatusForbidden)
+			return true
+		}
+		return false
+	}
+
+	if !preflight {
+		t.setOrigin(w, origin)
+		return false
+	}
+
+	if !t.methods[strings.ToUpper(r.Header.Get("Access-Control-Request-Method"))] {
+		http.Error(w, "cors method not allowed", http.StatusForbidden)
+		return true
+	}
+	for _, header := range strings.Split(r.Header.Get("Access-Control-Request-Headers"), ",") {
+		header = strings.ToLower(strings.TrimSpace(header))
+		if header != "" && !t.headers[header] {
+			http.Error(w, "cors header not allowed", http.StatusForbidden)
+			return true
+		}
+	}
+
+	t.setOrigin(w, origin)
+	w.Header().Set("Access-Control-Allow-Methods", t.allowMethods)
+	if t.allowHeaders != "" {
+		w.Header().Set("Access-Control-Allow-Headers", t.allowHeaders)
+	}
+	if t.maxAge != "" {
+		w.Header().Set("Access-Control-Max-Age", t.maxAge)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return true
+}
+
+func (t *corsPolicy) setOrigin(w http.ResponseWriter, origin string) {
+	if t.any {
+		w.Header().Set("Access-Control-Allow-Origin", anyOrigin)
+		return
+	}
+	w.Header().Set("Access-Control-Allow-Origin", origin)
+	if t.credentials {
+		w.Header().Set("Access-Control-Allow-Credentials", "true")
+	}
+}
@@ -0,0 +1,128 @@
+package gateway
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestCorsAllowOrigin(t *testing.T) {
+	cors, err := newCorsPolicy(sconf.GatewayCorsConf{
+		AllowOrigins: []string{"https://wallet.example.com/", "https://*.xuper.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		origin string
+		allow  bool
+	}{
+		{origin: "https://wallet.example.com", allow: true},
+		{origin: "HTTPS://Wallet.Example.com", allow: true},
+		{origin: "http://wallet.example.com"},
+		{origin: "https://evil.example.com"},
+		{origin: "https://app.xuper.com", allow: true},
+		{origin: "https://a.b.xuper.com", allow: true},
+		// 通配不匹配域名本身
+		{origin: "https://xuper.com"},
+		{origin: "https://.xuper.com"},
+		{origin: "https://evilxuper.com"},
+		{origin: "http://app.xuper.com"},
+	}
+	for _, c := range cases {
+		if got := cors.allowOrigin(c.origin); got != c.allow {
+			t.Errorf("origin %s: allow %v, expect %v", c.origin, got, c.allow)
+		}
+	}
+}
+
+func TestNewCorsPolicy(t *testing.T) {
+	invalid := []sconf.GatewayCorsConf{
+		{AllowOrigins: []string{"*"}, AllowCredentials: true},
+		{AllowOrigins: []string{"wallet.example.com"}},
+		{AllowOrigins: []string{"https://"}},
+		{AllowOrigins: []string{"https://wallet.*.com"}},
+		{AllowOrigins: []string{"https://*.*.com"}},
+		{AllowOrigins: []string{"https://example.com/path"}},
+	}
+	for _, conf := range invalid {
+		if _, err := newCorsPolicy(conf); err == nil {
+			t.Errorf("cors %+v should be invalid", conf)
+		}
+	}
+
+	cors, err := newCorsPolicy(sconf.GatewayCorsConf{AllowOrigins: []string{"*"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cors.allowOrigin("https://any.example.com") {
+		t.Error("any origin should be allowed")
+	}
+	cors, err = newCorsPolicy(sconf.GatewayCorsConf{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cors.allowOrigin("https://wallet.example.com") {
+		t.Error("no origin should be allowed by default")
+	}
+}
+
+func TestCorsHandle(t *testing.T) {
+	cors, err := newCorsPolicy(sconf.GatewayCorsConf{
+		AllowOrigins:     []string{"https://wallet.example.com"},
+		AllowMethods:     []string{"GET", "POST"},
+		AllowHeaders:     []string{"Content-Type"},
+		AllowCredentials: true,
+		MaxAge:           600,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := func(method, origin, reqMethod, reqHeaders string) (*httptest.ResponseRecorder, bool) {
+		r := httptest.NewRequest(method, "/v1/get_balance", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		if reqMethod != "" {
+			r.Header.Set("Access-Control-Request-Method", reqMethod)
+		}
+		if reqHeaders != "" {
+			r.Header.Set("Access-Control-Request-Headers", reqHeaders)
+		}
+		w := httptest.NewRecorder()
+		return w, cors.handle(w, r)
+	}
+
+	// 同源请求不处理
+	if w, done := request(http.MethodGet, "", "", ""); done || w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Error("request without origin should be passed through")
+	}
+	// 来源不匹配的普通请求不带跨域响应头
+	if w, done := request(http.MethodGet, "https://evil.com", "", ""); done || w.Header().Get("Access-Control-Allow-Origin") != "" {
+		t.Error("request from other origin should not get cors headers")
+	}
+	w, done := request(http.MethodPost, "https://wallet.example.com", "", "")
+	if done || w.Header().Get("Access-Control-Allow-Origin") != "https://wallet.example.com" ||
+		w.Header().Get("Access-Control-Allow-Credentials") != "true" {
+		t.Errorf("unexpected headers of allowed request %v", w.Header())
+	}
+
+	w, done = request(http.MethodOptions, "https://wallet.example.com", "POST", "content-type")
+	if !done || w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Max-Age") != "600" ||
+		w.Header().Get("Access-Control-Allow-Methods") != "GET,POST" {
+		t.Errorf("unexpected preflight response %d %v", w.Code, w.Header())
+	}
+	for _, c := range []struct{ origin, method, headers string }{
+		{origin: "https://evil.com", method: "POST"},
+		{origin: "https://wallet.example.com", method: "DELETE"},
+		{origin: "https://wallet.example.com", method: "POST", headers: "Content-Type, X-Token"},
+	} {
+		if w, done := request(http.MethodOptions, c.origin, c.method, c.headers); !done || w.Code != http.StatusForbidden {
+			t.Errorf("preflight %+v should be rejected, got %d", c, w.Code)
+		}
+	}
+}
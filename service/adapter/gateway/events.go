@@ -0,0 +1,243 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+const (
+	eventsWSPath  = "/v1/events/ws"
+	eventsSSEPath = "/v1/events/sse"
+
+	// 客户端传入filter的最大长度
+	maxFilterSize = 64 << 10
+	// websocket写超时
+	wsWriteTimeout = 10 * time.Second
+)
+
+// eventBridge 将EventService的区块订阅以websocket和SSE方式暴露给浏览器
+// 过滤条件为json格式的BlockFilter，推送json格式的FilteredBlock，格式与xchain-cli watch一致
+type eventBridge struct {
+	scfg     *sconf.ServConf
+	log      logs.Logger
+	cors     *corsPolicy
+	client   pb.EventServiceClient
+	upgrader websocket.Upgrader
+}
+
+func newEventBridge(scfg *sconf.ServConf, log logs.Logger, cors *corsPolicy, conn *grpc.ClientConn) *eventBridge {
+	t := &eventBridge{
+		scfg:   scfg,
+		log:    log,
+		cors:   cors,
+		client: pb.NewEventServiceClient(conn),
+	}
+	t.upgrader = websocket.Upgrader{
+		CheckOrigin: t.checkOrigin,
+	}
+	return t
+}
+
+func (t *eventBridge) register(mux *http.ServeMux) {
+	mux.HandleFunc(eventsWSPath, t.serveWS)
+	mux.HandleFunc(eventsSSEPath, t.serveSSE)
+}
+
+// 接受同源请求和跨域策略允许的来源
+func (t *eventBridge) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || t.cors.allowOrigin(origin) {
+		return true
+	}
+	return strings.HasSuffix(origin, "://"+r.Host)
+}
+
+// serveWS filter通过query参数filter传入，未传入时读取客户端的第一条消息
+func (t *eventBridge) serveWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := t.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade已经返回错误响应
+		t.log.Warn("websocket upgrade failed", "ip", r.RemoteAddr, "err", err)
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxFilterSize)
+
+	buf := []byte(r.URL.Query().Get("filter"))
+	if len(buf) == 0 {
+		_, buf, err = conn.ReadMessage()
+		if err != nil {
+			return
+		}
+	}
+	req, err := t.newRequest(buf, nil)
+	if err != nil {
+		t.closeWS(conn, websocket.CloseUnsupportedData, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	// 读取客户端消息以处理close和pong，连接断开时结束订阅
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = t.subscribe(ctx, r, req, func(ev *pb.Event, block interface{}) error {
+		conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		if block == nil {
+			return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout))
+		}
+		return conn.WriteJSON(block)
+	})
+	if err != nil {
+		t.closeWS(conn, websocket.CloseInternalServerErr, err.Error())
+		return
+	}
+	t.closeWS(conn, websocket.CloseNormalClosure, "")
+}
+
+func (t *eventBridge) closeWS(conn *websocket.Conn, code int, text string) {
+	msg := websocket.FormatCloseMessage(code, text)
+	conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(wsWriteTimeout))
+}
+
+// serveSSE filter通过query参数filter或POST body传入
+// 事件id为游标，浏览器重连时通过Last-Event-ID从断开处继续
+func (t *eventBridge) serveSSE(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	buf := []byte(r.URL.Query().Get("filter"))
+	if len(buf) == 0 && r.Method == http.MethodPost {
+		buf, _ = ioutil.ReadAll(io.LimitReader(r.Body, maxFilterSize))
+	}
+	cursor, err := acom.ParseCursor(r.Header.Get("Last-Event-ID"))
+	if err != nil {
+		http.Error(w, "bad Last-Event-ID: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	req, err := t.newRequest(buf, cursor)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	err = t.subscribe(r.Context(), r, req, func(ev *pb.Event, block interface{}) error {
+		if block == nil {
+			_, err := io.WriteString(w, ": heartbeat\n\n")
+			flusher.Flush()
+			return err
+		}
+		data, err := json.Marshal(block)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "id: %s\nevent: block\ndata: %s\n\n", acom.FormatCursor(ev.GetCursor()), data)
+		flusher.Flush()
+		return err
+	})
+	if err != nil {
+		fmt.Fprintf(w, "event: error\ndata: %s\n\n", strings.Replace(err.Error(), "\n", " ", -1))
+		flusher.Flush()
+	}
+}
+
+func (t *eventBridge) newRequest(buf []byte, cursor *pb.EventCursor) (*pb.SubscribeRequest, error) {
+	filter := new(pb.BlockFilter)
+	if len(buf) > 0 {
+		if err := json.Unmarshal(buf, filter); err != nil {
+			return nil, fmt.Errorf("bad filter: %v", err)
+		}
+	}
+	if filter.Bcname == "" {
+		return nil, fmt.Errorf("filter bcname unset")
+	}
+	payload, err := proto.Marshal(filter)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SubscribeRequest{
+		Type:        pb.SubscribeType_BLOCK,
+		Filter:      payload,
+		StartCursor: cursor,
+	}, nil
+}
+
+// 转发订阅，send的block为nil时表示心跳
+// 客户端地址通过metadata传给事件服务，单地址连接数限制按浏览器客户端计算
+func (t *eventBridge) subscribe(ctx context.Context, r *http.Request, req *pb.SubscribeRequest,
+	send func(ev *pb.Event, block interface{}) error) error {
+
+	if ip, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		ctx = metadata.AppendToOutgoingContext(ctx, acom.ForwardedForKey, ip)
+	}
+	stream, err := t.client.Subscribe(ctx, req)
+	if err != nil {
+		return errorDesc(err)
+	}
+
+	for {
+		ev, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return errorDesc(err)
+		}
+		if ev.GetHeartbeat() {
+			if err := send(ev, nil); err != nil {
+				return nil
+			}
+			continue
+		}
+
+		block := new(pb.FilteredBlock)
+		if err := proto.Unmarshal(ev.GetPayload(), block); err != nil {
+			return err
+		}
+		if err := send(ev, acom.FromFilteredBlockPB(block)); err != nil {
+			// 客户端断开
+			return nil
+		}
+	}
+}
+
+func errorDesc(err error) error {
+	if st, ok := status.FromError(err); ok {
+		return fmt.Errorf("%s", st.Message())
+	}
+	return err
+}
@@ -4,35 +4,61 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
-	"strings"
+	"path/filepath"
 	"sync"
 
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/xuperchain/xupercore/lib/logs"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	xupospb "github.com/xuperchain/xuperos/common/xupospb"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	scom "github.com/xuperchain/xuperos/service/common"
 )
 
 type Gateway struct {
 	scfg     *sconf.ServConf
+	tlsPath  string
 	log      logs.Logger
+	cors     *corsPolicy
 	server   *http.Server
+	ctx      context.Context
+	cancel   context.CancelFunc
 	isInit   bool
 	exitOnce *sync.Once
 }
 
-func NewGateway(scfg *sconf.ServConf) (*Gateway, error) {
+// tlsPath为节点tls目录，enableTls或者开启https时加载其中的证书
+func NewGateway(scfg *sconf.ServConf, tlsPath string) (*Gateway, error) {
 	if scfg == nil {
 		return nil, fmt.Errorf("param error")
 	}
 
-	log, _ := logs.NewLogger("", "gateway")
+	log, _ := loglevel.NewLogger("", "gateway")
+	corsConf := scfg.GatewayCors
+	if scfg.AdapterAllowCROS && len(corsConf.AllowOrigins) == 0 {
+		// 兼容旧配置
+		log.Warn("adapterAllowCROS is deprecated, use gatewayCors.allowOrigins instead, allow any origin now")
+		corsConf.AllowOrigins = []string{anyOrigin}
+	}
+	cors, err := newCorsPolicy(corsConf)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
 	obj := &Gateway{
 		scfg:     scfg,
+		tlsPath:  tlsPath,
 		log:      log,
+		cors:     cors,
+		ctx:      ctx,
+		cancel:   cancel,
 		isInit:   true,
 		exitOnce: &sync.Once{},
 	}
@@ -73,14 +99,24 @@ func (t *Gateway) runGateway() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{
-		grpc.WithInsecure(),
+	mux := runtime.NewServeMux(runtime.WithMetadata(forwardMetadata))
+	baseOpts := []grpc.DialOption{
 		grpc.WithInitialWindowSize(t.scfg.InitWindowSize),
 		grpc.WithWriteBufferSize(t.scfg.WriteBufSize),
 		grpc.WithInitialConnWindowSize(t.scfg.InitConnWindowSize),
 		grpc.WithReadBufferSize(t.scfg.ReadBufSize),
 	}
+	opts := baseOpts
+	// adapterRpcPort使用tls时以节点证书作为客户端证书
+	if t.scfg.AdapterRpcTls() {
+		loader, err := scom.NewTlsLoader(t.scfg.Tls, t.tlsPath, t.scfg.TlsServerName, t.log)
+		if err != nil {
+			return fmt.Errorf("load tls config failed.err:%v", err)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(loader.ClientConfig())))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
 
 	rpcEndpoint := fmt.Sprintf(":%d", t.scfg.AdapterRpcPort)
 	err := pb.RegisterXchainHandlerFromEndpoint(ctx, mux, rpcEndpoint, opts)
@@ -95,51 +131,79 @@ func (t *Gateway) runGateway() error {
 		}
 	}
 
+	// 原生XuperOS服务，rpcPort始终是明文端口
+	nativeEndpoint := fmt.Sprintf(":%d", t.scfg.RpcPort)
+	nativeOpts := append(baseOpts[:len(baseOpts):len(baseOpts)], grpc.WithInsecure())
+	err = xupospb.RegisterXuperOSHandlerFromEndpoint(ctx, mux, nativeEndpoint, nativeOpts)
+	if err != nil {
+		return err
+	}
+
+	handler := http.NewServeMux()
+	handler.Handle("/", mux)
+	// 接口文档
+	doc, err := newOpenapiDoc(t.scfg)
+	if err != nil {
+		return err
+	}
+	doc.register(handler)
+	routes := doc.routes()
+	// 事件订阅是服务端流，单独提供websocket和SSE接口
+	if t.scfg.EnableEvent {
+		conn, err := grpc.DialContext(ctx, rpcEndpoint, opts...)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		newEventBridge(t.scfg, t.log, t.cors, conn).register(handler)
+		routes[eventsWSPath] = true
+		routes[eventsSSEPath] = true
+	}
+
 	addr := fmt.Sprintf(":%d", t.scfg.AdapterGWPort)
 	t.server = &http.Server{
 		Addr:    addr,
-		Handler: t.interupt(mux),
+		Handler: t.access(routes, t.interupt(handler)),
+		// 退出时取消请求上下文，结束长连接的事件订阅
+		BaseContext: func(net.Listener) context.Context {
+			return t.ctx
+		},
+	}
+	if t.scfg.GatewayTls.EnableHttps {
+		err = t.server.ListenAndServeTLS(t.tlsFile(t.scfg.GatewayTls.CertFile),
+			t.tlsFile(t.scfg.GatewayTls.KeyFile))
+	} else {
+		err = t.server.ListenAndServe()
 	}
-	err = t.server.ListenAndServe()
 	if err != http.ErrServerClosed {
 		return err
 	}
 	return nil
 }
 
+// 证书文件相对tls目录
+func (t *Gateway) tlsFile(fname string) string {
+	if filepath.IsAbs(fname) {
+		return fname
+	}
+	return filepath.Join(t.tlsPath, fname)
+}
+
 func (t *Gateway) stopGateway() {
+	t.cancel()
 	if t.server != nil {
 		t.server.Shutdown(context.Background())
 	}
 }
 
-// interupt
+// interupt 处理跨域请求
 func (t *Gateway) interupt(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		// allow CROS requests
-		// Note: CROS is kind of dangerous in production environment
-		// don't use this without consideration
-		if t.scfg.AdapterAllowCROS {
-			if origin := r.Header.Get("Origin"); origin != "" {
-				w.Header().Set("Access-Control-Allow-Origin", origin)
-				if r.Method == "OPTIONS" && r.Header.Get("Access-Control-Request-Method") != "" {
-					t.preflightHandler(w, r)
-					return
-				}
-			}
+		// 跨域请求按配置的策略处理，预检请求在此结束
+		if t.cors.handle(w, r) {
+			return
 		}
 
 		h.ServeHTTP(w, r)
-
-		// Request log
-		t.log.Trace("gateway access request", "ip", r.RemoteAddr, "method", r.Method, "url", r.URL.Path)
 	})
 }
-
-func (t *Gateway) preflightHandler(w http.ResponseWriter, r *http.Request) {
-	headers := []string{"Content-Type", "Accept"}
-	w.Header().Set("Access-Control-Allow-Headers", strings.Join(headers, ","))
-	methods := []string{"GET", "HEAD", "POST", "PUT", "DELETE"}
-	w.Header().Set("Access-Control-Allow-Methods", strings.Join(methods, ","))
-	return
-}
@@ -0,0 +1,154 @@
+package gateway
+
+//go:generate go run openapi_gen.go
+//go:generate go run swagger_ui_gen.go
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/def"
+)
+
+const (
+	openapiPath = "/openapi.json"
+	swaggerPath = "/swagger/"
+
+	// xendorser.proto中背书服务的tag
+	endorserTag = "xendorser"
+	// swagger-ui的首页
+	swaggerIndex = "index.html"
+)
+
+// 静态资源编译进节点，使用进程启动时间作为修改时间，支持浏览器缓存校验
+var swaggerModTime = time.Now()
+
+// openapiDoc 网关http接口的openapi v2文档，由xchain.proto、xendorser.proto和xuperos.proto的http注解生成
+// 文档版本为节点的编译版本，未开启背书服务时去掉背书接口
+type openapiDoc struct {
+	spec  []byte
+	paths []string
+}
+
+func newOpenapiDoc(scfg *sconf.ServConf) (*openapiDoc, error) {
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(openapiSpec, &doc); err != nil {
+		return nil, err
+	}
+
+	doc["info"] = map[string]interface{}{
+		"title":       "XuperChain Gateway API",
+		"description": "HTTP bindings of the Xchain, Xendorser and native XuperOS services served by this node.",
+		"version":     nodeVersion(),
+	}
+	scheme := "http"
+	if scfg.GatewayTls.EnableHttps {
+		scheme = "https"
+	}
+	doc["schemes"] = []string{scheme}
+
+	if paths, ok := doc["paths"].(map[string]interface{}); ok && !scfg.EnableEndorser {
+		for path, item := range paths {
+			ops, _ := item.(map[string]interface{})
+			for method, op := range ops {
+				if hasTag(op, endorserTag) {
+					delete(ops, method)
+				}
+			}
+			if len(ops) == 0 {
+				delete(paths, path)
+			}
+		}
+	}
+
+	var paths []string
+	if items, ok := doc["paths"].(map[string]interface{}); ok {
+		for path := range items {
+			paths = append(paths, path)
+		}
+	}
+
+	spec, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &openapiDoc{spec: spec, paths: paths}, nil
+}
+
+func (t *openapiDoc) register(mux *http.ServeMux) {
+	mux.HandleFunc(openapiPath, t.serveSpec)
+	mux.HandleFunc(swaggerPath, t.serveUI)
+	mux.Handle(strings.TrimSuffix(swaggerPath, "/"), http.RedirectHandler(swaggerPath, http.StatusMovedPermanently))
+}
+
+// 文档中的接口路径和文档自身的路径，用于请求指标
+func (t *openapiDoc) routes() map[string]bool {
+	routes := map[string]bool{
+		openapiPath:                          true,
+		swaggerPath:                          true,
+		strings.TrimSuffix(swaggerPath, "/"): true,
+	}
+	for name := range swaggerUIAssets {
+		routes[swaggerPath+name] = true
+	}
+	for _, path := range t.paths {
+		routes[path] = true
+	}
+	return routes
+}
+
+func (t *openapiDoc) serveSpec(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(t.spec)
+}
+
+// serveUI 提供swagger-ui的静态资源，/swagger/为首页
+func (t *openapiDoc) serveUI(w http.ResponseWriter, r *http.Request) {
+	name := strings.TrimPrefix(r.URL.Path, swaggerPath)
+	if name == "" {
+		name = swaggerIndex
+	}
+	content, ok := swaggerUIAssets[name]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if ctype := mime.TypeByExtension(path.Ext(name)); ctype != "" {
+		w.Header().Set("Content-Type", ctype)
+	}
+	http.ServeContent(w, r, name, swaggerModTime, strings.NewReader(content))
+}
+
+func hasTag(op interface{}, tag string) bool {
+	fields, _ := op.(map[string]interface{})
+	tags, _ := fields["tags"].([]interface{})
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeVersion() string {
+	if def.Version == "" {
+		return "unknown"
+	}
+	if def.CommitID == "" {
+		return def.Version
+	}
+	return def.Version + "-" + def.CommitID
+}
@@ -0,0 +1,66 @@
+//go:build ignore
+// +build ignore
+
+// 将protoc-gen-swagger生成的openapi文档转换为go源码，编译进网关
+// protoc-gen-swagger不能合并不同package的proto，原生XuperOS服务的文档单独生成，在这里合并
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strconv"
+)
+
+const (
+	specFile    = "../../../common/xupospb/pb/xchain.swagger.json"
+	xuperosFile = "../../../common/xupospb/xuperos.swagger.json"
+	outputFile  = "openapi_spec.go"
+)
+
+func readSpec(fname string) map[string]interface{} {
+	buf, err := ioutil.ReadFile(fname)
+	if err != nil {
+		log.Fatalf("read openapi spec failed.err:%v", err)
+	}
+	spec := make(map[string]interface{})
+	if err := json.Unmarshal(buf, &spec); err != nil {
+		log.Fatalf("invalid openapi spec %s.err:%v", fname, err)
+	}
+	return spec
+}
+
+func main() {
+	spec := readSpec(specFile)
+	// 合并接口和类型定义，公共的错误类型两边相同
+	for key, value := range readSpec(xuperosFile) {
+		items, ok := value.(map[string]interface{})
+		if key != "paths" && key != "definitions" || !ok {
+			continue
+		}
+		merged, _ := spec[key].(map[string]interface{})
+		if merged == nil {
+			merged = make(map[string]interface{})
+			spec[key] = merged
+		}
+		for name, item := range items {
+			if _, ok := merged[name]; ok && key == "paths" {
+				log.Fatalf("duplicated path %s in %s", name, xuperosFile)
+			}
+			merged[name] = item
+		}
+	}
+	buf, err := json.Marshal(spec)
+	if err != nil {
+		log.Fatalf("marshal openapi spec failed.err:%v", err)
+	}
+
+	src := fmt.Sprintf("// Code generated by openapi_gen.go. DO NOT EDIT.\n\n"+
+		"package gateway\n\n"+
+		"// openapiSpec 由%s和%s生成\n"+
+		"var openapiSpec = []byte(%s)\n", specFile, xuperosFile, strconv.Quote(string(buf)))
+	if err := ioutil.WriteFile(outputFile, []byte(src), 0644); err != nil {
+		log.Fatalf("write %s failed.err:%v", outputFile, err)
+	}
+}
@@ -0,0 +1,70 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+func TestOpenapiDoc(t *testing.T) {
+	for _, enableEndorser := range []bool{false, true} {
+		doc, err := newOpenapiDoc(&sconf.ServConf{EnableEndorser: enableEndorser})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var spec struct {
+			Paths map[string]interface{}
+		}
+		if err := json.Unmarshal(doc.spec, &spec); err != nil {
+			t.Fatal(err)
+		}
+		if spec.Paths["/v1/get_bcstatus"] == nil || spec.Paths["/v1/xuperos/check_alive"] == nil {
+			t.Errorf("xchain and xuperos paths should be included")
+		}
+		if (spec.Paths["/v1/endorsercall"] != nil) != enableEndorser {
+			t.Errorf("endorser paths included %v, expect %v", !enableEndorser, enableEndorser)
+		}
+		if routes := doc.routes(); !routes["/v1/xuperos/check_alive"] || !routes[swaggerPath+"swagger-ui-bundle.js"] {
+			t.Errorf("unexpected routes %v", routes)
+		}
+	}
+}
+
+func TestServeUI(t *testing.T) {
+	doc := &openapiDoc{}
+	cases := []struct {
+		method string
+		path   string
+		code   int
+		ctype  string
+	}{
+		{method: http.MethodGet, path: swaggerPath, code: http.StatusOK, ctype: "text/html"},
+		{method: http.MethodGet, path: swaggerPath + "index.html", code: http.StatusOK, ctype: "text/html"},
+		{method: http.MethodGet, path: swaggerPath + "swagger-ui-bundle.js", code: http.StatusOK, ctype: "javascript"},
+		{method: http.MethodHead, path: swaggerPath + "swagger-ui.css", code: http.StatusOK, ctype: "text/css"},
+		{method: http.MethodGet, path: swaggerPath + "favicon-32x32.png", code: http.StatusOK, ctype: "image/png"},
+		{method: http.MethodGet, path: swaggerPath + "README.md", code: http.StatusNotFound},
+		{method: http.MethodGet, path: swaggerPath + "../openapi.json", code: http.StatusNotFound},
+		{method: http.MethodPost, path: swaggerPath, code: http.StatusMethodNotAllowed},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		doc.serveUI(w, httptest.NewRequest(c.method, c.path, nil))
+		if w.Code != c.code {
+			t.Errorf("%s %s: status %d, expect %d", c.method, c.path, w.Code, c.code)
+			continue
+		}
+		if ctype := w.Header().Get("Content-Type"); !strings.Contains(ctype, c.ctype) {
+			t.Errorf("%s %s: content type %s", c.method, c.path, ctype)
+		}
+	}
+
+	// 页面加载网关的接口文档
+	if !strings.Contains(swaggerUIAssets["swagger-initializer.js"], `"../openapi.json"`) {
+		t.Error("swagger-ui should load ../openapi.json")
+	}
+}
@@ -0,0 +1,61 @@
+//go:build ignore
+// +build ignore
+
+// 将swagger-ui的静态资源转换为go源码，编译进网关
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strconv"
+)
+
+const (
+	assetDir   = "swagger-ui"
+	outputFile = "swagger_ui_assets.go"
+)
+
+// 只打包页面需要的文件
+var assetExts = map[string]bool{
+	".html": true,
+	".css":  true,
+	".js":   true,
+	".png":  true,
+}
+
+func main() {
+	files, err := ioutil.ReadDir(assetDir)
+	if err != nil {
+		log.Fatalf("read swagger-ui dir failed.err:%v", err)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by swagger_ui_gen.go. DO NOT EDIT.\n\n" +
+		"package gateway\n\n" +
+		"// swaggerUIAssets 由" + assetDir + "目录生成，key为文件名\n" +
+		"var swaggerUIAssets = map[string]string{\n")
+	for _, file := range files {
+		if file.IsDir() || !assetExts[filepath.Ext(file.Name())] {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(assetDir, file.Name()))
+		if err != nil {
+			log.Fatalf("read %s failed.err:%v", file.Name(), err)
+		}
+		fmt.Fprintf(&out, "\t%s: %s,\n", strconv.Quote(file.Name()), strconv.Quote(string(buf)))
+	}
+	out.WriteString("}\n")
+
+	// 对齐map的key，和gofmt的结果一致
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		log.Fatalf("format source failed.err:%v", err)
+	}
+	if err := ioutil.WriteFile(outputFile, src, 0644); err != nil {
+		log.Fatalf("write %s failed.err:%v", outputFile, err)
+	}
+}
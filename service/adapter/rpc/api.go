@@ -4,13 +4,15 @@ import (
 	"context"
 	"math/big"
 
-	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/kernel/network/p2p"
 	"github.com/xuperchain/xupercore/lib/utils"
 	"github.com/xuperchain/xupercore/protos"
 	"github.com/xuperchain/xuperos/models"
 
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/metrics"
+	sutils "github.com/xuperchain/xuperos/common/utils"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 	acom "github.com/xuperchain/xuperos/service/adapter/common"
 )
@@ -29,14 +31,25 @@ func (t *RpcServ) PostTx(gctx context.Context, req *pb.TxStatus) (*pb.CommonRepl
 	rctx := sctx.ValueReqCtx(gctx)
 
 	// 校验参数
-	if req == nil || req.GetTx() == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetTx() != nil, "tx").
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
-	tx := acom.TxToXledger(req.GetTx())
+	tx := sutils.TxToXledger(req.GetTx())
 	if tx == nil {
 		rctx.GetLog().Warn("param error,tx convert to xledger tx failed")
-		return resp, ecom.ErrParameter
+		return resp, acom.NewParamChecker(req).Check(false, "tx", "is invalid").Err()
+	}
+
+	// 排空期间拒绝新交易
+	if t.drainer.IsDraining() {
+		rctx.GetLog().Warn("node is draining, reject tx")
+		t.speeds.Mark(req.GetBcname(), metrics.EventTxRejected, 1)
+		return resp, ecom.ErrForbidden
 	}
 
 	// 提交交易
@@ -53,12 +66,57 @@ func (t *RpcServ) PostTx(gctx context.Context, req *pb.TxStatus) (*pb.CommonRepl
 			p2p.WithLogId(rctx.GetLog().GetLogId()),
 		)
 		go t.engine.Context().Net.SendMessage(rctx, msg)
+		t.pendingTxs.Publish(req.GetBcname(), tx)
+		t.speeds.Mark(req.GetBcname(), metrics.EventTxSubmitted, 1)
+	} else {
+		t.speeds.Mark(req.GetBcname(), metrics.EventTxRejected, 1)
 	}
 	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
 	rctx.GetLog().SetInfoField("txid", utils.F(req.GetTxid()))
 	return resp, err
 }
 
+// ValidateTx run the node side verification of a signed tx without posting it
+func (t *RpcServ) ValidateTx(gctx context.Context, req *pb.TxStatus) (*pb.ValidateTxResponse, error) {
+	// 默认响应
+	resp := &pb.ValidateTxResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	// 校验参数
+	err := acom.NewParamChecker(req).
+		Required(req.GetTx() != nil, "tx").
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
+	}
+	tx := sutils.TxToXledger(req.GetTx())
+	if tx == nil {
+		rctx.GetLog().Warn("param error,tx convert to xledger tx failed")
+		return resp, acom.NewParamChecker(req).Check(false, "tx", "is invalid").Err()
+	}
+
+	// 预校验交易
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return resp, err
+	}
+	failures := handle.ValidateTx(tx)
+
+	resp.Bcname = req.GetBcname()
+	resp.Txid = tx.GetTxid()
+	resp.Valid = len(failures) == 0
+	resp.Failures = acom.TxCheckFailuresToXchain(failures)
+
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcname())
+	rctx.GetLog().SetInfoField("txid", utils.F(tx.GetTxid()))
+	rctx.GetLog().SetInfoField("failures", len(failures))
+	return resp, nil
+}
+
 // PreExec smart contract preExec process
 func (t *RpcServ) PreExec(gctx context.Context, req *pb.InvokeRPCRequest) (*pb.InvokeRPCResponse, error) {
 	// 默认响应
@@ -67,14 +125,17 @@ func (t *RpcServ) PreExec(gctx context.Context, req *pb.InvokeRPCRequest) (*pb.I
 	rctx := sctx.ValueReqCtx(gctx)
 
 	// 校验参数
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 	reqs, err := acom.ConvertInvokeReq(req.GetRequests())
 	if err != nil {
 		rctx.GetLog().Warn("param error, convert failed", "err", err)
-		return resp, ecom.ErrParameter
+		return resp, acom.NewParamChecker(req).Check(false, "requests", err.Error()).Err()
 	}
 
 	// 预执行
@@ -104,9 +165,13 @@ func (t *RpcServ) PreExecWithSelectUTXO(gctx context.Context,
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetRequest() == nil {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetRequest() != nil, "request").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	// PreExec
@@ -129,6 +194,8 @@ func (t *RpcServ) PreExecWithSelectUTXO(gctx context.Context,
 		TotalNeed: big.NewInt(totalAmount).String(),
 		UserSign:  req.GetSignInfo().GetSign(),
 		NeedLock:  req.GetNeedLock(),
+		Timestamp: req.GetTimestamp(),
+		Nonce:     req.GetNonce(),
 	}
 	utxoOut, err := t.SelectUTXO(gctx, utxoInput)
 	if err != nil {
@@ -151,14 +218,18 @@ func (t *RpcServ) SelectUTXO(gctx context.Context, req *pb.UtxoInput) (*pb.UtxoO
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetTotalNeed() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetTotalNeed() != "", "totalNeed").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 	totalNeed, ok := new(big.Int).SetString(req.GetTotalNeed(), 10)
 	if !ok {
 		rctx.GetLog().Warn("param error,total need set error", "totalNeed", req.GetTotalNeed())
-		return resp, ecom.ErrParameter
+		return resp, acom.NewParamChecker(req).Check(false, "totalNeed", "must be a decimal integer").Err()
 	}
 
 	// select utxo
@@ -168,7 +239,7 @@ func (t *RpcServ) SelectUTXO(gctx context.Context, req *pb.UtxoInput) (*pb.UtxoO
 		return resp, err
 	}
 	out, err := handle.SelectUtxo(req.GetAddress(), totalNeed, req.GetNeedLock(), false,
-		req.GetPublickey(), req.GetUserSign())
+		utxoLockSign(req), t.lockGuard)
 	if err != nil {
 		rctx.GetLog().Warn("select utxo failed", "err", err.Error())
 		return resp, err
@@ -192,9 +263,12 @@ func (t *RpcServ) SelectUTXOBySize(gctx context.Context, req *pb.UtxoInput) (*pb
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	// select utxo
@@ -204,7 +278,7 @@ func (t *RpcServ) SelectUTXOBySize(gctx context.Context, req *pb.UtxoInput) (*pb
 		return resp, err
 	}
 	out, err := handle.SelectUTXOBySize(req.GetAddress(), req.GetNeedLock(), false,
-		req.GetPublickey(), req.GetUserSign())
+		utxoLockSign(req), t.lockGuard)
 	if err != nil {
 		rctx.GetLog().Warn("select utxo failed", "err", err.Error())
 		return resp, err
@@ -221,6 +295,16 @@ func (t *RpcServ) SelectUTXOBySize(gctx context.Context, req *pb.UtxoInput) (*pb
 	return resp, nil
 }
 
+// 锁定utxo的用户签名
+func utxoLockSign(req *pb.UtxoInput) *models.UtxoLockSign {
+	return &models.UtxoLockSign{
+		PublicKey: req.GetPublickey(),
+		Sign:      req.GetUserSign(),
+		Timestamp: req.GetTimestamp(),
+		Nonce:     req.GetNonce(),
+	}
+}
+
 // QueryContractStatData query statistic info about contract
 func (t *RpcServ) QueryContractStatData(gctx context.Context,
 	req *pb.ContractStatDataRequest) (*pb.ContractStatDataResponse, error) {
@@ -229,9 +313,12 @@ func (t *RpcServ) QueryContractStatData(gctx context.Context,
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -263,9 +350,12 @@ func (t *RpcServ) QueryUtxoRecord(gctx context.Context,
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -296,13 +386,17 @@ func (t *RpcServ) QueryACL(gctx context.Context, req *pb.AclStatus) (*pb.AclStat
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 	if len(req.GetAccountName()) < 1 && (len(req.GetContractName()) < 1 || len(req.GetMethodName()) < 1) {
 		rctx.GetLog().Warn("param error,unset name")
-		return resp, ecom.ErrParameter
+		return resp, acom.NewParamChecker(req).
+			Check(false, "accountName", "is required when contractName or methodName unset").Err()
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -349,9 +443,13 @@ func (t *RpcServ) GetAccountContracts(gctx context.Context, req *pb.GetAccountCo
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAccount() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetAccount() != "", "account").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -386,9 +484,13 @@ func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus,
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || len(req.GetTxid()) == 0 {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(len(req.GetTxid()) > 0, "txid").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -397,13 +499,13 @@ func (t *RpcServ) QueryTx(gctx context.Context, req *pb.TxStatus) (*pb.TxStatus,
 		return resp, ecom.ErrInternal.More("%v", err)
 	}
 
-	txInfo, err := handle.QueryTx(req.GetTxid())
+	txInfo, err := handle.QueryTx(req.GetTxid(), t.ledgerCache)
 	if err != nil {
 		rctx.GetLog().Warn("query tx failed", "err", err)
 		return resp, err
 	}
 
-	tx := acom.TxToXchain(txInfo.Tx)
+	tx := sutils.TxToXchain(txInfo.Tx)
 	if tx == nil {
 		rctx.GetLog().Warn("convert tx failed")
 		return resp, ecom.ErrInternal
@@ -428,9 +530,12 @@ func (t *RpcServ) GetBalance(gctx context.Context, req *pb.AddressStatus) (*pb.A
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	for i := 0; i < len(req.Bcs); i++ {
@@ -467,9 +572,12 @@ func (t *RpcServ) GetFrozenBalance(gctx context.Context, req *pb.AddressStatus)
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	for i := 0; i < len(req.Bcs); i++ {
@@ -508,9 +616,12 @@ func (t *RpcServ) GetBalanceDetail(gctx context.Context, req *pb.AddressBalanceS
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	for i := 0; i < len(req.Tfds); i++ {
@@ -552,9 +663,13 @@ func (t *RpcServ) GetBlock(gctx context.Context, req *pb.BlockID) (*pb.Block, er
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || len(req.GetBlockid()) == 0 {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(len(req.GetBlockid()) > 0, "blockid").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -563,7 +678,7 @@ func (t *RpcServ) GetBlock(gctx context.Context, req *pb.BlockID) (*pb.Block, er
 		return resp, err
 	}
 
-	blockInfo, err := handle.QueryBlock(req.GetBlockid(), true)
+	blockInfo, err := handle.QueryBlock(req.GetBlockid(), true, t.ledgerCache)
 	if err != nil {
 		rctx.GetLog().Warn("query block error", "error", err)
 		return resp, err
@@ -592,9 +707,12 @@ func (t *RpcServ) GetBlockChainStatus(gctx context.Context, req *pb.BCStatus) (*
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -642,9 +760,12 @@ func (t *RpcServ) ConfirmBlockChainStatus(gctx context.Context, req *pb.BCStatus
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -710,6 +831,14 @@ func (t *RpcServ) GetSystemStatus(gctx context.Context, req *pb.CommonIn) (*pb.S
 		systemsStatus.BcsStatus = append(systemsStatus.BcsStatus, status)
 	}
 
+	if req.ViewOption == pb.ViewOption_NONE || req.ViewOption == pb.ViewOption_SPEEDS {
+		sumSpeeds, bcSpeeds := t.speeds.Snapshot()
+		systemsStatus.Speeds.SumSpeeds = sumSpeeds
+		for bcName, speed := range bcSpeeds {
+			systemsStatus.Speeds.BcSpeeds[bcName] = &pb.BCSpeeds{BcSpeed: speed}
+		}
+	}
+
 	if req.ViewOption == pb.ViewOption_NONE || req.ViewOption == pb.ViewOption_PEERS {
 		peerInfo := t.engine.Context().Net.PeerInfo()
 		peerUrls := acom.PeerInfoToStrings(peerInfo)
@@ -738,6 +867,24 @@ func (t *RpcServ) GetNetURL(gctx context.Context, req *pb.CommonIn) (*pb.RawUrl,
 	return resp, nil
 }
 
+// GetPeerDetails get connected peers with chain heights, direction and latency
+func (t *RpcServ) GetPeerDetails(gctx context.Context, req *pb.CommonIn) (*pb.PeerDetailsReply, error) {
+	// 默认响应
+	resp := &pb.PeerDetailsReply{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req == nil {
+		rctx.GetLog().Warn("param error,some param unset")
+		return resp, ecom.ErrParameter
+	}
+
+	resp.Local, resp.Peers = t.peers.details()
+
+	rctx.GetLog().SetInfoField("peer_count", len(resp.Peers))
+	return resp, nil
+}
+
 // GetBlockByHeight  get trunk block by height
 func (t *RpcServ) GetBlockByHeight(gctx context.Context, req *pb.BlockHeight) (*pb.Block, error) {
 	// 默认响应
@@ -745,9 +892,12 @@ func (t *RpcServ) GetBlockByHeight(gctx context.Context, req *pb.BlockHeight) (*
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -755,7 +905,7 @@ func (t *RpcServ) GetBlockByHeight(gctx context.Context, req *pb.BlockHeight) (*
 		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
 		return resp, err
 	}
-	blockInfo, err := handle.QueryBlockByHeight(req.GetHeight(), true)
+	blockInfo, err := handle.QueryBlockByHeight(req.GetHeight(), true, t.ledgerCache)
 	if err != nil {
 		rctx.GetLog().Warn("query block error", "bc", req.GetBcname(), "height", req.GetHeight())
 		return resp, err
@@ -783,9 +933,13 @@ func (t *RpcServ) GetAccountByAK(gctx context.Context, req *pb.AK2AccountRequest
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -814,9 +968,13 @@ func (t *RpcServ) GetAddressContracts(gctx context.Context, req *pb.AddressContr
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
@@ -862,9 +1020,12 @@ func (t *RpcServ) GetConsensusStatus(gctx context.Context, req *pb.ConsensusStat
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
 	if err != nil {
@@ -891,9 +1052,12 @@ func (t *RpcServ) DposCandidates(gctx context.Context, req *pb.DposCandidatesReq
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	return resp, ecom.ErrForbidden
@@ -906,9 +1070,13 @@ func (t *RpcServ) DposNominateRecords(gctx context.Context, req *pb.DposNominate
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	return resp, ecom.ErrForbidden
@@ -921,9 +1089,13 @@ func (t *RpcServ) DposNomineeRecords(gctx context.Context, req *pb.DposNomineeRe
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	return resp, ecom.ErrForbidden
@@ -936,9 +1108,13 @@ func (t *RpcServ) DposVoteRecords(gctx context.Context, req *pb.DposVoteRecordsR
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	return resp, ecom.ErrForbidden
@@ -951,9 +1127,13 @@ func (t *RpcServ) DposVotedRecords(gctx context.Context, req *pb.DposVotedRecord
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" || req.GetAddress() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Required(req.GetAddress() != "", "address").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	return resp, ecom.ErrForbidden
@@ -966,9 +1146,12 @@ func (t *RpcServ) DposCheckResults(gctx context.Context, req *pb.DposCheckResult
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	return resp, ecom.ErrForbidden
@@ -981,9 +1164,12 @@ func (t *RpcServ) DposStatus(gctx context.Context, req *pb.DposStatusRequest) (*
 	// 获取请求上下文，对内传递rctx
 	rctx := sctx.ValueReqCtx(gctx)
 
-	if req == nil || req.GetBcname() == "" {
-		rctx.GetLog().Warn("param error,some param unset")
-		return resp, ecom.ErrParameter
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
 	}
 
 	return resp, ecom.ErrForbidden
@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/models"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+// GetBlocksStream 按高度顺序推送主干区块，发送受grpc流控约束，客户端消费慢时阻塞
+// 流式接口不经过UnaryInterceptor，在这里创建请求上下文和输出access日志
+func (t *RpcServ) GetBlocksStream(req *pb.BlocksRange, stream pb.Xchain_GetBlocksStreamServer) error {
+	t.drainer.Acquire()
+	defer t.drainer.Release()
+
+	gctx := stream.Context()
+	header := req.GetHeader()
+	if header == nil {
+		header = t.defReqHeader(gctx)
+	}
+	if header.GetLogid() == "" {
+		header.Logid = t.forwardedLogId(gctx)
+	}
+	rctx, err := t.createReqCtx(gctx, header)
+	if err != nil {
+		return acom.GrpcStatusError(ecom.ErrInternal, header.GetLogid())
+	}
+
+	sent := int64(0)
+	stdErr := ecom.ErrSuccess
+	defer func() {
+		rctx.GetLog().Info("access", "from", header.GetFromNode(), "client_ip", rctx.GetClientIp(),
+			"rpc_method", "/pb.Xchain/GetBlocksStream", "bc_name", req.GetBcname(),
+			"start_height", req.GetStartHeight(), "end_height", req.GetEndHeight(), "sent", sent,
+			"status", stdErr.Status, "err_code", stdErr.Code, "err_msg", stdErr.Msg,
+			"cost_time", rctx.GetTimer().Print())
+	}()
+	// 流式接口没有响应header，失败时只响应grpc状态码
+	fail := func(err error) error {
+		stdErr = acom.CastError(err)
+		return acom.GrpcStatusError(err, header.GetLogid())
+	}
+
+	err = acom.NewParamChecker(req).
+		Required(req.GetBcname() != "", "bcname").
+		Check(req.GetStartHeight() >= 0, "start_height", "must not be negative").
+		Check(req.GetEndHeight() <= 0 || req.GetEndHeight() >= req.GetStartHeight(),
+			"end_height", "must not be less than start_height").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,invalid blocks range", "err", err)
+		return fail(err)
+	}
+
+	handle, err := models.NewChainHandle(req.GetBcname(), rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return fail(err)
+	}
+	status, err := handle.QueryChainStatus()
+	if err != nil {
+		rctx.GetLog().Warn("get chain status error", "error", err)
+		return fail(err)
+	}
+	endHeight := req.GetEndHeight()
+	trunkHeight := status.GetLedgerMeta().GetTrunkHeight()
+	if endHeight == 0 || endHeight > trunkHeight {
+		endHeight = trunkHeight
+	}
+
+	for height := req.GetStartHeight(); height <= endHeight; height++ {
+		// 排空时结束推送，返回Unavailable并附带下一个高度，客户端从该高度继续
+		if t.drainer.IsDraining() {
+			rctx.GetLog().Warn("node is draining, stop blocks stream", "height", height)
+			stdErr = ecom.ErrForbidden
+			return acom.GrpcUnavailableError(ecom.ErrForbidden.More("node is draining"),
+				header.GetLogid(), height)
+		}
+
+		// 同步工具拉取的历史区块不写入缓存，避免挤掉热点区块
+		blockInfo, err := handle.QueryBlockByHeight(height, true, nil)
+		if err != nil {
+			rctx.GetLog().Warn("query block error", "height", height, "error", err)
+			return fail(err)
+		}
+		if blockInfo.GetBlock() == nil {
+			rctx.GetLog().Warn("block not exist", "height", height)
+			return fail(ecom.ErrBlockNotExist)
+		}
+		block := acom.BlockToXchain(blockInfo.GetBlock())
+		if block == nil {
+			rctx.GetLog().Warn("convert block failed", "height", height)
+			return fail(ecom.ErrInternal)
+		}
+		if !req.GetNeedContent() {
+			block.Transactions = nil
+		}
+
+		resp := &pb.Block{
+			Header: &pb.Header{
+				Logid:    header.GetLogid(),
+				FromNode: t.genTraceId(),
+				Error:    pb.XChainErrorEnum_SUCCESS,
+			},
+			Bcname:  req.GetBcname(),
+			Blockid: block.GetBlockid(),
+			Status:  pb.Block_EBlockStatus(blockInfo.GetStatus()),
+			Block:   block,
+		}
+		if err := stream.Send(resp); err != nil {
+			// 客户端断开
+			rctx.GetLog().Warn("send block failed", "height", height, "error", err)
+			return err
+		}
+		sent++
+	}
+
+	return nil
+}
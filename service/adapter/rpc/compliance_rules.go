@@ -0,0 +1,497 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 合规规则类型
+const (
+	RuleBlockedAddresses  = "blocked_addresses"
+	RuleMaxDailyAmount    = "max_daily_amount"
+	RuleContractWhitelist = "contract_whitelist"
+	RuleAuthRequire       = "auth_require"
+)
+
+// 审计结果
+const (
+	decisionApprove = "approve"
+	decisionReject  = "reject"
+	// 规则通过后收费或签名失败，撤销之前的approve
+	decisionRollback = "rollback"
+)
+
+// 规则之外的背书检查，作为审计日志的rule_id
+const (
+	auditRuleVerify   = "verify"
+	auditRulePrecheck = "precheck"
+	auditRuleFee      = "fee"
+	auditRuleSign     = "sign"
+)
+
+// ComplianceRuleConf 合规规则，bcname为空时对所有链生效
+type ComplianceRuleConf struct {
+	Id     string `yaml:"id"`
+	Type   string `yaml:"type"`
+	Bcname string `yaml:"bcname,omitempty"`
+	// blocked_addresses: 禁止出现在发起者、auth_require、转入转出中的地址
+	// max_daily_amount: 限额的地址，为空时对所有地址生效
+	Addresses []string `yaml:"addresses,omitempty"`
+	// max_daily_amount: 单个地址每日转出总额上限
+	Amount string `yaml:"amount,omitempty"`
+	// contract_whitelist: 允许调用的合约，methods为空时允许全部方法
+	Contracts []*ContractRule `yaml:"contracts,omitempty"`
+	// auth_require: 每个正则都需要匹配至少一个auth_require
+	Patterns []string `yaml:"patterns,omitempty"`
+}
+
+// ContractRule 合约白名单
+type ContractRule struct {
+	Name    string   `yaml:"name"`
+	Methods []string `yaml:"methods,omitempty"`
+}
+
+type complianceRuleFile struct {
+	Rules []*ComplianceRuleConf `yaml:"rules"`
+}
+
+// ruleEngine 从yaml加载的合规规则，按顺序执行，第一条不通过的规则作为拒绝原因
+// 每次检查结果写入审计日志
+type ruleEngine struct {
+	rules []*complianceRule
+	audit *auditLog
+
+	mutex sync.Mutex
+	// 日期 => 链名/地址 => 当日已背书的转出金额，每次变化后写入quotaFile
+	day       string
+	spent     map[string]*big.Int
+	quotaFile string
+	now       func() time.Time
+}
+
+// quotaState 持久化的每日已用额度
+type quotaState struct {
+	Day   string            `json:"day"`
+	Spent map[string]string `json:"spent"`
+}
+
+type complianceRule struct {
+	conf      *ComplianceRuleConf
+	addresses map[string]bool
+	amount    *big.Int
+	contracts map[string]map[string]bool
+	patterns  []*regexp.Regexp
+}
+
+// ruleViolation 规则检查不通过
+type ruleViolation struct {
+	ruleId string
+	reason string
+}
+
+func newRuleEngine(ruleFile, auditFile, quotaFile string) (*ruleEngine, error) {
+	buf, err := ioutil.ReadFile(ruleFile)
+	if err != nil {
+		return nil, fmt.Errorf("read compliance rules failed.err:%v", err)
+	}
+	file := new(complianceRuleFile)
+	if err := yaml.UnmarshalStrict(buf, file); err != nil {
+		return nil, fmt.Errorf("unmarshal compliance rules failed.err:%v", err)
+	}
+
+	t := &ruleEngine{
+		spent:     make(map[string]*big.Int),
+		quotaFile: quotaFile,
+		now:       time.Now,
+	}
+	ids := make(map[string]bool)
+	for _, conf := range file.Rules {
+		rule, err := compileRule(conf)
+		if err != nil {
+			return nil, err
+		}
+		if ids[conf.Id] {
+			return nil, fmt.Errorf("duplicate compliance rule id %s", conf.Id)
+		}
+		ids[conf.Id] = true
+		t.rules = append(t.rules, rule)
+	}
+
+	if err := t.loadQuota(); err != nil {
+		return nil, err
+	}
+	t.audit, err = newAuditLog(auditFile)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func compileRule(conf *ComplianceRuleConf) (*complianceRule, error) {
+	if conf == nil || conf.Id == "" {
+		return nil, fmt.Errorf("compliance rule id unset")
+	}
+	rule := &complianceRule{
+		conf:      conf,
+		addresses: make(map[string]bool),
+	}
+	for _, addr := range conf.Addresses {
+		rule.addresses[addr] = true
+	}
+
+	switch conf.Type {
+	case RuleBlockedAddresses:
+		if len(conf.Addresses) == 0 {
+			return nil, fmt.Errorf("rule %s: addresses unset", conf.Id)
+		}
+	case RuleMaxDailyAmount:
+		amount, ok := new(big.Int).SetString(conf.Amount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("rule %s: invalid amount %q", conf.Id, conf.Amount)
+		}
+		rule.amount = amount
+	case RuleContractWhitelist:
+		rule.contracts = make(map[string]map[string]bool)
+		for _, c := range conf.Contracts {
+			if c == nil || c.Name == "" {
+				return nil, fmt.Errorf("rule %s: contract name unset", conf.Id)
+			}
+			methods := make(map[string]bool)
+			for _, m := range c.Methods {
+				methods[m] = true
+			}
+			rule.contracts[c.Name] = methods
+		}
+	case RuleAuthRequire:
+		if len(conf.Patterns) == 0 {
+			return nil, fmt.Errorf("rule %s: patterns unset", conf.Id)
+		}
+		for _, p := range conf.Patterns {
+			re, err := regexp.Compile(p)
+			if err != nil {
+				return nil, fmt.Errorf("rule %s: invalid pattern %q", conf.Id, p)
+			}
+			rule.patterns = append(rule.patterns, re)
+		}
+	default:
+		return nil, fmt.Errorf("rule %s: unknown type %q", conf.Id, conf.Type)
+	}
+	return rule, nil
+}
+
+// Check 按顺序执行规则，全部通过时占用每日限额
+// 返回的cancel在后续收费或签名失败时调用，释放占用的限额并记录失败的检查和原因
+func (t *ruleEngine) Check(gctx context.Context, bcName string, tx *pb.Transaction) (func(ruleId, reason string), error) {
+	rctx := sctx.ValueReqCtx(gctx)
+	outflow := txOutflow(tx)
+
+	t.mutex.Lock()
+	t.rotateDay()
+	day := t.day
+	var violation *ruleViolation
+	for _, rule := range t.rules {
+		if rule.conf.Bcname != "" && rule.conf.Bcname != bcName {
+			continue
+		}
+		if violation = t.checkRule(rule, bcName, tx, outflow); violation != nil {
+			break
+		}
+	}
+	var saveErr error
+	if violation == nil && len(outflow) > 0 {
+		t.addSpent(bcName, outflow, 1)
+		// 额度写入失败时不背书，否则重启后限额被绕过
+		if saveErr = t.saveQuota(); saveErr != nil {
+			t.addSpent(bcName, outflow, -1)
+		}
+	}
+	t.mutex.Unlock()
+	if saveErr != nil {
+		rctx.GetLog().Warn("save compliance quota failed", "err", saveErr)
+		return nil, ecom.ErrInternal
+	}
+
+	record := t.newRecord(gctx, bcName, tx, decisionApprove)
+	if violation != nil {
+		record.Decision = decisionReject
+		record.RuleId = violation.ruleId
+		record.Reason = violation.reason
+	}
+	release := func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+		if t.day == day && len(outflow) > 0 {
+			t.addSpent(bcName, outflow, -1)
+			// 写入失败时文件中的额度偏大，只会更严格
+			t.saveQuota()
+		}
+	}
+	if err := t.audit.write(record); err != nil {
+		// 审计失败时不背书
+		rctx.GetLog().Warn("write compliance audit log failed", "err", err)
+		if violation == nil {
+			release()
+		}
+		return nil, ecom.ErrInternal
+	}
+	if violation != nil {
+		rctx.GetLog().Warn("tx rejected by compliance rule", "rule_id", violation.ruleId,
+			"reason", violation.reason)
+		return nil, ecom.ErrForbidden.More("rejected by compliance rule %s: %s", violation.ruleId,
+			violation.reason)
+	}
+
+	cancel := func(ruleId, reason string) {
+		release()
+		rollback := *record
+		rollback.Time = t.now().Format(time.RFC3339Nano)
+		rollback.Decision = decisionRollback
+		rollback.RuleId = ruleId
+		rollback.Reason = reason
+		if err := t.audit.write(&rollback); err != nil {
+			rctx.GetLog().Warn("write compliance audit log failed", "err", err)
+		}
+	}
+	return cancel, nil
+}
+
+// Reject 规则之外的检查拒绝背书时写入审计日志，不影响拒绝结果
+func (t *ruleEngine) Reject(gctx context.Context, bcName string, tx *pb.Transaction, ruleId, reason string) {
+	record := t.newRecord(gctx, bcName, tx, decisionReject)
+	record.RuleId = ruleId
+	record.Reason = reason
+	if err := t.audit.write(record); err != nil {
+		sctx.ValueReqCtx(gctx).GetLog().Warn("write compliance audit log failed", "err", err)
+	}
+}
+
+func (t *ruleEngine) newRecord(gctx context.Context, bcName string, tx *pb.Transaction,
+	decision string) *auditRecord {
+
+	return &auditRecord{
+		Time:      t.now().Format(time.RFC3339Nano),
+		Logid:     sctx.ValueReqCtx(gctx).GetLog().GetLogId(),
+		Bcname:    bcName,
+		Txid:      hex.EncodeToString(tx.GetTxid()),
+		Initiator: tx.GetInitiator(),
+		Decision:  decision,
+	}
+}
+
+func (t *ruleEngine) checkRule(rule *complianceRule, bcName string, tx *pb.Transaction,
+	outflow map[string]*big.Int) *ruleViolation {
+
+	conf := rule.conf
+	switch conf.Type {
+	case RuleBlockedAddresses:
+		for _, addr := range txAddresses(tx) {
+			if rule.addresses[addr] {
+				return &ruleViolation{conf.Id, fmt.Sprintf("address %s is blocked", addr)}
+			}
+		}
+	case RuleMaxDailyAmount:
+		for addr, amount := range outflow {
+			if len(rule.addresses) > 0 && !rule.addresses[addr] {
+				continue
+			}
+			total := new(big.Int).Add(amount, t.spentOf(bcName, addr))
+			if total.Cmp(rule.amount) > 0 {
+				return &ruleViolation{conf.Id, fmt.Sprintf("address %s exceeds daily amount %s", addr, rule.amount)}
+			}
+		}
+	case RuleContractWhitelist:
+		for _, req := range tx.GetContractRequests() {
+			methods, ok := rule.contracts[req.GetContractName()]
+			if !ok {
+				return &ruleViolation{conf.Id, fmt.Sprintf("contract %s not allowed", req.GetContractName())}
+			}
+			if len(methods) > 0 && !methods[req.GetMethodName()] {
+				return &ruleViolation{conf.Id, fmt.Sprintf("method %s.%s not allowed",
+					req.GetContractName(), req.GetMethodName())}
+			}
+		}
+	case RuleAuthRequire:
+		for _, re := range rule.patterns {
+			matched := false
+			for _, ak := range tx.GetAuthRequire() {
+				if re.MatchString(ak) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return &ruleViolation{conf.Id, fmt.Sprintf("no auth require matches %s", re)}
+			}
+		}
+	}
+	return nil
+}
+
+// sign为1时累加，-1时扣减
+func (t *ruleEngine) addSpent(bcName string, outflow map[string]*big.Int, sign int64) {
+	for addr, amount := range outflow {
+		key := bcName + "/" + addr
+		if t.spent[key] == nil {
+			t.spent[key] = big.NewInt(0)
+		}
+		t.spent[key].Add(t.spent[key], new(big.Int).Mul(amount, big.NewInt(sign)))
+	}
+}
+
+func (t *ruleEngine) spentOf(bcName, addr string) *big.Int {
+	if amount, ok := t.spent[bcName+"/"+addr]; ok {
+		return amount
+	}
+	return big.NewInt(0)
+}
+
+// 每日限额按本地日期统计，跨天清零
+func (t *ruleEngine) rotateDay() {
+	day := t.now().Format("2006-01-02")
+	if day != t.day {
+		t.day = day
+		t.spent = make(map[string]*big.Int)
+	}
+}
+
+// 加载已用额度，文件不存在时从0开始，跨天的记录由rotateDay清零
+func (t *ruleEngine) loadQuota() error {
+	buf, err := ioutil.ReadFile(t.quotaFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("read compliance quota failed.err:%v", err)
+	}
+	state := new(quotaState)
+	if err := json.Unmarshal(buf, state); err != nil {
+		return fmt.Errorf("unmarshal compliance quota failed.err:%v", err)
+	}
+	t.day = state.Day
+	for key, value := range state.Spent {
+		amount, ok := new(big.Int).SetString(value, 10)
+		if !ok {
+			return fmt.Errorf("invalid compliance quota %s: %q", key, value)
+		}
+		t.spent[key] = amount
+	}
+	return nil
+}
+
+// 先写临时文件再重命名，避免进程中断时文件损坏
+func (t *ruleEngine) saveQuota() error {
+	state := &quotaState{
+		Day:   t.day,
+		Spent: make(map[string]string, len(t.spent)),
+	}
+	for key, amount := range t.spent {
+		if amount.Sign() > 0 {
+			state.Spent[key] = amount.String()
+		}
+	}
+	buf, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(t.quotaFile), 0755); err != nil {
+		return err
+	}
+	tmpName := t.quotaFile + ".tmp"
+	if err := ioutil.WriteFile(tmpName, buf, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, t.quotaFile)
+}
+
+// 各地址的转出金额：作为输入的金额减去找零
+func txOutflow(tx *pb.Transaction) map[string]*big.Int {
+	outflow := make(map[string]*big.Int)
+	for _, input := range tx.GetTxInputs() {
+		addr := string(input.GetFromAddr())
+		if outflow[addr] == nil {
+			outflow[addr] = big.NewInt(0)
+		}
+		outflow[addr].Add(outflow[addr], new(big.Int).SetBytes(input.GetAmount()))
+	}
+	for _, output := range tx.GetTxOutputs() {
+		if amount, ok := outflow[string(output.GetToAddr())]; ok {
+			amount.Sub(amount, new(big.Int).SetBytes(output.GetAmount()))
+		}
+	}
+	for addr, amount := range outflow {
+		if amount.Sign() <= 0 {
+			delete(outflow, addr)
+		}
+	}
+	return outflow
+}
+
+// 交易涉及的全部地址，auth_require中的账户/地址取地址部分
+func txAddresses(tx *pb.Transaction) []string {
+	addrs := []string{tx.GetInitiator()}
+	for _, ak := range tx.GetAuthRequire() {
+		addrs = append(addrs, ak, filepath.Base(ak))
+	}
+	for _, input := range tx.GetTxInputs() {
+		addrs = append(addrs, string(input.GetFromAddr()))
+	}
+	for _, output := range tx.GetTxOutputs() {
+		addrs = append(addrs, string(output.GetToAddr()))
+	}
+	return addrs
+}
+
+// auditRecord 审计日志，每行一条json
+type auditRecord struct {
+	Time      string `json:"time"`
+	Logid     string `json:"logid"`
+	Bcname    string `json:"bcname"`
+	Txid      string `json:"txid,omitempty"`
+	Initiator string `json:"initiator"`
+	Decision  string `json:"decision"`
+	RuleId    string `json:"rule_id,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+type auditLog struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func newAuditLog(fname string) (*auditLog, error) {
+	if err := os.MkdirAll(filepath.Dir(fname), 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(fname, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open compliance audit log failed.err:%v", err)
+	}
+	return &auditLog{file: file}, nil
+}
+
+func (t *auditLog) write(record *auditRecord) error {
+	buf, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	_, err = t.file.Write(append(buf, '\n'))
+	return err
+}
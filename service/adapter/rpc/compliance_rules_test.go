@@ -0,0 +1,233 @@
+package rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/timer"
+
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) GetLogId() string                           { return "test" }
+func (nopLogger) SetCommField(key string, value interface{}) {}
+func (nopLogger) SetInfoField(key string, value interface{}) {}
+func (nopLogger) Error(msg string, ctx ...interface{})       {}
+func (nopLogger) Warn(msg string, ctx ...interface{})        {}
+func (nopLogger) Info(msg string, ctx ...interface{})        {}
+func (nopLogger) Trace(msg string, ctx ...interface{})       {}
+func (nopLogger) Debug(msg string, ctx ...interface{})       {}
+
+// testReqCtx 不依赖引擎的请求上下文
+type testReqCtx struct {
+	context.Context
+}
+
+func (testReqCtx) GetEngine() common.Engine { return nil }
+func (testReqCtx) GetLog() logs.Logger      { return nopLogger{} }
+func (testReqCtx) GetTimer() *timer.XTimer  { return timer.NewXTimer() }
+func (testReqCtx) GetClientIp() string      { return "127.0.0.1" }
+
+func testContext() context.Context {
+	return sctx.WithReqCtx(context.Background(), testReqCtx{context.Background()})
+}
+
+const testRules = `
+rules:
+  - id: blocked
+    type: blocked_addresses
+    addresses: [mallory]
+  - id: daily
+    type: max_daily_amount
+    bcname: xuper
+    amount: "100"
+  - id: contracts
+    type: contract_whitelist
+    contracts:
+      - name: counter
+        methods: [increase]
+      - name: token
+  - id: auth
+    type: auth_require
+    patterns: ["^endorser$"]
+`
+
+func newTestRuleEngine(t *testing.T, dir string) *ruleEngine {
+	ruleFile := filepath.Join(dir, "rules.yaml")
+	if err := ioutil.WriteFile(ruleFile, []byte(testRules), 0644); err != nil {
+		t.Fatal(err)
+	}
+	engine, err := newRuleEngine(ruleFile, filepath.Join(dir, "audit.log"), filepath.Join(dir, "quota.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return engine
+}
+
+// from转给to的交易，找零转回from
+func transferTx(from, to string, input, amount int64) *pb.Transaction {
+	return &pb.Transaction{
+		Initiator:   from,
+		AuthRequire: []string{from, "endorser"},
+		TxInputs:    []*pb.TxInput{{FromAddr: []byte(from), Amount: big.NewInt(input).Bytes()}},
+		TxOutputs: []*pb.TxOutput{
+			{ToAddr: []byte(to), Amount: big.NewInt(amount).Bytes()},
+			{ToAddr: []byte(from), Amount: big.NewInt(input - amount).Bytes()},
+		},
+	}
+}
+
+func TestRuleEngineCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compliance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	engine := newTestRuleEngine(t, dir)
+
+	invoke := func(contract, method string) *pb.Transaction {
+		tx := transferTx("alice", "bob", 10, 1)
+		tx.ContractRequests = []*pb.InvokeRequest{{ContractName: contract, MethodName: method}}
+		return tx
+	}
+	noEndorser := transferTx("alice", "bob", 10, 1)
+	noEndorser.AuthRequire = []string{"alice"}
+	blockedAuth := transferTx("alice", "bob", 10, 1)
+	blockedAuth.AuthRequire = append(blockedAuth.AuthRequire, "XC1111111111111111@xuper/mallory")
+
+	cases := []struct {
+		name   string
+		bcName string
+		tx     *pb.Transaction
+		reject string
+	}{
+		{name: "ok", bcName: "xuper", tx: transferTx("alice", "bob", 100, 10)},
+		{name: "blocked initiator", bcName: "xuper", tx: transferTx("mallory", "bob", 10, 1), reject: "blocked"},
+		{name: "blocked receiver", bcName: "xuper", tx: transferTx("alice", "mallory", 10, 1), reject: "blocked"},
+		{name: "blocked auth require", bcName: "xuper", tx: blockedAuth, reject: "blocked"},
+		// 找零不计入转出
+		{name: "daily amount", bcName: "xuper", tx: transferTx("alice", "bob", 1000, 91), reject: "daily"},
+		{name: "daily amount of other chain", bcName: "hello", tx: transferTx("alice", "bob", 1000, 91)},
+		{name: "contract allowed", bcName: "xuper", tx: invoke("counter", "increase")},
+		{name: "any method allowed", bcName: "xuper", tx: invoke("token", "transfer")},
+		{name: "method not allowed", bcName: "xuper", tx: invoke("counter", "reset"), reject: "contracts"},
+		{name: "contract not allowed", bcName: "xuper", tx: invoke("vote", "vote"), reject: "contracts"},
+		{name: "auth require unmatched", bcName: "xuper", tx: noEndorser, reject: "auth"},
+	}
+	for _, c := range cases {
+		_, err := engine.Check(testContext(), c.bcName, c.tx)
+		if c.reject == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", c.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), "rule "+c.reject) {
+			t.Errorf("%s: expect rejected by %s, got %v", c.name, c.reject, err)
+		}
+	}
+
+	audit, err := ioutil.ReadFile(filepath.Join(dir, "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(audit), "\n"); lines != len(cases) {
+		t.Errorf("expect %d audit records, got %d", len(cases), lines)
+	}
+}
+
+func TestRuleEngineQuota(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compliance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.Local)
+	engine := newTestRuleEngine(t, dir)
+	engine.now = func() time.Time { return now }
+
+	if _, err := engine.Check(testContext(), "xuper", transferTx("alice", "bob", 100, 60)); err != nil {
+		t.Fatal(err)
+	}
+	// 撤销后额度释放
+	cancel, err := engine.Check(testContext(), "xuper", transferTx("alice", "bob", 100, 40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancel(auditRuleFee, "fee failed")
+	if _, err := engine.Check(testContext(), "xuper", transferTx("alice", "bob", 100, 41)); err == nil {
+		t.Fatal("daily amount exceeded")
+	}
+	// 撤销时追加rollback记录
+	audit, err := ioutil.ReadFile(filepath.Join(dir, "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(audit)), "\n")
+	if len(lines) != 4 || !strings.Contains(lines[2], `"decision":"rollback"`) ||
+		!strings.Contains(lines[2], `"rule_id":"fee","reason":"fee failed"`) {
+		t.Errorf("unexpected audit log %s", audit)
+	}
+
+	// 重启后继续累计
+	engine = newTestRuleEngine(t, dir)
+	engine.now = func() time.Time { return now }
+	if _, err := engine.Check(testContext(), "xuper", transferTx("alice", "bob", 100, 41)); err == nil {
+		t.Fatal("quota should be kept across restart")
+	}
+	if _, err := engine.Check(testContext(), "xuper", transferTx("alice", "bob", 100, 40)); err != nil {
+		t.Fatal(err)
+	}
+
+	// 跨天清零
+	engine = newTestRuleEngine(t, dir)
+	engine.now = func() time.Time { return now.Add(24 * time.Hour) }
+	if _, err := engine.Check(testContext(), "xuper", transferTx("alice", "bob", 100, 100)); err != nil {
+		t.Fatalf("quota should be reset the next day: %v", err)
+	}
+}
+
+func TestRuleEngineReject(t *testing.T) {
+	dir, err := ioutil.TempDir("", "compliance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	engine := newTestRuleEngine(t, dir)
+
+	engine.Reject(testContext(), "xuper", transferTx("alice", "bob", 100, 10), auditRulePrecheck, "bad sign")
+	audit, err := ioutil.ReadFile(filepath.Join(dir, "audit.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(audit), `"decision":"reject","rule_id":"precheck","reason":"bad sign"`) {
+		t.Errorf("unexpected audit log %s", audit)
+	}
+}
+
+func TestCompileRule(t *testing.T) {
+	cases := []*ComplianceRuleConf{
+		{Type: RuleBlockedAddresses, Addresses: []string{"a"}},
+		{Id: "r", Type: RuleBlockedAddresses},
+		{Id: "r", Type: RuleMaxDailyAmount, Amount: "-1"},
+		{Id: "r", Type: RuleContractWhitelist, Contracts: []*ContractRule{{}}},
+		{Id: "r", Type: RuleAuthRequire, Patterns: []string{"("}},
+		{Id: "r", Type: "unknown"},
+	}
+	for _, conf := range cases {
+		if _, err := compileRule(conf); err == nil {
+			t.Errorf("rule %+v should be invalid", conf)
+		}
+	}
+}
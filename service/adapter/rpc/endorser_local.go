@@ -0,0 +1,348 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/common/xaddress"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+	sutils "github.com/xuperchain/xuperos/common/utils"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/models"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+// 背书服务模式
+const (
+	EndorserModeProxy = "proxy"
+	EndorserModeLocal = "local"
+)
+
+// 标准背书请求名
+const (
+	EndorserComplianceCheck       = "ComplianceCheck"
+	EndorserPreExecWithFee        = "PreExecWithFee"
+	EndorserPreExecWithSelectUTXO = "PreExecWithSelectUTXO"
+)
+
+// complianceRules 合规检查规则，检查不通过时返回的错误作为背书拒绝原因
+// 检查通过后背书失败时调用返回的cancel撤销检查的副作用，ruleId为失败的检查，reason为失败原因
+type complianceRules interface {
+	Check(gctx context.Context, bcName string, tx *pb.Transaction) (cancel func(ruleId, reason string), err error)
+	// Reject 规则之外的检查拒绝背书时记录审计
+	Reject(gctx context.Context, bcName string, tx *pb.Transaction, ruleId, reason string)
+}
+
+// localEndorser 节点内置背书服务，不需要单独部署xendorser
+// ComplianceCheck校验服务费交易并执行合规规则，通过后用背书私钥对交易签名；
+// PreExecWithFee和PreExecWithSelectUTXO直接调用本节点的预执行接口
+type localEndorser struct {
+	conf    sconf.LocalEndorserConf
+	rpcServ *RpcServ
+	engine  ecom.Engine
+	rules   complianceRules
+	// 链名 => 背书账户，各链的加密类型可能不同
+	addrs sync.Map
+}
+
+func newLocalEndorser(scfg *sconf.ServConf, rpcServ *RpcServ, engine ecom.Engine) (*localEndorser, error) {
+	t := &localEndorser{
+		conf:    scfg.LocalEndorser,
+		rpcServ: rpcServ,
+		engine:  engine,
+	}
+	// 不能用节点账户背书，否则任何人都能让节点私钥为交易签名
+	if t.conf.KeyDir == "" {
+		return nil, fmt.Errorf("local endorser requires a dedicated localEndorser.keyDir")
+	}
+	if t.conf.Rules == "" {
+		return t, nil
+	}
+
+	// 规则文件相对配置目录，审计日志相对根目录
+	envCfg := engine.Context().EnvCfg
+	ruleFile := t.conf.Rules
+	if !filepath.IsAbs(ruleFile) {
+		ruleFile = envCfg.GenConfFilePath(ruleFile)
+	}
+	auditFile := t.conf.AuditLog
+	if !filepath.IsAbs(auditFile) {
+		auditFile = envCfg.GenDirAbsPath(auditFile)
+	}
+	quotaFile := t.conf.QuotaFile
+	if !filepath.IsAbs(quotaFile) {
+		quotaFile = envCfg.GenDirAbsPath(quotaFile)
+	}
+	rules, err := newRuleEngine(ruleFile, auditFile, quotaFile)
+	if err != nil {
+		return nil, err
+	}
+	t.rules = rules
+	return t, nil
+}
+
+func (t *localEndorser) EndorserCall(gctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	// 默认响应
+	resp := &pb.EndorserResponse{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	// 校验参数
+	err := acom.NewParamChecker(req).
+		Required(req.GetBcName() != "", "BcName").
+		Required(len(req.GetRequestData()) > 0, "RequestData").
+		Err()
+	if err != nil {
+		rctx.GetLog().Warn("param error,some param unset", "err", err)
+		return resp, err
+	}
+	rctx.GetLog().SetInfoField("bc_name", req.GetBcName())
+	rctx.GetLog().SetInfoField("request_name", req.GetRequestName())
+
+	addr, err := t.address(req.GetBcName())
+	if err != nil {
+		rctx.GetLog().Warn("load endorser address failed", "err", err)
+		return resp, err
+	}
+
+	var data interface{}
+	switch req.GetRequestName() {
+	case EndorserComplianceCheck:
+		sign, err := t.complianceCheck(gctx, req, addr)
+		if err != nil {
+			return resp, err
+		}
+		resp.EndorserSign = sign
+	case EndorserPreExecWithFee:
+		data, err = t.preExec(gctx, req, addr)
+	case EndorserPreExecWithSelectUTXO:
+		data, err = t.preExecWithSelectUTXO(gctx, req, addr)
+	default:
+		rctx.GetLog().Warn("param error,unknown request name", "request_name", req.GetRequestName())
+		return resp, ecom.ErrParameter.More("unknown request name:%s", req.GetRequestName())
+	}
+	if err != nil {
+		return resp, err
+	}
+	if data != nil {
+		resp.ResponseData, err = json.Marshal(data)
+		if err != nil {
+			return resp, ecom.ErrInternal
+		}
+	}
+
+	resp.ResponseName = req.GetRequestName()
+	resp.EndorserAddress = addr.Address
+	return resp, nil
+}
+
+// 交易需要背书地址签名，合规规则通过并收取服务费后签名
+func (t *localEndorser) complianceCheck(gctx context.Context, req *pb.EndorserRequest,
+	addr *xaddress.Address) (*pb.SignatureInfo, error) {
+
+	rctx := sctx.ValueReqCtx(gctx)
+	txStatus := new(pb.TxStatus)
+	if err := json.Unmarshal(req.GetRequestData(), txStatus); err != nil || txStatus.GetTx() == nil {
+		rctx.GetLog().Warn("param error,unmarshal tx status failed", "err", err)
+		return nil, ecom.ErrParameter.More("bad request data")
+	}
+	tx := txStatus.GetTx()
+	if txStatus.GetBcname() != "" && txStatus.GetBcname() != req.GetBcName() {
+		return nil, ecom.ErrParameter.More("bcname mismatch")
+	}
+	if err := t.verifyTx(tx, addr.Address); err != nil {
+		rctx.GetLog().Warn("refuse to endorse tx", "endorser", addr.Address, "err", err)
+		// 没有加载规则时没有审计日志
+		if t.rules != nil {
+			t.rules.Reject(gctx, req.GetBcName(), tx, auditRuleVerify, err.Error())
+		}
+		return nil, err
+	}
+
+	chain, err := t.engine.Get(req.GetBcName())
+	if err != nil {
+		return nil, ecom.ErrChainNotExist
+	}
+	// 先校验发起人签名和utxo，避免伪造的交易占用他人的每日限额
+	if err := t.precheckTx(gctx, req.GetBcName(), tx, req.GetFee()); err != nil {
+		t.rules.Reject(gctx, req.GetBcName(), tx, auditRulePrecheck, err.Error())
+		return nil, err
+	}
+	// verifyTx保证已加载合规规则
+	cancel, err := t.rules.Check(gctx, req.GetBcName(), tx)
+	if err != nil {
+		return nil, err
+	}
+	// 收费或签名失败时撤销，审计日志记录失败原因
+	if err := t.processFee(gctx, req, addr, t.conf.Fee); err != nil {
+		cancel(auditRuleFee, err.Error())
+		return nil, err
+	}
+
+	sign, err := sutils.ComputeTxSign(chain.Context().Crypto, tx, []byte(addr.PrivateKeyStr))
+	if err != nil {
+		rctx.GetLog().Warn("sign tx failed", "err", err)
+		cancel(auditRuleSign, err.Error())
+		return nil, ecom.ErrInternal
+	}
+	rctx.GetLog().SetInfoField("initiator", tx.GetInitiator())
+	return &pb.SignatureInfo{
+		PublicKey: addr.PublicKeyStr,
+		Sign:      sign,
+	}, nil
+}
+
+// 签名前的检查：交易需要背书地址签名，必须加载了合规规则，
+// 且不能花费背书地址的utxo，避免背书签名被用来转走背书账户的资产
+func (t *localEndorser) verifyTx(tx *pb.Transaction, endorser string) error {
+	if !requireAddress(tx.GetAuthRequire(), endorser) {
+		return ecom.ErrParameter.More("auth require should contain endorser address %s", endorser)
+	}
+	if t.rules == nil {
+		return ecom.ErrForbidden.More("no compliance rules loaded")
+	}
+	if tx.GetInitiator() == endorser {
+		return ecom.ErrForbidden.More("initiator can not be the endorser address")
+	}
+	for _, input := range tx.GetTxInputs() {
+		if string(input.GetFromAddr()) == endorser {
+			return ecom.ErrForbidden.More("tx spends utxo of the endorser address")
+		}
+	}
+	return nil
+}
+
+// 校验交易格式、发起人签名和utxo，交易可以花费服务费交易的找零
+func (t *localEndorser) precheckTx(gctx context.Context, bcName string, tx, fee *pb.Transaction) error {
+	rctx := sctx.ValueReqCtx(gctx)
+	handle, err := models.NewChainHandle(bcName, rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err)
+		return err
+	}
+	var pending []*xldgpb.Transaction
+	if fee != nil {
+		pending = append(pending, sutils.TxToXledger(fee))
+	}
+	failures := handle.PrecheckTx(sutils.TxToXledger(tx), pending...)
+	if len(failures) > 0 {
+		rctx.GetLog().Warn("precheck tx failed", "failures", len(failures), "reason", failures[0].Reason)
+		return ecom.ErrParameter.More("%s", failures[0].Reason)
+	}
+	return nil
+}
+
+func (t *localEndorser) preExec(gctx context.Context, req *pb.EndorserRequest,
+	addr *xaddress.Address) (*pb.InvokeRPCResponse, error) {
+
+	invokeReq := new(pb.InvokeRPCRequest)
+	if err := json.Unmarshal(req.GetRequestData(), invokeReq); err != nil {
+		return nil, ecom.ErrParameter.More("bad request data")
+	}
+	if err := t.processFee(gctx, req, addr, 0); err != nil {
+		return nil, err
+	}
+	invokeReq.Bcname = req.GetBcName()
+	return t.rpcServ.PreExec(gctx, invokeReq)
+}
+
+func (t *localEndorser) preExecWithSelectUTXO(gctx context.Context, req *pb.EndorserRequest,
+	addr *xaddress.Address) (*pb.PreExecWithSelectUTXOResponse, error) {
+
+	selectReq := new(pb.PreExecWithSelectUTXORequest)
+	if err := json.Unmarshal(req.GetRequestData(), selectReq); err != nil || selectReq.GetRequest() == nil {
+		return nil, ecom.ErrParameter.More("bad request data")
+	}
+	if err := t.processFee(gctx, req, addr, 0); err != nil {
+		return nil, err
+	}
+	selectReq.Bcname = req.GetBcName()
+	selectReq.Request.Bcname = req.GetBcName()
+	return t.rpcServ.PreExecWithSelectUTXO(gctx, selectReq)
+}
+
+// 校验服务费交易支付给收款地址的金额不少于minFee并提交，minFee为0时服务费交易可选
+func (t *localEndorser) processFee(gctx context.Context, req *pb.EndorserRequest,
+	addr *xaddress.Address, minFee int64) error {
+
+	rctx := sctx.ValueReqCtx(gctx)
+	fee := req.GetFee()
+	if fee == nil {
+		if minFee > 0 {
+			return ecom.ErrParameter.More("fee tx required")
+		}
+		return nil
+	}
+
+	feeAddr := t.conf.FeeAddr
+	if feeAddr == "" {
+		feeAddr = addr.Address
+	}
+	paid := big.NewInt(0)
+	for _, output := range fee.GetTxOutputs() {
+		if string(output.GetToAddr()) == feeAddr {
+			paid.Add(paid, new(big.Int).SetBytes(output.GetAmount()))
+		}
+	}
+	if paid.Cmp(big.NewInt(minFee)) < 0 {
+		rctx.GetLog().Warn("endorser fee not enough", "paid", paid.String(), "need", minFee)
+		return ecom.ErrParameter.More("fee not enough, need %d to %s", minFee, feeAddr)
+	}
+
+	// 由节点校验服务费交易的签名和utxo
+	_, err := t.rpcServ.PostTx(gctx, &pb.TxStatus{
+		Bcname: req.GetBcName(),
+		Txid:   fee.GetTxid(),
+		Tx:     fee,
+	})
+	if err != nil {
+		rctx.GetLog().Warn("post fee tx failed", "err", err)
+		return err
+	}
+	rctx.GetLog().SetInfoField("fee_txid", fee.GetTxid())
+	return nil
+}
+
+// 加载背书账户，不能和节点账户相同
+func (t *localEndorser) address(bcName string) (*xaddress.Address, error) {
+	if addr, ok := t.addrs.Load(bcName); ok {
+		return addr.(*xaddress.Address), nil
+	}
+
+	chain, err := t.engine.Get(bcName)
+	if err != nil {
+		return nil, ecom.ErrChainNotExist
+	}
+	keyDir := t.conf.KeyDir
+	if !filepath.IsAbs(keyDir) {
+		keyDir = t.engine.Context().EnvCfg.GenDirAbsPath(keyDir)
+	}
+	addr, err := xaddress.LoadAddrInfo(keyDir, chain.Context().Crypto)
+	if err != nil {
+		return nil, ecom.ErrInternal.More("%v", err)
+	}
+	if addr.Address == chain.Context().Address.Address {
+		return nil, ecom.ErrForbidden.More("endorser key should not be the node key")
+	}
+	t.addrs.Store(bcName, addr)
+	return addr, nil
+}
+
+// auth_require为地址或者账户/地址
+func requireAddress(authRequire []string, addr string) bool {
+	for _, ak := range authRequire {
+		if ak == addr || strings.HasSuffix(ak, "/"+addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/xuperchain/xupercore/kernel/common/xaddress"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+type passRules struct{}
+
+func (passRules) Check(gctx context.Context, bcName string, tx *pb.Transaction) (func(ruleId, reason string), error) {
+	return func(ruleId, reason string) {}, nil
+}
+
+func (passRules) Reject(gctx context.Context, bcName string, tx *pb.Transaction, ruleId, reason string) {
+}
+
+// recordRules 记录拒绝背书的检查
+type recordRules struct {
+	passRules
+	rejects []string
+}
+
+func (t *recordRules) Reject(gctx context.Context, bcName string, tx *pb.Transaction, ruleId, reason string) {
+	t.rejects = append(t.rejects, ruleId)
+}
+
+func TestNewLocalEndorserRequiresKeyDir(t *testing.T) {
+	scfg := sconf.GetDefServConf()
+	scfg.LocalEndorser.KeyDir = ""
+	if _, err := newLocalEndorser(scfg, nil, nil); err == nil {
+		t.Fatal("local endorser should not start without keyDir")
+	}
+}
+
+func TestLocalEndorserVerifyTx(t *testing.T) {
+	const endorser = "endorserAddr"
+	cases := []struct {
+		name   string
+		rules  complianceRules
+		tx     *pb.Transaction
+		reject string
+	}{
+		{
+			name:  "ok",
+			rules: passRules{},
+			tx: &pb.Transaction{
+				Initiator:   "alice",
+				AuthRequire: []string{"alice", endorser},
+				TxInputs:    []*pb.TxInput{{FromAddr: []byte("alice")}},
+			},
+		},
+		{
+			name:   "endorser not required",
+			rules:  passRules{},
+			tx:     &pb.Transaction{Initiator: "alice", AuthRequire: []string{"alice"}},
+			reject: "auth require",
+		},
+		{
+			name:   "no rules loaded",
+			tx:     &pb.Transaction{Initiator: "alice", AuthRequire: []string{"alice", endorser}},
+			reject: "no compliance rules",
+		},
+		{
+			name:  "spend endorser utxo",
+			rules: passRules{},
+			tx: &pb.Transaction{
+				Initiator:   "alice",
+				AuthRequire: []string{"alice", endorser},
+				TxInputs: []*pb.TxInput{
+					{FromAddr: []byte("alice")},
+					{FromAddr: []byte(endorser)},
+				},
+			},
+			reject: "utxo of the endorser",
+		},
+		{
+			name:   "endorser as initiator",
+			rules:  passRules{},
+			tx:     &pb.Transaction{Initiator: endorser, AuthRequire: []string{endorser}},
+			reject: "initiator",
+		},
+		{
+			name:   "account of endorser",
+			rules:  passRules{},
+			tx:     &pb.Transaction{Initiator: "alice", AuthRequire: []string{"XC1111111111111111@xuper/" + endorser}},
+			reject: "",
+		},
+	}
+	for _, c := range cases {
+		le := &localEndorser{rules: c.rules}
+		err := le.verifyTx(c.tx, endorser)
+		if c.reject == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", c.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.reject) {
+			t.Errorf("%s: expect error containing %q, got %v", c.name, c.reject, err)
+		}
+	}
+}
+
+func TestComplianceCheckAuditsVerifyReject(t *testing.T) {
+	const endorser = "endorserAddr"
+	rules := &recordRules{}
+	le := &localEndorser{rules: rules}
+	data, _ := json.Marshal(&pb.TxStatus{
+		Bcname: "xuper",
+		Tx:     &pb.Transaction{Initiator: endorser, AuthRequire: []string{endorser}},
+	})
+	req := &pb.EndorserRequest{BcName: "xuper", RequestData: data}
+	// 校验不通过时在查找链之前返回
+	if _, err := le.complianceCheck(testContext(), req, &xaddress.Address{Address: endorser}); err == nil {
+		t.Fatal("tx initiated by the endorser should be rejected")
+	}
+	if len(rules.rejects) != 1 || rules.rejects[0] != auditRuleVerify {
+		t.Errorf("verify reject should be audited, got %v", rules.rejects)
+	}
+}
@@ -0,0 +1,440 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/metrics"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 背书节点选择策略
+const (
+	EndorserPolicyRoundRobin  = "round_robin"
+	EndorserPolicyLeastLoaded = "least_loaded"
+)
+
+// 熔断状态
+const (
+	breakerClosed = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+var errNoEndorser = errors.New("no available endorser")
+
+// endorserPool 背书节点连接池
+// 定期健康检查，按策略选择健康且未熔断的节点，节点不可用时换其他节点重试
+type endorserPool struct {
+	conf  sconf.EndorserPoolConf
+	log   logs.Logger
+	nodes []*endorserNode
+	next  uint32
+
+	exitCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// endorserNode 单个背书节点，grpc连接断开后自动重连，不需要重建
+type endorserNode struct {
+	host   string
+	conn   *grpc.ClientConn
+	client pb.XendorserClient
+	health grpc_health_v1.HealthClient
+	// 处理中的调用数
+	inflight int64
+
+	mutex    sync.Mutex
+	healthy  bool
+	failures int
+	state    int
+	openTime time.Time
+	// 半开状态下是否已放行探测请求
+	probing bool
+}
+
+// rootPath用于解析TLS证书的相对路径
+func newEndorserPool(scfg *sconf.ServConf, rootPath string, log logs.Logger) (*endorserPool, error) {
+	conf := scfg.EndorserPool
+	if conf.Policy != EndorserPolicyRoundRobin && conf.Policy != EndorserPolicyLeastLoaded {
+		return nil, fmt.Errorf("unknown endorser pool policy: %s", conf.Policy)
+	}
+
+	endpoints := make([]*sconf.EndorserEndpoint, 0, len(scfg.EndorserHosts)+len(conf.Endpoints))
+	for _, host := range scfg.EndorserHosts {
+		endpoints = append(endpoints, &sconf.EndorserEndpoint{Host: host})
+	}
+	endpoints = append(endpoints, conf.Endpoints...)
+
+	t := &endorserPool{
+		conf:   conf,
+		log:    log,
+		exitCh: make(chan struct{}),
+	}
+	hosts := make(map[string]bool)
+	for _, ep := range endpoints {
+		if ep == nil || ep.Host == "" {
+			return nil, fmt.Errorf("endorser host unset")
+		}
+		if hosts[ep.Host] {
+			return nil, fmt.Errorf("duplicate endorser host: %s", ep.Host)
+		}
+		hosts[ep.Host] = true
+
+		node, err := newEndorserNode(ep, rootPath)
+		if err != nil {
+			t.close()
+			return nil, fmt.Errorf("create endorser client failed.host:%s,err:%v", ep.Host, err)
+		}
+		t.nodes = append(t.nodes, node)
+	}
+	return t, nil
+}
+
+func newEndorserNode(ep *sconf.EndorserEndpoint, rootPath string) (*endorserNode, error) {
+	opts := []grpc.DialOption{grpc.WithMaxMsgSize(64<<20 - 1)}
+	if ep.Tls == nil {
+		opts = append(opts, grpc.WithInsecure())
+	} else {
+		creds, err := newClientTls(ep.Tls, rootPath)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	}
+
+	// 非阻塞连接，节点不可用时由健康检查标记
+	conn, err := grpc.Dial(ep.Host, opts...)
+	if err != nil {
+		return nil, err
+	}
+	node := &endorserNode{
+		host:    ep.Host,
+		conn:    conn,
+		client:  pb.NewXendorserClient(conn),
+		health:  grpc_health_v1.NewHealthClient(conn),
+		healthy: true,
+	}
+	metrics.EndorserHealthyGauge.WithLabelValues(ep.Host).Set(1)
+	metrics.EndorserBreakerGauge.WithLabelValues(ep.Host).Set(breakerClosed)
+	return node, nil
+}
+
+func newClientTls(conf *sconf.TlsClientConf, rootPath string) (credentials.TransportCredentials, error) {
+	absPath := func(fname string) string {
+		if filepath.IsAbs(fname) {
+			return fname
+		}
+		return filepath.Join(rootPath, fname)
+	}
+
+	tlsConf := &tls.Config{
+		ServerName: conf.ServerName,
+	}
+	if conf.CaFile != "" {
+		bs, err := ioutil.ReadFile(absPath(conf.CaFile))
+		if err != nil {
+			return nil, err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(bs) {
+			return nil, fmt.Errorf("no certificate found in %s", conf.CaFile)
+		}
+		tlsConf.RootCAs = certPool
+	}
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(absPath(conf.CertFile), absPath(conf.KeyFile))
+		if err != nil {
+			return nil, err
+		}
+		tlsConf.Certificates = []tls.Certificate{certificate}
+	}
+	return credentials.NewTLS(tlsConf), nil
+}
+
+// 启动健康检查
+func (t *endorserPool) start() {
+	interval := time.Duration(t.conf.HealthCheckInterval) * time.Second
+	if interval <= 0 || len(t.nodes) == 0 {
+		return
+	}
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			t.checkHealth()
+			select {
+			case <-t.exitCh:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (t *endorserPool) stop() {
+	close(t.exitCh)
+	t.wg.Wait()
+	t.close()
+}
+
+func (t *endorserPool) close() {
+	for _, node := range t.nodes {
+		node.conn.Close()
+	}
+}
+
+func (t *endorserPool) checkHealth() {
+	timeout := time.Duration(t.conf.HealthCheckTimeout) * time.Second
+	wg := &sync.WaitGroup{}
+	for _, node := range t.nodes {
+		wg.Add(1)
+		go func(node *endorserNode) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			err := node.checkHealth(ctx)
+			if node.setHealthy(err == nil) {
+				t.log.Info("endorser health changed", "host", node.host, "healthy", err == nil, "err", err)
+			}
+		}(node)
+	}
+	wg.Wait()
+}
+
+// EndorserCall 选择节点调用，节点不可用时换其他节点重试，背书服务返回的业务错误直接返回
+func (t *endorserPool) EndorserCall(ctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	tried := make(map[*endorserNode]bool)
+	lastErr := errNoEndorser
+	for i := 0; i <= t.conf.MaxRetries; i++ {
+		node := t.pick(tried)
+		if node == nil {
+			break
+		}
+		tried[node] = true
+
+		resp, err := node.call(ctx, req)
+		switch {
+		case err == nil:
+			node.record(true, t.conf.BreakerThreshold)
+			metrics.EndorserCallCounter.WithLabelValues(node.host, "ok").Inc()
+			return resp, nil
+		case canceled(ctx, err):
+			// 调用方取消或超时，不能说明节点状态
+			node.release()
+			metrics.EndorserCallCounter.WithLabelValues(node.host, "canceled").Inc()
+			return nil, err
+		case !retryable(err) && !nodeFault(err):
+			// 节点可用，只是拒绝了请求
+			node.record(true, t.conf.BreakerThreshold)
+			metrics.EndorserCallCounter.WithLabelValues(node.host, "rejected").Inc()
+			return nil, err
+		}
+
+		metrics.EndorserCallCounter.WithLabelValues(node.host, "failed").Inc()
+		if node.record(false, t.conf.BreakerThreshold) {
+			t.log.Warn("endorser circuit breaker open", "host", node.host, "err", err)
+		}
+		// 节点内部错误可能与请求有关，计入熔断但不换节点重试
+		if !retryable(err) {
+			return nil, err
+		}
+		lastErr = err
+		t.log.Warn("endorser call failed", "host", node.host, "retry", i, "err", err)
+	}
+	return nil, lastErr
+}
+
+// 优先选择健康的节点，全部不健康时在未熔断的节点中选择，健康检查结果可能滞后
+func (t *endorserPool) pick(tried map[*endorserNode]bool) *endorserNode {
+	cooldown := time.Duration(t.conf.BreakerCooldown) * time.Second
+	healthy := make([]*endorserNode, 0, len(t.nodes))
+	others := make([]*endorserNode, 0, len(t.nodes))
+	for _, node := range t.nodes {
+		if tried[node] {
+			continue
+		}
+		if node.isHealthy() {
+			healthy = append(healthy, node)
+		} else {
+			others = append(others, node)
+		}
+	}
+
+	for _, candidates := range [][]*endorserNode{healthy, others} {
+		for len(candidates) > 0 {
+			i := t.choose(candidates)
+			if candidates[i].allow(cooldown) {
+				return candidates[i]
+			}
+			candidates = append(candidates[:i:i], candidates[i+1:]...)
+		}
+	}
+	return nil
+}
+
+func (t *endorserPool) choose(candidates []*endorserNode) int {
+	start := int(atomic.AddUint32(&t.next, 1) % uint32(len(candidates)))
+	if t.conf.Policy == EndorserPolicyRoundRobin {
+		return start
+	}
+
+	// 处理中调用数相同时按轮询顺序选择
+	best := start
+	for k := 1; k < len(candidates); k++ {
+		i := (start + k) % len(candidates)
+		if atomic.LoadInt64(&candidates[i].inflight) < atomic.LoadInt64(&candidates[best].inflight) {
+			best = i
+		}
+	}
+	return best
+}
+
+// 调用方取消或者调用方的超时到期
+func canceled(ctx context.Context, err error) bool {
+	return ctx.Err() != nil || status.Code(err) == codes.Canceled
+}
+
+// 连接失败、超时和限流可以换节点重试
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// 节点内部错误，计入熔断
+func nodeFault(err error) bool {
+	switch status.Code(err) {
+	case codes.Internal, codes.Unknown, codes.DataLoss:
+		return true
+	}
+	return false
+}
+
+func (t *endorserNode) call(ctx context.Context, req *pb.EndorserRequest) (*pb.EndorserResponse, error) {
+	atomic.AddInt64(&t.inflight, 1)
+	metrics.EndorserInflightGauge.WithLabelValues(t.host).Inc()
+	defer func() {
+		atomic.AddInt64(&t.inflight, -1)
+		metrics.EndorserInflightGauge.WithLabelValues(t.host).Dec()
+	}()
+
+	return t.client.EndorserCall(ctx, req)
+}
+
+// 优先使用grpc标准健康检查，背书服务未实现时能建立连接即认为健康
+func (t *endorserNode) checkHealth(ctx context.Context) error {
+	resp, err := t.health.Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if resp.GetStatus() != grpc_health_v1.HealthCheckResponse_SERVING {
+		return fmt.Errorf("endorser status %s", resp.GetStatus())
+	}
+	return nil
+}
+
+// 返回健康状态是否变化
+func (t *endorserNode) setHealthy(healthy bool) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	value := 0.0
+	if healthy {
+		value = 1
+	}
+	metrics.EndorserHealthyGauge.WithLabelValues(t.host).Set(value)
+	changed := t.healthy != healthy
+	t.healthy = healthy
+	return changed
+}
+
+func (t *endorserNode) isHealthy() bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.healthy
+}
+
+// 熔断状态下冷却时间过后进入半开状态，只放行一个探测请求
+func (t *endorserNode) allow(cooldown time.Duration) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	switch t.state {
+	case breakerOpen:
+		if time.Since(t.openTime) < cooldown {
+			return false
+		}
+		t.setState(breakerHalfOpen)
+		t.probing = true
+		return true
+	case breakerHalfOpen:
+		if t.probing {
+			return false
+		}
+		t.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// 记录调用结果，返回是否由本次失败触发熔断
+func (t *endorserNode) record(succ bool, threshold int) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.probing = false
+	if succ {
+		t.failures = 0
+		t.setState(breakerClosed)
+		return false
+	}
+
+	t.failures++
+	// 半开状态下探测失败直接重新熔断
+	if t.state == breakerHalfOpen || (threshold > 0 && t.failures >= threshold) {
+		opened := t.state != breakerOpen
+		t.openTime = time.Now()
+		t.setState(breakerOpen)
+		return opened
+	}
+	return false
+}
+
+// 调用结果不计入熔断，半开状态下允许重新探测
+func (t *endorserNode) release() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.probing = false
+}
+
+func (t *endorserNode) setState(state int) {
+	t.state = state
+	metrics.EndorserBreakerGauge.WithLabelValues(t.host).Set(float64(state))
+}
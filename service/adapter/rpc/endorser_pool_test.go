@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// fakeEndorser 依次返回预设的错误，用完后返回成功
+type fakeEndorser struct {
+	errs  []error
+	calls int
+}
+
+func (t *fakeEndorser) EndorserCall(ctx context.Context, in *pb.EndorserRequest,
+	opts ...grpc.CallOption) (*pb.EndorserResponse, error) {
+	t.calls++
+	if len(t.errs) == 0 {
+		return &pb.EndorserResponse{}, nil
+	}
+	err := t.errs[0]
+	t.errs = t.errs[1:]
+	return nil, err
+}
+
+func newTestNode(host string, errs ...error) (*endorserNode, *fakeEndorser) {
+	client := &fakeEndorser{errs: errs}
+	return &endorserNode{host: host, client: client, healthy: true}, client
+}
+
+func newTestPool(nodes ...*endorserNode) *endorserPool {
+	return &endorserPool{
+		conf: sconf.EndorserPoolConf{
+			Policy:           EndorserPolicyRoundRobin,
+			MaxRetries:       len(nodes),
+			BreakerThreshold: 2,
+			BreakerCooldown:  60,
+		},
+		log:   nopLogger{},
+		nodes: nodes,
+	}
+}
+
+func TestBreakerTransitions(t *testing.T) {
+	node, _ := newTestNode("a")
+	cooldown := time.Minute
+
+	// 连续失败达到阈值后熔断
+	if node.record(false, 2) || node.state != breakerClosed {
+		t.Fatal("breaker should be closed below threshold")
+	}
+	if !node.record(false, 2) || node.state != breakerOpen {
+		t.Fatal("breaker should open at threshold")
+	}
+	if node.allow(cooldown) {
+		t.Fatal("open breaker should reject calls in cooldown")
+	}
+
+	// 冷却后半开，只放行一个探测请求
+	node.openTime = time.Now().Add(-cooldown)
+	if !node.allow(cooldown) || node.state != breakerHalfOpen {
+		t.Fatal("breaker should be half open after cooldown")
+	}
+	if node.allow(cooldown) {
+		t.Fatal("half open breaker should allow only one probe")
+	}
+	// 探测失败重新熔断
+	if !node.record(false, 2) || node.state != breakerOpen {
+		t.Fatal("failed probe should reopen the breaker")
+	}
+
+	// 取消的探测不计入结果，允许重新探测
+	node.openTime = time.Now().Add(-cooldown)
+	if !node.allow(cooldown) {
+		t.Fatal("breaker should be half open after cooldown")
+	}
+	node.release()
+	if node.state != breakerHalfOpen || !node.allow(cooldown) {
+		t.Fatal("canceled probe should allow another probe")
+	}
+	// 探测成功关闭熔断
+	if node.record(true, 2) || node.state != breakerClosed || node.failures != 0 {
+		t.Fatal("successful probe should close the breaker")
+	}
+	if !node.allow(cooldown) || !node.allow(cooldown) {
+		t.Fatal("closed breaker should allow calls")
+	}
+}
+
+func TestEndorserCallClassify(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	cases := []struct {
+		name     string
+		err      error
+		failures int
+		retried  bool
+	}{
+		{name: "ok"},
+		{name: "rejected", err: status.Error(codes.InvalidArgument, "bad tx")},
+		{name: "permission denied", err: status.Error(codes.PermissionDenied, "forbidden")},
+		{name: "internal", err: status.Error(codes.Internal, "panic"), failures: 1},
+		{name: "unknown", err: status.Error(codes.Unknown, "unknown"), failures: 1},
+		{name: "canceled", err: status.Error(codes.Canceled, "canceled")},
+		{name: "unavailable", err: unavailable, failures: 1, retried: true},
+		{name: "resource exhausted", err: status.Error(codes.ResourceExhausted, "limited"), failures: 1, retried: true},
+	}
+	for _, c := range cases {
+		a, _ := newTestNode("a", c.err)
+		a.failures = 1
+		b, clientB := newTestNode("b")
+		pool := newTestPool(a, b)
+		// 轮询从a开始
+		pool.next = uint32(len(pool.nodes) - 1)
+
+		_, err := pool.EndorserCall(context.Background(), &pb.EndorserRequest{})
+		if c.retried {
+			if err != nil || clientB.calls != 1 {
+				t.Errorf("%s: should be retried on other node, err %v", c.name, err)
+			}
+		} else if status.Code(err) != status.Code(c.err) || clientB.calls != 0 {
+			t.Errorf("%s: should not be retried, err %v", c.name, err)
+		}
+
+		// 失败累计，成功和拒绝清零，取消保持不变
+		expect := 0
+		if c.failures > 0 {
+			expect = 2
+		} else if c.name == "canceled" {
+			expect = 1
+		}
+		if a.failures != expect {
+			t.Errorf("%s: failures %d, expect %d", c.name, a.failures, expect)
+		}
+		if c.failures > 0 && a.state != breakerOpen {
+			t.Errorf("%s: breaker should be open", c.name)
+		}
+	}
+}
+
+func TestEndorserCallCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	a, _ := newTestNode("a", status.Error(codes.DeadlineExceeded, "deadline exceeded"))
+	b, clientB := newTestNode("b")
+	pool := newTestPool(a, b)
+	pool.next = uint32(len(pool.nodes) - 1)
+
+	if _, err := pool.EndorserCall(ctx, &pb.EndorserRequest{}); err == nil {
+		t.Fatal("expect error")
+	}
+	if a.failures != 0 || clientB.calls != 0 {
+		t.Errorf("canceled call should be neither failure nor retried, failures %d", a.failures)
+	}
+}
@@ -4,15 +4,18 @@ import (
 	"errors"
 	"net"
 	"sync"
+	"time"
 
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
-	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
 	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/metrics"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
 	acom "github.com/xuperchain/xuperos/service/adapter/common"
+	"github.com/xuperchain/xuperos/service/adapter/event"
 )
 
 // eventService implements the interface of pb.EventService
@@ -24,10 +27,11 @@ type eventService struct {
 	connCounter map[string]int
 }
 
-func newEventService(cfg *sconf.ServConf, engine ecom.Engine) *eventService {
+func newEventService(cfg *sconf.ServConf, engine ecom.Engine,
+	pendingTxs *event.PendingTxFeed) *eventService {
 	return &eventService{
 		cfg:         cfg,
-		router:      event.NewRouter(engine),
+		router:      event.NewRouter(engine, pendingTxs),
 		connCounter: make(map[string]int),
 	}
 }
@@ -45,27 +49,64 @@ func (e *eventService) Subscribe(req *pb.SubscribeRequest, stream pb.EventServic
 	}
 	defer e.releaseConn(remoteIP)
 
-	encfunc, iter, err := e.router.Subscribe(acom.ConvertEventSubType(req.GetType()), req.GetFilter())
+	iter, err := e.router.Subscribe(req)
 	if err != nil {
 		return err
 	}
-	for iter.Next() {
-		payload := iter.Data()
-		buf, _ := encfunc(payload)
-		event := &pb.Event{
-			Payload: buf,
-		}
-		err := stream.Send(event)
-		if err != nil {
-			break
-		}
+
+	typ := req.GetType().String()
+	metrics.EventSubscriberGauge.WithLabelValues(typ).Inc()
+	defer metrics.EventSubscriberGauge.WithLabelValues(typ).Dec()
+
+	sub := newSubscription(iter, e.cfg.EventBufferSize, e.cfg.EventSlowPolicy, typ)
+	go sub.produce()
+	defer sub.close()
+
+	return e.consume(stream, sub, req.GetStartCursor())
+}
+
+// 从订阅缓冲区取出事件推送给客户端，空闲时发送心跳
+func (e *eventService) consume(stream pb.EventService_SubscribeServer, sub *subscription,
+	cursor *pb.EventCursor) error {
+
+	var heartbeat <-chan time.Time
+	if e.cfg.EventHeartbeat > 0 {
+		ticker := time.NewTicker(time.Duration(e.cfg.EventHeartbeat) * time.Second)
+		defer ticker.Stop()
+		heartbeat = ticker.C
 	}
-	iter.Close()
 
-	if iter.Error() != nil {
-		return iter.Error()
+	idle := true
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case ev, ok := <-sub.events:
+			if !ok {
+				return sub.finish(cursor)
+			}
+			metrics.EventLagHistogram.WithLabelValues(sub.typ).Observe(float64(len(sub.events)))
+			if err := stream.Send(ev); err != nil {
+				return nil
+			}
+			if ev.GetCursor() != nil {
+				cursor = ev.GetCursor()
+			}
+			idle = false
+		case <-heartbeat:
+			if !idle {
+				idle = true
+				continue
+			}
+			ev := &pb.Event{
+				Cursor:    cursor,
+				Heartbeat: true,
+			}
+			if err := stream.Send(ev); err != nil {
+				return nil
+			}
+		}
 	}
-	return nil
 }
 
 func (e *eventService) connPermit(ctx context.Context) (string, error) {
@@ -77,6 +118,12 @@ func (e *eventService) connPermit(ctx context.Context) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// 本机网关转发的订阅按浏览器客户端地址计数
+	if ip := net.ParseIP(remoteIP); ip != nil && ip.IsLoopback() {
+		if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(acom.ForwardedForKey)) > 0 {
+			remoteIP = md.Get(acom.ForwardedForKey)[0]
+		}
+	}
 
 	if e.cfg.EventAddrMaxConn == 0 {
 		return remoteIP, nil
@@ -0,0 +1,134 @@
+package rpc
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/event"
+
+	"github.com/xuperchain/xuperos/common/metrics"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+// 订阅缓冲区满时的处理策略
+const (
+	// 阻塞等待客户端消费，同时暂停读取账本
+	SlowPolicyBlock = "block"
+	// 丢弃缓冲区中最早的事件
+	SlowPolicyDropOldest = "drop_oldest"
+	// 断开订阅，客户端根据最后收到的游标续订
+	SlowPolicyDisconnect = "disconnect"
+)
+
+// 默认订阅缓冲区大小
+const defEventBufferSize = 1024
+
+func validSlowPolicy(policy string) bool {
+	switch policy {
+	case SlowPolicyBlock, SlowPolicyDropOldest, SlowPolicyDisconnect:
+		return true
+	}
+	return false
+}
+
+// subscription 单个订阅的有界缓冲区，读取事件和推送事件在不同的goroutine
+type subscription struct {
+	iter   event.Iterator
+	policy string
+	typ    string
+	events chan *pb.Event
+
+	// 缓冲区溢出导致断开
+	overflow  bool
+	err       error
+	exitCh    chan struct{}
+	closeOnce sync.Once
+}
+
+func newSubscription(iter event.Iterator, size int, policy, typ string) *subscription {
+	if size <= 0 {
+		size = defEventBufferSize
+	}
+	return &subscription{
+		iter:   iter,
+		policy: policy,
+		typ:    typ,
+		events: make(chan *pb.Event, size),
+		exitCh: make(chan struct{}),
+	}
+}
+
+// 从迭代器读取事件写入缓冲区，迭代结束、出错或溢出断开时关闭缓冲区
+func (t *subscription) produce() {
+	defer close(t.events)
+
+	for t.iter.Next() {
+		ev := t.iter.Data().(*pb.Event)
+		if !t.push(ev) {
+			return
+		}
+	}
+	t.err = t.iter.Error()
+}
+
+func (t *subscription) push(ev *pb.Event) bool {
+	switch t.policy {
+	case SlowPolicyDropOldest:
+		for {
+			select {
+			case <-t.exitCh:
+				return false
+			case t.events <- ev:
+				return true
+			default:
+			}
+			select {
+			case <-t.events:
+				metrics.EventDropCounter.WithLabelValues(t.typ, t.policy).Inc()
+			default:
+			}
+		}
+	case SlowPolicyDisconnect:
+		select {
+		case <-t.exitCh:
+			return false
+		case t.events <- ev:
+			return true
+		default:
+			t.overflow = true
+			metrics.EventDropCounter.WithLabelValues(t.typ, t.policy).Inc()
+			return false
+		}
+	default:
+		select {
+		case <-t.exitCh:
+			return false
+		case t.events <- ev:
+			return true
+		}
+	}
+}
+
+// 缓冲区关闭后的订阅结果，溢出断开时返回最后推送的游标供客户端续订
+func (t *subscription) finish(cursor *pb.EventCursor) error {
+	if t.overflow && cursor == nil {
+		return status.Errorf(codes.ResourceExhausted,
+			"slow consumer, event buffer overflow, resume from the start cursor")
+	}
+	if t.overflow {
+		return status.Errorf(codes.ResourceExhausted,
+			"slow consumer, event buffer overflow, resume from cursor %s", acom.FormatCursor(cursor))
+	}
+	return t.err
+}
+
+// 停止读取并释放迭代器
+func (t *subscription) close() {
+	t.closeOnce.Do(func() {
+		close(t.exitCh)
+		t.iter.Close()
+	})
+}
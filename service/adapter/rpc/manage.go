@@ -1,19 +1,18 @@
 package rpc
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"errors"
 	"fmt"
-	"io/ioutil"
 	"net"
-	"path/filepath"
 	"sync"
+	"time"
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	gpromeus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	// 注册gzip压缩，客户端请求压缩时响应使用相同算法
+	_ "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/xuperchain/xupercore/kernel/engines"
@@ -23,39 +22,88 @@ import (
 
 	sconf "github.com/xuperchain/xuperos/common/config"
 	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	"github.com/xuperchain/xuperos/common/metrics"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/models"
+	"github.com/xuperchain/xuperos/service/adapter/event"
+	scom "github.com/xuperchain/xuperos/service/common"
 )
 
 // rpc server启停控制管理
 type RpcServMG struct {
-	scfg     *sconf.ServConf
-	engine   ecom.Engine
-	log      logs.Logger
-	rpcServ  *RpcServ
-	servHD   *grpc.Server
-	isInit   bool
-	exitOnce *sync.Once
+	scfg    *sconf.ServConf
+	engine  ecom.Engine
+	log     logs.Logger
+	rpcServ *RpcServ
+	servHD  *grpc.Server
+	// tls端口，adapterRpcPort使用tls时servHD为空
+	tlsServHD *grpc.Server
+	speedMon  *speedMonitor
+	peerMon   *peerMonitor
+	pool      *endorserPool
+	endorser  pb.XendorserServer
+	isInit    bool
+	exitOnce  *sync.Once
 }
 
-func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*RpcServMG, error) {
-	if scfg == nil || engine == nil {
+func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine, drainer *scom.Drainer,
+	ledgerCache *models.LedgerCache, speeds *metrics.Speeds,
+	pendingTxs *event.PendingTxFeed) (*RpcServMG, error) {
+	if scfg == nil || engine == nil || drainer == nil || speeds == nil || pendingTxs == nil {
 		return nil, fmt.Errorf("param error")
 	}
 	xosEngine, err := xuperos.EngineConvert(engine)
 	if err != nil {
 		return nil, fmt.Errorf("not xuperos engine")
 	}
+	if scfg.AdapterTlsPort > 0 && scfg.AdapterTlsPort == scfg.AdapterRpcPort {
+		return nil, fmt.Errorf("adapter tls port conflicts with rpc port")
+	}
+	if scfg.EnableEvent && !validSlowPolicy(scfg.EventSlowPolicy) {
+		return nil, fmt.Errorf("unknown event slow policy: %s", scfg.EventSlowPolicy)
+	}
 
-	log, _ := logs.NewLogger("", def.SubModName)
+	log, _ := loglevel.NewLogger("", def.SubModName)
+	peerMon := newPeerMonitor(xosEngine, log)
+	lockGuard, err := models.NewUtxoLockGuard(scfg.StrictUtxoLockSign,
+		time.Duration(scfg.UtxoLockSignWindow)*time.Second)
+	if err != nil {
+		return nil, err
+	}
+	rpcServ := NewRpcServ(engine.(ecom.Engine), log, speeds, peerMon, drainer, lockGuard,
+		ledgerCache, pendingTxs)
 	obj := &RpcServMG{
 		scfg:     scfg,
 		engine:   xosEngine,
 		log:      log,
-		rpcServ:  NewRpcServ(engine.(ecom.Engine), log),
+		rpcServ:  rpcServ,
+		speedMon: newSpeedMonitor(xosEngine, speeds, log),
+		peerMon:  peerMon,
 		isInit:   true,
 		exitOnce: &sync.Once{},
 	}
 
+	if scfg.EnableEndorser {
+		switch scfg.EndorserMode {
+		case EndorserModeProxy:
+			pool, err := newEndorserPool(scfg, xosEngine.Context().EnvCfg.RootPath, log)
+			if err != nil {
+				return nil, err
+			}
+			obj.pool = pool
+			obj.endorser = newEndorserService(pool)
+		case EndorserModeLocal:
+			endorser, err := newLocalEndorser(scfg, obj.rpcServ, xosEngine)
+			if err != nil {
+				return nil, err
+			}
+			obj.endorser = endorser
+		default:
+			return nil, fmt.Errorf("unknown endorser mode: %s", scfg.EndorserMode)
+		}
+	}
+
 	return obj, nil
 }
 
@@ -67,6 +115,13 @@ func (t *RpcServMG) Run() error {
 
 	t.log.Trace("run grpc server", "isTls", t.scfg.EnableTls)
 
+	// 启动速率统计和节点诊断
+	go t.speedMon.run()
+	t.peerMon.start()
+	if t.pool != nil {
+		t.pool.start()
+	}
+
 	// 启动rpc server，阻塞直到退出
 	err := t.runRpcServ()
 	if err != nil {
@@ -85,11 +140,17 @@ func (t *RpcServMG) Exit() {
 	}
 
 	t.exitOnce.Do(func() {
+		t.speedMon.stop()
+		t.peerMon.stop()
 		t.stopRpcServ()
+		if t.pool != nil {
+			t.pool.stop()
+		}
 	})
 }
 
 // 启动rpc服务，阻塞直到退出
+// 开启adapterTlsPort时同时提供明文和tls端口，任一端口异常退出时返回
 func (t *RpcServMG) runRpcServ() error {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		t.rpcServ.UnaryInterceptor(),
@@ -105,35 +166,49 @@ func (t *RpcServMG) runRpcServ() error {
 		grpc.WriteBufferSize(t.scfg.WriteBufSize),
 	}
 
-	if t.scfg.EnableTls {
+	// event involved rpc
+	eventService := newEventService(t.scfg, t.engine, t.rpcServ.pendingTxs)
+	servers := make(map[int]*grpc.Server)
+	if !t.scfg.AdapterRpcTls() {
+		t.servHD = t.newServer(rpcOptions, eventService)
+		servers[t.scfg.AdapterRpcPort] = t.servHD
+	}
+	if t.scfg.EnableTls || t.scfg.AdapterTlsPort > 0 {
 		creds, err := t.newTls()
 		if err != nil {
 			return err
 		}
-		rpcOptions = append(rpcOptions, grpc.Creds(creds))
+		port := t.scfg.AdapterTlsPort
+		if port <= 0 {
+			port = t.scfg.AdapterRpcPort
+		}
+		t.tlsServHD = t.newServer(append(rpcOptions, grpc.Creds(creds)), eventService)
+		servers[port] = t.tlsServHD
 	}
 
-	t.servHD = grpc.NewServer(rpcOptions...)
-	pb.RegisterXchainServer(t.servHD, t.rpcServ)
-
-	// event involved rpc
-	eventService := newEventService(t.scfg, t.engine)
-	pb.RegisterEventServiceServer(t.servHD, eventService)
-
-	if t.scfg.EnableEndorser {
-		endorserService := newEndorserService(t.scfg, t.engine)
-		pb.RegisterXendorserServer(t.servHD, endorserService)
+	errCh := make(chan error, len(servers))
+	for port, server := range servers {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			t.log.Error("failed to listen", "port", port, "err", err)
+			t.stopRpcServ()
+			return fmt.Errorf("failed to listen")
+		}
+		go func(server *grpc.Server, lis net.Listener) {
+			errCh <- server.Serve(lis)
+		}(server, lis)
 	}
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", t.scfg.AdapterRpcPort))
-	if err != nil {
-		t.log.Error("failed to listen", "err", err)
-		return fmt.Errorf("failed to listen")
+	// 一个端口退出时关闭其他端口
+	var err error
+	for range servers {
+		if e := <-errCh; e != nil && err == nil {
+			t.log.Error("failed to serve", "err", e)
+			err = e
+		}
+		t.stopRpcServ()
 	}
-
-	reflection.Register(t.servHD)
-	if err := t.servHD.Serve(lis); err != nil {
-		t.log.Error("failed to serve", "err", err)
+	if err != nil {
 		return err
 	}
 
@@ -141,39 +216,34 @@ func (t *RpcServMG) runRpcServ() error {
 	return nil
 }
 
+func (t *RpcServMG) newServer(rpcOptions []grpc.ServerOption, eventService *eventService) *grpc.Server {
+	servHD := grpc.NewServer(rpcOptions...)
+	pb.RegisterXchainServer(servHD, t.rpcServ)
+	pb.RegisterEventServiceServer(servHD, eventService)
+	if t.endorser != nil {
+		pb.RegisterXendorserServer(servHD, t.endorser)
+	}
+	reflection.Register(servHD)
+	return servHD
+}
+
 func (t *RpcServMG) newTls() (credentials.TransportCredentials, error) {
 	envConf := t.engine.Context().EnvCfg
 	tlsPath := envConf.GenDataAbsPath(envConf.TlsDir)
-	bs, err := ioutil.ReadFile(filepath.Join(tlsPath, "cert.crt"))
+	loader, err := scom.NewTlsLoader(t.scfg.Tls, tlsPath, t.scfg.TlsServerName, t.log)
 	if err != nil {
 		return nil, err
 	}
-	certPool := x509.NewCertPool()
-	ok := certPool.AppendCertsFromPEM(bs)
-	if !ok {
-		return nil, err
-	}
-	certificate, err := tls.LoadX509KeyPair(filepath.Join(tlsPath, "key.pem"),
-		filepath.Join(tlsPath, "private.key"))
-	if err != nil {
-		return nil, err
-	}
-
-	creds := credentials.NewTLS(&tls.Config{
-		ServerName:   t.scfg.TlsServerName,
-		Certificates: []tls.Certificate{certificate},
-		RootCAs:      certPool,
-		ClientCAs:    certPool,
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-	})
-
-	return creds, nil
+	return credentials.NewTLS(loader.ServerConfig()), nil
 }
 
 // 需要幂等
 func (t *RpcServMG) stopRpcServ() {
+	// 优雅关闭grpc server
 	if t.servHD != nil {
-		// 优雅关闭grpc server
 		t.servHD.GracefulStop()
 	}
+	if t.tlsServHD != nil {
+		t.tlsServHD.GracefulStop()
+	}
 }
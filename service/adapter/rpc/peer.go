@@ -0,0 +1,346 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	xctx "github.com/xuperchain/xupercore/kernel/common/xcontext"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos/xpb"
+	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/timer"
+	"github.com/xuperchain/xupercore/protos"
+
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+// 记录各节点最近一次消息时间的消息类型
+var peerActiveMsgTypes = []protos.XuperMessage_MessageType{
+	protos.XuperMessage_SENDBLOCK,
+	protos.XuperMessage_POSTTX,
+	protos.XuperMessage_BATCHPOSTTX,
+	protos.XuperMessage_GET_BLOCK,
+	protos.XuperMessage_GET_BLOCKCHAINSTATUS,
+	protos.XuperMessage_CONFIRM_BLOCKCHAINSTATUS,
+	protos.XuperMessage_NEW_BLOCKID,
+	protos.XuperMessage_GET_BLOCKIDS,
+	protos.XuperMessage_GET_BLOCKS,
+	protos.XuperMessage_GET_PEER_INFO,
+	protos.XuperMessage_CHAINED_BFT_NEW_VIEW_MSG,
+	protos.XuperMessage_CHAINED_BFT_NEW_PROPOSAL_MSG,
+	protos.XuperMessage_CHAINED_BFT_VOTE_MSG,
+}
+
+const (
+	// 后台探测节点的间隔
+	peerProbeInterval = 30 * time.Second
+	// 单个节点所有链的探测超时
+	peerProbeTimeout = 5 * time.Second
+)
+
+var errProbeTimeout = errors.New("probe timeout")
+
+// 节点诊断：记录节点消息时间，后台定期探测节点链高度和时延，查询时返回最近一次探测结果
+type peerMonitor struct {
+	engine ecom.Engine
+	log    logs.Logger
+	subs   []p2p.Subscriber
+	// 节点标识 => 最近一次消息时间(ms)
+	lastMsg *sync.Map
+
+	mutex sync.Mutex
+	// 节点标识 => 最近一次探测结果
+	probes   map[string]*pb.PeerDetail
+	exitChan chan struct{}
+	exitOnce *sync.Once
+}
+
+func newPeerMonitor(engine ecom.Engine, log logs.Logger) *peerMonitor {
+	return &peerMonitor{
+		engine:   engine,
+		log:      log,
+		lastMsg:  &sync.Map{},
+		probes:   make(map[string]*pb.PeerDetail),
+		exitChan: make(chan struct{}),
+		exitOnce: &sync.Once{},
+	}
+}
+
+// probeCtx 探测节点的上下文，p2p层未必使用调用方的超时，探测时另外等待超时
+type probeCtx struct {
+	context.Context
+	log   logs.Logger
+	timer *timer.XTimer
+}
+
+func (t *probeCtx) GetLog() logs.Logger     { return t.log }
+func (t *probeCtx) GetTimer() *timer.XTimer { return t.timer }
+
+// 订阅p2p消息，只记录来源节点，不处理消息，并启动后台探测
+func (t *peerMonitor) start() {
+	go t.run()
+
+	net := t.engine.Context().Net
+	for _, typ := range peerActiveMsgTypes {
+		sub := &peerActiveSubscriber{typ: typ, lastMsg: t.lastMsg}
+		if err := net.Register(sub); err != nil {
+			t.log.Warn("register peer active subscriber failed", "type", typ, "err", err)
+			continue
+		}
+		t.subs = append(t.subs, sub)
+	}
+}
+
+// 需要幂等
+func (t *peerMonitor) stop() {
+	t.exitOnce.Do(func() {
+		close(t.exitChan)
+	})
+	net := t.engine.Context().Net
+	for _, sub := range t.subs {
+		net.UnRegister(sub)
+	}
+	t.subs = nil
+}
+
+// 获取本节点和所有连接节点的诊断信息
+func (t *peerMonitor) details() (*pb.PeerDetail, []*pb.PeerDetail) {
+	net := t.engine.Context().Net
+	peerInfo := net.PeerInfo()
+	bcNames := t.engine.GetChains()
+
+	local := &pb.PeerDetail{
+		Id:      peerInfo.GetId(),
+		Address: peerInfo.GetAddress(),
+		Account: peerInfo.GetAccount(),
+	}
+	for _, bcName := range bcNames {
+		chain, err := t.engine.Get(bcName)
+		if err != nil {
+			continue
+		}
+		meta := chain.Context().Ledger.GetMeta()
+		local.Chains = append(local.Chains, &pb.PeerChainHeight{
+			Bcname:     bcName,
+			Height:     meta.GetTrunkHeight(),
+			TipBlockid: meta.GetTipBlockid(),
+		})
+	}
+
+	outbound := t.outboundNodes()
+	peers := make([]*pb.PeerDetail, 0, len(peerInfo.GetPeer()))
+	for _, remote := range peerInfo.GetPeer() {
+		peer := &pb.PeerDetail{
+			Id:          remote.GetId(),
+			Address:     remote.GetAddress(),
+			Account:     remote.GetAccount(),
+			Direction:   pb.PeerDirection_INBOUND,
+			LastMsgTime: t.lastMsgTime(remote),
+		}
+		if outbound[remote.GetId()] || outbound[remote.GetAddress()] {
+			peer.Direction = pb.PeerDirection_OUTBOUND
+		}
+		if probe := t.lastProbe(peer); probe != nil {
+			peer.Chains = probe.GetChains()
+			peer.Latency = probe.GetLatency()
+			peer.Error = probe.GetError()
+			peer.ProbeTime = probe.GetProbeTime()
+		}
+		peers = append(peers, peer)
+	}
+	return local, peers
+}
+
+// 定期探测，阻塞直到stop
+func (t *peerMonitor) run() {
+	ticker := time.NewTicker(peerProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		t.refresh()
+		select {
+		case <-t.exitChan:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// 并发探测所有连接的节点，替换全部探测结果，已断开的节点不再保留
+func (t *peerMonitor) refresh() {
+	peerInfo := t.engine.Context().Net.PeerInfo()
+	bcNames := t.engine.GetChains()
+
+	probes := make(map[string]*pb.PeerDetail)
+	mutex := sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	for _, remote := range peerInfo.GetPeer() {
+		wg.Add(1)
+		go func(remote *protos.PeerInfo) {
+			defer wg.Done()
+			peer := &pb.PeerDetail{Id: remote.GetId(), Address: remote.GetAddress()}
+			t.probeWithTimeout(peer, bcNames)
+			mutex.Lock()
+			probes[peerKey(peer)] = peer
+			mutex.Unlock()
+		}(remote)
+	}
+	wg.Wait()
+
+	t.mutex.Lock()
+	t.probes = probes
+	t.mutex.Unlock()
+}
+
+// 超时后不再等待，探测结果写入副本，避免超时后继续修改
+func (t *peerMonitor) probeWithTimeout(peer *pb.PeerDetail, bcNames []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), peerProbeTimeout)
+	defer cancel()
+	pctx := &probeCtx{Context: ctx, log: t.log, timer: timer.NewXTimer()}
+
+	result := &pb.PeerDetail{Id: peer.GetId(), Address: peer.GetAddress()}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		t.probe(pctx, result, bcNames)
+	}()
+
+	select {
+	case <-done:
+		peer.Chains = result.GetChains()
+		peer.Latency = result.GetLatency()
+		peer.Error = result.GetError()
+	case <-ctx.Done():
+		peer.Error = errProbeTimeout.Error()
+	}
+	peer.ProbeTime = time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func (t *peerMonitor) lastProbe(peer *pb.PeerDetail) *pb.PeerDetail {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.probes[peerKey(peer)]
+}
+
+func peerKey(peer *pb.PeerDetail) string {
+	return peer.GetId() + "/" + peer.GetAddress()
+}
+
+// 向节点查询各链状态，时延取各链探测的最小值
+func (t *peerMonitor) probe(ctx xctx.XContext, peer *pb.PeerDetail, bcNames []string) {
+	var target p2p.OptionFunc
+	if peer.GetId() != "" && peer.GetId() != peer.GetAddress() {
+		target = p2p.WithPeerIDs([]string{peer.GetId()})
+	} else {
+		target = p2p.WithAddresses([]string{peer.GetAddress()})
+	}
+
+	net := t.engine.Context().Net
+	for _, bcName := range bcNames {
+		msg := p2p.NewMessage(protos.XuperMessage_GET_BLOCKCHAINSTATUS, nil,
+			p2p.WithBCName(bcName),
+			p2p.WithLogId(ctx.GetLog().GetLogId()),
+		)
+		begin := time.Now()
+		responses, err := net.SendMessageWithResponse(ctx, msg, target)
+		if err != nil || len(responses) == 0 {
+			if err != nil {
+				peer.Error = err.Error()
+			} else {
+				peer.Error = "no response"
+			}
+			continue
+		}
+		latency := time.Since(begin).Milliseconds()
+		if peer.Latency == 0 || latency < peer.Latency {
+			peer.Latency = latency
+		}
+
+		response := responses[0]
+		if response.GetHeader().GetErrorType() != protos.XuperMessage_SUCCESS {
+			continue
+		}
+		var status xpb.ChainStatus
+		if err := p2p.Unmarshal(response, &status); err != nil {
+			t.log.Warn("unmarshal peer chain status failed", "peer", peer.GetAddress(), "err", err)
+			continue
+		}
+		peer.Chains = append(peer.Chains, &pb.PeerChainHeight{
+			Bcname:     bcName,
+			Height:     status.GetLedgerMeta().GetTrunkHeight(),
+			TipBlockid: status.GetLedgerMeta().GetTipBlockid(),
+		})
+	}
+	// 有链探测成功时不再报错
+	if len(peer.Chains) > 0 {
+		peer.Error = ""
+	}
+}
+
+// 配置中主动连接的节点
+func (t *peerMonitor) outboundNodes() map[string]bool {
+	nodes := make(map[string]bool)
+	conf := t.engine.Context().Net.Context().P2PConf
+	if conf == nil {
+		return nodes
+	}
+
+	addNode := func(addr string) {
+		nodes[addr] = true
+		if host := acom.MultiAddrToHost(addr); host != "" {
+			nodes[host] = true
+		}
+		// p2pv2地址格式 /ip4/x/tcp/x/p2p/<peerid>
+		if idx := strings.LastIndex(addr, "/p2p/"); idx >= 0 {
+			nodes[addr[idx+len("/p2p/"):]] = true
+		}
+	}
+	for _, addr := range conf.BootNodes {
+		addNode(addr)
+	}
+	for _, addrs := range conf.StaticNodes {
+		for _, addr := range addrs {
+			addNode(addr)
+		}
+	}
+	return nodes
+}
+
+func (t *peerMonitor) lastMsgTime(peer *protos.PeerInfo) int64 {
+	for _, key := range []string{peer.GetId(), peer.GetAddress()} {
+		if v, ok := t.lastMsg.Load(key); ok {
+			return v.(int64)
+		}
+	}
+	return 0
+}
+
+// 只记录消息来源的订阅者
+type peerActiveSubscriber struct {
+	typ     protos.XuperMessage_MessageType
+	lastMsg *sync.Map
+}
+
+func (s *peerActiveSubscriber) GetMessageType() protos.XuperMessage_MessageType {
+	return s.typ
+}
+
+func (s *peerActiveSubscriber) Match(msg *protos.XuperMessage) bool {
+	return msg.GetHeader().GetFrom() != ""
+}
+
+func (s *peerActiveSubscriber) HandleMessage(ctx xctx.XContext, msg *protos.XuperMessage, stream p2p.Stream) error {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	from := msg.GetHeader().GetFrom()
+	s.lastMsg.Store(from, now)
+	// p2pv1节点标识为host:port，消息来源为multiaddr
+	if host := acom.MultiAddrToHost(from); host != "" {
+		s.lastMsg.Store(host, now)
+	}
+	return nil
+}
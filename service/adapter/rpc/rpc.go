@@ -10,26 +10,48 @@ import (
 	"strings"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 
-	sctx "github.com/xuperchain/xupercore/example/xchain/common/context"
 	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
 	"github.com/xuperchain/xupercore/lib/logs"
 	"github.com/xuperchain/xupercore/lib/utils"
 
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/metrics"
 	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/models"
 	acom "github.com/xuperchain/xuperos/service/adapter/common"
+	"github.com/xuperchain/xuperos/service/adapter/event"
+	scom "github.com/xuperchain/xuperos/service/common"
 )
 
 type RpcServ struct {
-	engine ecom.Engine
-	log    logs.Logger
+	engine  ecom.Engine
+	log     logs.Logger
+	speeds  *metrics.Speeds
+	peers   *peerMonitor
+	drainer *scom.Drainer
+	// 锁定utxo签名的时效和防重放校验
+	lockGuard *models.UtxoLockGuard
+	// 已确认区块和交易的查询缓存
+	ledgerCache *models.LedgerCache
+	// 本节点提交的交易推送给待确认交易订阅
+	pendingTxs *event.PendingTxFeed
 }
 
-func NewRpcServ(engine ecom.Engine, log logs.Logger) *RpcServ {
+func NewRpcServ(engine ecom.Engine, log logs.Logger, speeds *metrics.Speeds,
+	peers *peerMonitor, drainer *scom.Drainer, lockGuard *models.UtxoLockGuard,
+	ledgerCache *models.LedgerCache, pendingTxs *event.PendingTxFeed) *RpcServ {
 	return &RpcServ{
-		engine: engine,
-		log:    log,
+		engine:      engine,
+		log:         log,
+		speeds:      speeds,
+		peers:       peers,
+		drainer:     drainer,
+		lockGuard:   lockGuard,
+		ledgerCache: ledgerCache,
+		pendingTxs:  pendingTxs,
 	}
 }
 
@@ -37,6 +59,10 @@ func NewRpcServ(engine ecom.Engine, log logs.Logger) *RpcServ {
 func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (respRes interface{}, err error) {
+		// 统计处理中的请求，用于排空
+		t.drainer.Acquire()
+		defer t.drainer.Release()
+
 		// set request header
 		type HeaderInterface interface {
 			GetHeader() *pb.Header
@@ -44,11 +70,11 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		if req.(HeaderInterface).GetHeader() == nil {
 			header := reflect.ValueOf(req).Elem().FieldByName("Header")
 			if header.IsValid() && header.IsNil() && header.CanSet() {
-				header.Set(reflect.ValueOf(t.defReqHeader()))
+				header.Set(reflect.ValueOf(t.defReqHeader(ctx)))
 			}
 		}
 		if req.(HeaderInterface).GetHeader().GetLogid() == "" {
-			req.(HeaderInterface).GetHeader().Logid = utils.GenLogId()
+			req.(HeaderInterface).GetHeader().Logid = t.forwardedLogId(ctx)
 		}
 		reqHeader := req.(HeaderInterface).GetHeader()
 
@@ -65,7 +91,7 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		defer func() {
 			reqCtx.GetLog().Info("access", logFields...)
 			if e := recover(); e != nil {
-				err = fmt.Errorf("%s log_id = %s", ecom.ErrInternal, reqCtx.GetLog().GetLogId())
+				err = acom.GrpcStatusError(ecom.ErrInternal, reqCtx.GetLog().GetLogId())
 				reqCtx.GetLog().Error("Rpc server happen panic", "error", e)
 
 				// stack
@@ -80,19 +106,23 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 		stdErr := ecom.ErrSuccess
 		respRes, err = handler(ctx, req)
 		if err != nil {
-			stdErr = ecom.CastError(err)
+			stdErr = acom.CastError(err)
 		}
-		// 根据错误统一设置header，对外统一响应err=nil，通过Header.ErrCode判断
+		// 根据错误统一设置header，失败时响应body不会返回给客户端，header只在成功时送达
 		respHeader := &pb.Header{
 			Logid:    reqHeader.GetLogid(),
 			FromNode: t.genTraceId(),
-			Error:    t.convertErr(stdErr),
+			Error:    acom.ConvertErr(stdErr),
 		}
 		// 通过反射设置header到response
 		header := reflect.ValueOf(respRes).Elem().FieldByName("Header")
 		if header.IsValid() && header.IsNil() && header.CanSet() {
 			header.Set(reflect.ValueOf(respHeader))
 		}
+		// 失败时响应grpc状态码，ErrorInfo中携带原header错误码
+		if err != nil {
+			err = acom.GrpcStatusError(err, reqHeader.GetLogid())
+		}
 
 		// output ending log
 		// 可以通过log库提供的SetInfoField方法附加输出到ending log
@@ -102,9 +132,19 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-func (t *RpcServ) defReqHeader() *pb.Header {
+// 网关转发的请求使用网关的logid，便于关联http和grpc日志
+func (t *RpcServ) forwardedLogId(gctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(gctx); ok {
+		if ids := md.Get(acom.LogIdKey); len(ids) > 0 && ids[0] != "" {
+			return ids[0]
+		}
+	}
+	return utils.GenLogId()
+}
+
+func (t *RpcServ) defReqHeader(gctx context.Context) *pb.Header {
 	return &pb.Header{
-		Logid:    utils.GenLogId(),
+		Logid:    t.forwardedLogId(gctx),
 		FromNode: "",
 		Error:    pb.XChainErrorEnum_UNKNOW_ERROR,
 	}
@@ -146,16 +186,3 @@ func (t *RpcServ) getClietIP(gctx context.Context) (string, error) {
 func (t *RpcServ) genTraceId() string {
 	return utils.GetHostName()
 }
-
-// 转化错误类型为原接口错误
-func (t *RpcServ) convertErr(stdErr *ecom.Error) pb.XChainErrorEnum {
-	if stdErr == nil {
-		return pb.XChainErrorEnum_UNKNOW_ERROR
-	}
-
-	if errCode, ok := acom.StdErrToXchainErrMap[stdErr.Code]; ok {
-		return errCode
-	}
-
-	return pb.XChainErrorEnum_UNKNOW_ERROR
-}
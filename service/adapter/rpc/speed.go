@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	"github.com/xuperchain/xuperos/common/metrics"
+)
+
+const (
+	// 账本轮询间隔
+	speedPollInterval = time.Second
+	// 单次轮询最多回溯的区块数，避免追块时长时间占用
+	speedMaxBackTrace = 256
+)
+
+// 轮询各链账本，统计确认的区块和交易
+type speedMonitor struct {
+	engine ecom.Engine
+	speeds *metrics.Speeds
+	log    logs.Logger
+	// 各链已统计到的高度
+	heights  map[string]int64
+	exitChan chan struct{}
+	exitOnce *sync.Once
+}
+
+func newSpeedMonitor(engine ecom.Engine, speeds *metrics.Speeds, log logs.Logger) *speedMonitor {
+	return &speedMonitor{
+		engine:   engine,
+		speeds:   speeds,
+		log:      log,
+		heights:  make(map[string]int64),
+		exitChan: make(chan struct{}),
+		exitOnce: &sync.Once{},
+	}
+}
+
+// 启动轮询，阻塞直到stop
+func (t *speedMonitor) run() {
+	ticker := time.NewTicker(speedPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.exitChan:
+			return
+		case <-ticker.C:
+			for _, bcName := range t.engine.GetChains() {
+				t.poll(bcName)
+			}
+			t.speeds.UpdateGauge()
+		}
+	}
+}
+
+// 需要幂等
+func (t *speedMonitor) stop() {
+	t.exitOnce.Do(func() {
+		close(t.exitChan)
+	})
+}
+
+func (t *speedMonitor) poll(bcName string) {
+	chain, err := t.engine.Get(bcName)
+	if err != nil {
+		return
+	}
+	ledger := chain.Context().Ledger
+	meta := ledger.GetMeta()
+	lastHeight, ok := t.heights[bcName]
+	t.heights[bcName] = meta.GetTrunkHeight()
+	// 首次轮询只记录高度，不统计历史区块
+	if !ok || meta.GetTrunkHeight() <= lastHeight {
+		return
+	}
+
+	var blockCnt, txCnt int64
+	blockid := meta.GetTipBlockid()
+	for blockCnt < speedMaxBackTrace {
+		block, err := ledger.QueryBlockHeader(blockid)
+		if err != nil {
+			t.log.Warn("query block header failed", "bc_name", bcName, "err", err)
+			break
+		}
+		if block.GetHeight() <= lastHeight {
+			break
+		}
+		blockCnt++
+		txCnt += int64(block.GetTxCount())
+		blockid = block.GetPreHash()
+	}
+
+	t.speeds.Mark(bcName, metrics.EventBlockConfirmed, blockCnt)
+	t.speeds.Mark(bcName, metrics.EventTxConfirmed, txCnt)
+}
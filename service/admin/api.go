@@ -0,0 +1,410 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"runtime/pprof"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	"github.com/xuperchain/xupercore/protos"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+	scom "github.com/xuperchain/xuperos/service/common"
+)
+
+const (
+	// CPU profile默认和最大采样时长，单位：秒
+	defCpuProfileSeconds = 30
+	maxCpuProfileSeconds = 300
+	// 默认排空超时时间，单位：秒
+	defDrainTimeout = 30
+)
+
+// 注意：
+// 1.rpc接口响应resp不能为nil，必须实例化
+// 2.rpc接口响应err必须为ecom.Error类型的标准错误，没有错误响应err=nil
+// 3.rpc接口不需要关注resp.Header，由拦截器根据err统一设置
+// 4.rpc接口可以调用log库提供的SetInfoField方法附加输出到ending log
+
+// SetLogLevel 运行时调整子模块日志级别
+func (t *AdminServ) SetLogLevel(gctx context.Context, req *pb.SetLogLevelReq) (*pb.LogLevelResp, error) {
+	// 默认响应
+	resp := &pb.LogLevelResp{}
+	// 获取请求上下文，对内传递rctx
+	rctx := sctx.ValueReqCtx(gctx)
+
+	err := loglevel.SetLevel(req.GetModule(), req.GetLevel())
+	if err != nil {
+		rctx.GetLog().Warn("set log level failed", "err", err)
+		return resp, ecom.ErrParameter.More("%v", err)
+	}
+	resp.Levels = loglevel.GetLevels()
+
+	rctx.GetLog().SetInfoField("module", req.GetModule())
+	rctx.GetLog().SetInfoField("level", req.GetLevel())
+	return resp, nil
+}
+
+// GetLogLevels 查询运行时日志级别
+func (t *AdminServ) GetLogLevels(gctx context.Context, req *pb.BaseReq) (*pb.LogLevelResp, error) {
+	resp := &pb.LogLevelResp{}
+	resp.Levels = loglevel.GetLevels()
+	return resp, nil
+}
+
+// DumpGoroutines 导出全部goroutine栈
+func (t *AdminServ) DumpGoroutines(gctx context.Context, req *pb.BaseReq) (*pb.ProfileResp, error) {
+	return t.lookupProfile(gctx, "goroutine", 2)
+}
+
+// HeapProfile 导出heap profile
+func (t *AdminServ) HeapProfile(gctx context.Context, req *pb.BaseReq) (*pb.ProfileResp, error) {
+	return t.lookupProfile(gctx, "heap", 0)
+}
+
+func (t *AdminServ) lookupProfile(gctx context.Context, name string, debug int) (*pb.ProfileResp, error) {
+	resp := &pb.ProfileResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	buf := new(bytes.Buffer)
+	if err := pprof.Lookup(name).WriteTo(buf, debug); err != nil {
+		rctx.GetLog().Warn("write profile failed", "profile", name, "err", err)
+		return resp, ecom.ErrInternal
+	}
+	resp.Data = buf.Bytes()
+
+	rctx.GetLog().SetInfoField("profile", name)
+	rctx.GetLog().SetInfoField("size", len(resp.Data))
+	return resp, nil
+}
+
+// CpuProfile 采样CPU profile，阻塞到采样结束
+func (t *AdminServ) CpuProfile(gctx context.Context, req *pb.CpuProfileReq) (*pb.ProfileResp, error) {
+	resp := &pb.ProfileResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	seconds := req.GetSeconds()
+	if seconds <= 0 {
+		seconds = defCpuProfileSeconds
+	}
+	if seconds > maxCpuProfileSeconds {
+		rctx.GetLog().Warn("param error,profile seconds too large", "seconds", seconds)
+		return resp, ecom.ErrParameter
+	}
+
+	buf := new(bytes.Buffer)
+	// 同一时间只能有一个CPU profile
+	if err := pprof.StartCPUProfile(buf); err != nil {
+		rctx.GetLog().Warn("start cpu profile failed", "err", err)
+		return resp, ecom.ErrForbidden
+	}
+	select {
+	case <-time.After(time.Duration(seconds) * time.Second):
+	case <-gctx.Done():
+	}
+	pprof.StopCPUProfile()
+	resp.Data = buf.Bytes()
+
+	rctx.GetLog().SetInfoField("seconds", seconds)
+	rctx.GetLog().SetInfoField("size", len(resp.Data))
+	return resp, nil
+}
+
+// AddPeer 连接P2P节点
+func (t *AdminServ) AddPeer(gctx context.Context, req *pb.PeerReq) (*pb.PeerResp, error) {
+	resp := &pb.PeerResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req.GetAddress() == "" {
+		rctx.GetLog().Warn("param error,address unset")
+		return resp, ecom.ErrParameter
+	}
+
+	// 指定地址发送消息时，网络层会先与该地址建立连接
+	net := t.engine.Context().Net
+	peerInfo := net.PeerInfo()
+	msg := p2p.NewMessage(protos.XuperMessage_GET_PEER_INFO, &peerInfo,
+		p2p.WithLogId(rctx.GetLog().GetLogId()))
+	_, err := net.SendMessageWithResponse(rctx, msg, p2p.WithAddresses([]string{req.GetAddress()}))
+
+	connected := false
+	host := acom.MultiAddrToHost(req.GetAddress())
+	peerInfo = net.PeerInfo()
+	for _, remote := range peerInfo.GetPeer() {
+		resp.Peers = append(resp.Peers, remote.GetAddress())
+		if remote.GetAddress() == req.GetAddress() || (host != "" && remote.GetAddress() == host) {
+			connected = true
+		}
+	}
+
+	rctx.GetLog().SetInfoField("address", req.GetAddress())
+	rctx.GetLog().SetInfoField("connected", connected)
+	if err != nil && !connected {
+		rctx.GetLog().Warn("connect peer failed", "address", req.GetAddress(), "err", err)
+		return resp, ecom.ErrNetworkNoResponse
+	}
+	return resp, nil
+}
+
+// RemovePeer 内核网络层没有断开连接的接口，AddPeer建立的连接也没有持久化，
+// 这里明确返回Unimplemented，移除节点需要修改network.yaml的bootNodes后重启
+func (t *AdminServ) RemovePeer(gctx context.Context, req *pb.PeerReq) (*pb.PeerResp, error) {
+	rctx := sctx.ValueReqCtx(gctx)
+	rctx.GetLog().SetInfoField("address", req.GetAddress())
+	return &pb.PeerResp{}, status.Error(codes.Unimplemented,
+		"remove peer is not supported, remove it from bootNodes of network.yaml and restart")
+}
+
+// ListChains 查询数据目录下全部链及其状态
+func (t *AdminServ) ListChains(gctx context.Context, req *pb.BaseReq) (*pb.ListChainsResp, error) {
+	resp := &pb.ListChainsResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	states, err := t.chainMG.ListChains()
+	if err != nil {
+		rctx.GetLog().Warn("list chains failed", "err", err)
+		return resp, err
+	}
+	for _, st := range states {
+		resp.Chains = append(resp.Chains, chainStateToPb(st))
+	}
+
+	rctx.GetLog().SetInfoField("chain_cnt", len(resp.Chains))
+	return resp, nil
+}
+
+// CreateChain 根据创世块配置创建平行链
+func (t *AdminServ) CreateChain(gctx context.Context, req *pb.CreateChainReq) (*pb.ChainResp, error) {
+	resp := &pb.ChainResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req.GetName() == "" || len(req.GetGenesis()) == 0 {
+		rctx.GetLog().Warn("param error,name or genesis unset")
+		return resp, ecom.ErrParameter
+	}
+
+	err := t.chainMG.CreateChain(req.GetName(), req.GetGenesis(), req.GetLoad())
+	rctx.GetLog().SetInfoField("bc_name", req.GetName())
+	rctx.GetLog().SetInfoField("load", req.GetLoad())
+	if err != nil {
+		rctx.GetLog().Warn("create chain failed", "bc_name", req.GetName(), "err", err)
+		return resp, err
+	}
+
+	resp.Chain = t.chainInfo(req.GetName())
+	return resp, nil
+}
+
+// LoadChain 加载数据目录下未加载的链
+func (t *AdminServ) LoadChain(gctx context.Context, req *pb.ChainReq) (*pb.ChainResp, error) {
+	resp := &pb.ChainResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req.GetName() == "" {
+		rctx.GetLog().Warn("param error,name unset")
+		return resp, ecom.ErrParameter
+	}
+
+	err := t.chainMG.LoadChain(req.GetName())
+	rctx.GetLog().SetInfoField("bc_name", req.GetName())
+	if err != nil {
+		rctx.GetLog().Warn("load chain failed", "bc_name", req.GetName(), "err", err)
+		return resp, err
+	}
+
+	resp.Chain = t.chainInfo(req.GetName())
+	return resp, nil
+}
+
+// DisableChain 停用链，停止矿工并对各服务屏蔽，重启节点后才能再次加载
+func (t *AdminServ) DisableChain(gctx context.Context, req *pb.ChainReq) (*pb.ChainResp, error) {
+	resp := &pb.ChainResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req.GetName() == "" {
+		rctx.GetLog().Warn("param error,name unset")
+		return resp, ecom.ErrParameter
+	}
+
+	err := t.chainMG.DisableChain(req.GetName())
+	rctx.GetLog().SetInfoField("bc_name", req.GetName())
+	if err != nil {
+		rctx.GetLog().Warn("disable chain failed", "bc_name", req.GetName(), "err", err)
+		return resp, err
+	}
+
+	resp.Chain = t.chainInfo(req.GetName())
+	return resp, nil
+}
+
+func (t *AdminServ) chainInfo(name string) *pb.ChainInfo {
+	states, _ := t.chainMG.ListChains()
+	for _, st := range states {
+		if st.Name == name {
+			return chainStateToPb(st)
+		}
+	}
+	return &pb.ChainInfo{Name: name}
+}
+
+func chainStateToPb(st *scom.ChainState) *pb.ChainInfo {
+	info := &pb.ChainInfo{
+		Name:   st.Name,
+		Status: pb.ChainStatus_CHAIN_UNLOADED,
+		Root:   st.Root,
+		Height: st.Height,
+	}
+	switch st.Status {
+	case scom.ChainLoaded:
+		info.Status = pb.ChainStatus_CHAIN_LOADED
+	case scom.ChainDisabled:
+		info.Status = pb.ChainStatus_CHAIN_DISABLED
+	}
+	return info
+}
+
+// AddWebhook 添加webhook订阅
+func (t *AdminServ) AddWebhook(gctx context.Context, req *pb.WebhookReq) (*pb.BaseResp, error) {
+	resp := &pb.BaseResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if t.webhook == nil {
+		rctx.GetLog().Warn("webhook service disabled")
+		return resp, ecom.ErrForbidden
+	}
+	if req.GetWebhook() == nil {
+		rctx.GetLog().Warn("param error,webhook unset")
+		return resp, ecom.ErrParameter
+	}
+
+	conf := webhookFromPb(req.GetWebhook())
+	err := t.webhook.Add(conf)
+	rctx.GetLog().SetInfoField("webhook", conf.Name)
+	if err != nil {
+		rctx.GetLog().Warn("add webhook failed", "webhook", conf.Name, "err", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// RemoveWebhook 删除admin接口添加的webhook订阅
+func (t *AdminServ) RemoveWebhook(gctx context.Context, req *pb.WebhookNameReq) (*pb.BaseResp, error) {
+	resp := &pb.BaseResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if t.webhook == nil {
+		rctx.GetLog().Warn("webhook service disabled")
+		return resp, ecom.ErrForbidden
+	}
+
+	err := t.webhook.Remove(req.GetName())
+	rctx.GetLog().SetInfoField("webhook", req.GetName())
+	if err != nil {
+		rctx.GetLog().Warn("remove webhook failed", "webhook", req.GetName(), "err", err)
+		return resp, err
+	}
+	return resp, nil
+}
+
+// ListWebhooks 查询webhook订阅及推送状态
+func (t *AdminServ) ListWebhooks(gctx context.Context, req *pb.BaseReq) (*pb.ListWebhooksResp, error) {
+	resp := &pb.ListWebhooksResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if t.webhook == nil {
+		rctx.GetLog().Warn("webhook service disabled")
+		return resp, ecom.ErrForbidden
+	}
+
+	for _, st := range t.webhook.List() {
+		hook := webhookToPb(st.Conf)
+		// 不返回签名密钥
+		hook.Secret = ""
+		cursorHeight := int64(-1)
+		if st.Cursor != nil {
+			cursorHeight = st.Cursor.GetBlockHeight()
+		}
+		resp.Webhooks = append(resp.Webhooks, &pb.WebhookStatus{
+			Webhook:      hook,
+			Source:       st.Source,
+			CursorHeight: cursorHeight,
+			Delivered:    st.Delivered,
+			Retries:      st.Retries,
+			LastError:    st.LastError,
+		})
+	}
+	return resp, nil
+}
+
+func webhookFromPb(hook *pb.Webhook) *sconf.WebhookConf {
+	filter := hook.GetFilter()
+	return &sconf.WebhookConf{
+		Name:   hook.GetName(),
+		Url:    hook.GetUrl(),
+		Secret: hook.GetSecret(),
+		Filter: &sconf.EventFilter{
+			Bcname:      filter.GetBcname(),
+			Start:       filter.GetStart(),
+			Contract:    filter.GetContract(),
+			EventName:   filter.GetEventName(),
+			Initiator:   filter.GetInitiator(),
+			AuthRequire: filter.GetAuthRequire(),
+			FromAddr:    filter.GetFromAddr(),
+			ToAddr:      filter.GetToAddr(),
+		},
+	}
+}
+
+func webhookToPb(conf *sconf.WebhookConf) *pb.Webhook {
+	hook := &pb.Webhook{
+		Name:   conf.Name,
+		Url:    conf.Url,
+		Secret: conf.Secret,
+	}
+	if filter := conf.Filter; filter != nil {
+		hook.Filter = &pb.WebhookFilter{
+			Bcname:      filter.Bcname,
+			Start:       filter.Start,
+			Contract:    filter.Contract,
+			EventName:   filter.EventName,
+			Initiator:   filter.Initiator,
+			AuthRequire: filter.AuthRequire,
+			FromAddr:    filter.FromAddr,
+			ToAddr:      filter.ToAddr,
+		}
+	}
+	return hook
+}
+
+// Drain 停止接收交易并等待处理中的请求完成
+func (t *AdminServ) Drain(gctx context.Context, req *pb.DrainReq) (*pb.DrainResp, error) {
+	resp := &pb.DrainResp{}
+	rctx := sctx.ValueReqCtx(gctx)
+
+	if req.GetResume() {
+		t.drainer.Resume()
+	} else {
+		timeout := req.GetTimeout()
+		if timeout <= 0 {
+			timeout = defDrainTimeout
+		}
+		ctx, cancel := context.WithTimeout(gctx, time.Duration(timeout)*time.Second)
+		defer cancel()
+		t.drainer.Drain(ctx)
+	}
+	resp.Draining = t.drainer.IsDraining()
+	resp.Inflight = t.drainer.Inflight()
+
+	rctx.GetLog().SetInfoField("draining", resp.Draining)
+	rctx.GetLog().SetInfoField("inflight", resp.Inflight)
+	return resp, nil
+}
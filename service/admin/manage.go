@@ -0,0 +1,108 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+
+	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+	scom "github.com/xuperchain/xuperos/service/common"
+	"github.com/xuperchain/xuperos/service/webhook"
+)
+
+const (
+	SubModName = "admin"
+)
+
+// admin server启停控制管理
+type AdminServMG struct {
+	scfg      *sconf.ServConf
+	log       logs.Logger
+	adminServ *AdminServ
+	servHD    *grpc.Server
+	isInit    bool
+	exitOnce  *sync.Once
+}
+
+// webhookServ未启用webhook时为nil
+func NewAdminServMG(scfg *sconf.ServConf, chainMG *scom.ChainManager, drainer *scom.Drainer,
+	webhookServ *webhook.WebhookServ) (*AdminServMG, error) {
+	if scfg == nil || chainMG == nil || drainer == nil {
+		return nil, fmt.Errorf("param error")
+	}
+	// 运维接口必须鉴权
+	if scfg.AdminToken == "" {
+		return nil, fmt.Errorf("admin token unset")
+	}
+
+	log, _ := loglevel.NewLogger("", SubModName)
+	obj := &AdminServMG{
+		scfg:      scfg,
+		log:       log,
+		adminServ: NewAdminServ(scfg, chainMG, drainer, webhookServ, log),
+		isInit:    true,
+		exitOnce:  &sync.Once{},
+	}
+
+	return obj, nil
+}
+
+// 启动admin服务，阻塞直到退出
+func (t *AdminServMG) Run() error {
+	if !t.isInit {
+		return errors.New("AdminServMG not init")
+	}
+
+	err := t.runAdminServ()
+	if err != nil {
+		t.log.Error("admin server abnormal exit", "err", err)
+		return err
+	}
+
+	t.log.Trace("admin server exit")
+	return nil
+}
+
+// 退出admin服务，需要幂等
+func (t *AdminServMG) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		if t.servHD != nil {
+			t.servHD.GracefulStop()
+		}
+	})
+}
+
+func (t *AdminServMG) runAdminServ() error {
+	rpcOptions := []grpc.ServerOption{
+		middleware.WithUnaryServerChain(t.adminServ.UnaryInterceptor()),
+		grpc.MaxRecvMsgSize(t.scfg.MaxMsgSize),
+		grpc.MaxSendMsgSize(t.scfg.MaxMsgSize),
+	}
+	t.servHD = grpc.NewServer(rpcOptions...)
+	pb.RegisterAdminServer(t.servHD, t.adminServ)
+
+	// 默认只监听本机地址
+	lis, err := net.Listen("tcp", fmt.Sprintf("%s:%d", t.scfg.AdminHost, t.scfg.AdminPort))
+	if err != nil {
+		t.log.Error("failed to listen", "err", err)
+		return fmt.Errorf("failed to listen")
+	}
+
+	if err := t.servHD.Serve(lis); err != nil {
+		t.log.Error("failed to serve", "err", err)
+		return err
+	}
+	return nil
+}
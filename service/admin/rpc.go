@@ -0,0 +1,158 @@
+package admin
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+	pb "github.com/xuperchain/xuperos/common/xupospb"
+	scom "github.com/xuperchain/xuperos/service/common"
+	"github.com/xuperchain/xuperos/service/webhook"
+)
+
+const (
+	// 鉴权信息，格式：Bearer <adminToken>
+	authMetadataKey = "authorization"
+	authScheme      = "Bearer "
+)
+
+type AdminServ struct {
+	scfg    *sconf.ServConf
+	engine  ecom.Engine
+	chainMG *scom.ChainManager
+	drainer *scom.Drainer
+	webhook *webhook.WebhookServ
+	log     logs.Logger
+}
+
+func NewAdminServ(scfg *sconf.ServConf, chainMG *scom.ChainManager, drainer *scom.Drainer,
+	webhookServ *webhook.WebhookServ, log logs.Logger) *AdminServ {
+
+	return &AdminServ{
+		scfg:    scfg,
+		engine:  chainMG,
+		chainMG: chainMG,
+		drainer: drainer,
+		webhook: webhookServ,
+		log:     log,
+	}
+}
+
+// UnaryInterceptor 鉴权、设置请求上下文和统一响应header
+func (t *AdminServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (resp interface{}, err error) {
+
+		// panic recover
+		defer func() {
+			if e := recover(); e != nil {
+				t.log.Error("Admin server happen panic.", "error", e, "rpc_method", info.FullMethod)
+			}
+		}()
+
+		// set request header
+		type HeaderInterface interface {
+			GetHeader() *pb.ReqHeader
+		}
+		if req.(HeaderInterface).GetHeader() == nil {
+			header := reflect.ValueOf(req).Elem().FieldByName("Header")
+			if header.IsValid() && header.IsNil() && header.CanSet() {
+				header.Set(reflect.ValueOf(&pb.ReqHeader{SelfName: "unknow"}))
+			}
+		}
+		if req.(HeaderInterface).GetHeader().GetLogId() == "" {
+			req.(HeaderInterface).GetHeader().LogId = utils.GenLogId()
+		}
+		reqHeader := req.(HeaderInterface).GetHeader()
+
+		// set request context
+		clientIp := t.getClientIP(ctx)
+		reqCtx, err := sctx.NewReqCtx(t.engine, reqHeader.GetLogId(), clientIp)
+		if err != nil {
+			t.log.Error("create request context failed", "error", err)
+			return nil, fmt.Errorf("create request context failed")
+		}
+		ctx = sctx.WithReqCtx(ctx, reqCtx)
+
+		logFields := make([]interface{}, 0)
+		logFields = append(logFields, "from", reqHeader.GetSelfName(),
+			"client_ip", clientIp, "rpc_method", info.FullMethod)
+
+		// 鉴权失败直接拒绝，不执行handler
+		if !t.authorized(ctx) {
+			reqCtx.GetLog().Warn("admin request unauthorized", logFields...)
+			return nil, status.Error(codes.Unauthenticated, ecom.ErrUnauthorized.Msg)
+		}
+
+		stdErr := ecom.ErrSuccess
+		resp, err = handler(ctx, req)
+		// grpc错误直接返回，如未实现的接口
+		if _, ok := status.FromError(err); ok && err != nil {
+			reqCtx.GetLog().Warn("request failed", append(logFields, "err", err)...)
+			return nil, err
+		}
+		if err != nil {
+			stdErr = ecom.CastError(err)
+		}
+
+		respHeader := &pb.RespHeader{
+			LogId:   reqHeader.GetLogId(),
+			ErrCode: int64(stdErr.Code),
+			ErrMsg:  stdErr.Msg,
+			TraceId: utils.GetHostName(),
+		}
+		header := reflect.ValueOf(resp).Elem().FieldByName("Header")
+		if header.IsValid() && header.IsNil() && header.CanSet() {
+			header.Set(reflect.ValueOf(respHeader))
+		}
+
+		logFields = append(logFields, "status", stdErr.Status, "err_code", stdErr.Code,
+			"err_msg", stdErr.Msg, "cost_time", reqCtx.GetTimer().Print())
+		reqCtx.GetLog().Info("request done", logFields...)
+
+		return resp, nil
+	}
+}
+
+// 校验请求携带的admin token
+func (t *AdminServ) authorized(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	for _, auth := range md.Get(authMetadataKey) {
+		if !strings.HasPrefix(auth, authScheme) {
+			continue
+		}
+		token := strings.TrimPrefix(auth, authScheme)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(t.scfg.AdminToken)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *AdminServ) getClientIP(gctx context.Context) string {
+	pr, ok := peer.FromContext(gctx)
+	if !ok || pr.Addr == nil || pr.Addr == net.Addr(nil) {
+		return ""
+	}
+
+	addrSlice := strings.Split(pr.Addr.String(), ":")
+	return addrSlice[0]
+}
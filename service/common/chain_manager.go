@@ -0,0 +1,333 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/ledger"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/state/context"
+	"github.com/xuperchain/xupercore/bcs/ledger/xledger/tx"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/crypto/client"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+)
+
+// 链名只允许字母、数字和下划线
+var chainNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]{1,32}$`)
+
+// 链状态
+const (
+	ChainUnloaded = iota
+	ChainLoaded
+	ChainDisabled
+)
+
+// ChainState 链的运行状态
+type ChainState struct {
+	Name   string
+	Status int
+	Root   bool
+	// 已加载的链才有高度
+	Height int64
+}
+
+// 引擎动态加载链的能力，xuperos引擎实现了该接口
+type chainRegister interface {
+	RegisterBlockChain(name string) error
+}
+
+// ChainManager 运行时平行链生命周期管理
+// 包装引擎，对各服务屏蔽已停用的链。引擎没有提供移除链的接口，不能真正卸载链：
+// 已停用的链仍留在引擎内部，存储保持打开，p2p消息处理仍会访问它，停用只停止矿工。
+// 矿工停止后不能重新启动，已停用的链需要重启节点才能再次加载
+type ChainManager struct {
+	ecom.Engine
+
+	log      logs.Logger
+	mutex    sync.Mutex
+	disabled sync.Map
+}
+
+func NewChainManager(engine ecom.Engine, log logs.Logger) *ChainManager {
+	return &ChainManager{
+		Engine: engine,
+		log:    log,
+	}
+}
+
+// Get 获取已加载的链
+func (t *ChainManager) Get(name string) (ecom.Chain, error) {
+	if t.isDisabled(name) {
+		return nil, ecom.ErrChainNotExist
+	}
+	return t.Engine.Get(name)
+}
+
+// GetChains 获取已加载的链列表
+func (t *ChainManager) GetChains() []string {
+	chains := make([]string, 0)
+	for _, name := range t.Engine.GetChains() {
+		if !t.isDisabled(name) {
+			chains = append(chains, name)
+		}
+	}
+	return chains
+}
+
+// ListChains 列出数据目录下全部链及其状态
+func (t *ChainManager) ListChains() ([]*ChainState, error) {
+	names := make(map[string]bool)
+	for _, name := range t.Engine.GetChains() {
+		names[name] = true
+	}
+
+	dir, err := ioutil.ReadDir(t.chainDir())
+	if err != nil {
+		t.log.Warn("read blockchain data dir failed", "dir", t.chainDir(), "err", err)
+		return nil, ecom.ErrInternal
+	}
+	for _, fInfo := range dir {
+		if fInfo.IsDir() {
+			names[fInfo.Name()] = true
+		}
+	}
+
+	rootChain := t.Context().EngCfg.RootChain
+	states := make([]*ChainState, 0, len(names))
+	for name := range names {
+		st := &ChainState{
+			Name:   name,
+			Status: ChainUnloaded,
+			Root:   name == rootChain,
+		}
+		if t.isDisabled(name) {
+			st.Status = ChainDisabled
+		} else if chain, err := t.Get(name); err == nil {
+			st.Status = ChainLoaded
+			st.Height = chain.Context().Ledger.GetMeta().GetTrunkHeight()
+		}
+		states = append(states, st)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Name < states[j].Name
+	})
+
+	return states, nil
+}
+
+// CreateChain 根据创世块配置创建链，创建前完成全部校验，避免写入残缺数据
+func (t *ChainManager) CreateChain(name string, genesis []byte, load bool) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !chainNameRegexp.MatchString(name) {
+		return ecom.ErrParameter.More("invalid chain name:%s", name)
+	}
+	if err := ValidateGenesis(genesis); err != nil {
+		return ecom.ErrParameter.More("%v", err)
+	}
+	if _, err := t.Engine.Get(name); err == nil {
+		return ecom.ErrChainExist
+	}
+	if utils.PathExists(filepath.Join(t.chainDir(), name)) {
+		return ecom.ErrChainExist
+	}
+
+	if err := t.createLedger(name, genesis); err != nil {
+		t.log.Warn("create ledger failed", "chain", name, "err", err)
+		return ecom.ErrInternal.More("%v", err)
+	}
+	t.log.Info("create chain succ", "chain", name)
+
+	if !load {
+		return nil
+	}
+	return t.loadChain(name)
+}
+
+// LoadChain 加载数据目录下未加载的链
+func (t *ChainManager) LoadChain(name string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if !chainNameRegexp.MatchString(name) {
+		return ecom.ErrParameter.More("invalid chain name:%s", name)
+	}
+	return t.loadChain(name)
+}
+
+func (t *ChainManager) loadChain(name string) error {
+	if t.isDisabled(name) {
+		return ecom.ErrForbidden.More("chain %s was disabled, restart the node to load it again", name)
+	}
+	if _, err := t.Get(name); err == nil {
+		return ecom.ErrChainExist
+	}
+	if !utils.PathExists(filepath.Join(t.chainDir(), name)) {
+		return ecom.ErrChainNotExist
+	}
+
+	register, ok := t.Engine.(chainRegister)
+	if !ok {
+		return ecom.ErrForbidden.More("engine not support load chain")
+	}
+	if err := register.RegisterBlockChain(name); err != nil {
+		t.log.Warn("load chain failed", "chain", name, "err", err)
+		return ecom.ErrInternal.More("%v", err)
+	}
+
+	t.log.Info("load chain succ", "chain", name)
+	return nil
+}
+
+// DisableChain 停止链的矿工并对各服务屏蔽，存储保持打开供p2p消息处理使用，
+// 引擎退出时统一关闭，重启节点后可以重新加载
+func (t *ChainManager) DisableChain(name string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if name == t.Context().EngCfg.RootChain {
+		return ecom.ErrForbidden.More("root chain can not be disabled")
+	}
+	chain, err := t.Get(name)
+	if err != nil {
+		return ecom.ErrChainNotExist
+	}
+
+	// 先对外屏蔽，再停止矿工
+	t.disabled.Store(name, true)
+	chain.Stop()
+
+	t.log.Info("disable chain succ", "chain", name)
+	return nil
+}
+
+func (t *ChainManager) isDisabled(name string) bool {
+	_, ok := t.disabled.Load(name)
+	return ok
+}
+
+func (t *ChainManager) chainDir() string {
+	envCfg := t.Context().EnvCfg
+	return envCfg.GenDataAbsPath(envCfg.ChainDir)
+}
+
+// 创建账本和状态机，写入创世块，失败时清理链目录
+func (t *ChainManager) createLedger(name string, genesis []byte) (err error) {
+	envCfg := t.Context().EnvCfg
+	fullpath := filepath.Join(t.chainDir(), name)
+	if err = os.MkdirAll(fullpath, 0755); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(fullpath)
+		}
+	}()
+
+	rootfile := filepath.Join(fullpath, fmt.Sprintf("%s.json", name))
+	if err = ioutil.WriteFile(rootfile, genesis, 0666); err != nil {
+		return err
+	}
+
+	lctx, err := ledger.NewLedgerCtx(envCfg, name)
+	if err != nil {
+		return err
+	}
+	xledger, err := ledger.CreateLedger(lctx, genesis)
+	if err != nil {
+		return err
+	}
+	defer xledger.Close()
+
+	rootTx, err := tx.GenerateRootTx(genesis)
+	if err != nil {
+		return err
+	}
+	block, err := xledger.FormatRootBlock([]*lpb.Transaction{rootTx})
+	if err != nil {
+		return err
+	}
+	if status := xledger.ConfirmBlock(block, true); !status.Succ {
+		return fmt.Errorf("confirm root block failed")
+	}
+
+	rootCfg := &ledger.RootConfig{}
+	json.Unmarshal(genesis, rootCfg)
+	crypt, err := client.CreateCryptoClient(rootCfg.GetCryptoType())
+	if err != nil {
+		return err
+	}
+	sctx, err := context.NewStateCtx(envCfg, name, xledger, crypt)
+	if err != nil {
+		return err
+	}
+	handleState, err := state.NewState(sctx)
+	if err != nil {
+		return err
+	}
+	defer handleState.Close()
+
+	return handleState.Play(block.Blockid)
+}
+
+// ValidateGenesis 校验创世块配置，不写入任何数据
+func ValidateGenesis(genesis []byte) error {
+	if len(genesis) == 0 {
+		return fmt.Errorf("genesis config is empty")
+	}
+
+	rootCfg := &ledger.RootConfig{}
+	if err := json.Unmarshal(genesis, rootCfg); err != nil {
+		return fmt.Errorf("unmarshal genesis config failed.err:%v", err)
+	}
+	if rootCfg.Version == "" {
+		return fmt.Errorf("genesis version unset")
+	}
+	if _, err := client.CreateCryptoClient(rootCfg.GetCryptoType()); err != nil {
+		return fmt.Errorf("unsupported crypto type:%s", rootCfg.GetCryptoType())
+	}
+	if size, err := strconv.ParseInt(rootCfg.MaxBlockSize, 10, 64); err != nil || size <= 0 {
+		return fmt.Errorf("invalid maxblocksize:%s", rootCfg.MaxBlockSize)
+	}
+	if rootCfg.Award != "" {
+		if _, ok := big.NewInt(0).SetString(rootCfg.Award, 10); !ok {
+			return fmt.Errorf("invalid award:%s", rootCfg.Award)
+		}
+	}
+	for _, pd := range rootCfg.Predistribution {
+		if pd.Address == "" {
+			return fmt.Errorf("predistribution address unset")
+		}
+		quota, ok := big.NewInt(0).SetString(pd.Quota, 10)
+		if !ok || quota.Sign() < 0 {
+			return fmt.Errorf("invalid predistribution quota:%s", pd.Quota)
+		}
+	}
+
+	consCfg, err := rootCfg.GetGenesisConsensus()
+	if err != nil {
+		return fmt.Errorf("invalid genesis consensus.err:%v", err)
+	}
+	if consName, _ := consCfg["name"].(string); consName == "" {
+		return fmt.Errorf("consensus type unset")
+	}
+
+	// 生成创世交易，校验预分配等配置
+	if _, err := tx.GenerateRootTx(genesis); err != nil {
+		return fmt.Errorf("generate root tx failed.err:%v", err)
+	}
+
+	return nil
+}
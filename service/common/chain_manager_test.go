@@ -0,0 +1,198 @@
+package common
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	xconf "github.com/xuperchain/xupercore/kernel/common/xconfig"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	engconf "github.com/xuperchain/xupercore/kernel/engines/xuperos/config"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	raw, err := ioutil.ReadFile("../../data/genesis/xuper.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 基于默认创世块修改一个字段
+	modify := func(f func(cfg map[string]interface{})) []byte {
+		cfg := make(map[string]interface{})
+		if err := json.Unmarshal(raw, &cfg); err != nil {
+			t.Fatal(err)
+		}
+		f(cfg)
+		buf, _ := json.Marshal(cfg)
+		return buf
+	}
+	consensus := func(name string) func(cfg map[string]interface{}) {
+		return func(cfg map[string]interface{}) {
+			cfg["genesis_consensus"].(map[string]interface{})["name"] = name
+		}
+	}
+
+	cases := []struct {
+		name    string
+		genesis []byte
+		ok      bool
+	}{
+		{name: "default", genesis: raw, ok: true},
+		{name: "empty"},
+		{name: "not json", genesis: []byte("{")},
+		{name: "no version", genesis: modify(func(cfg map[string]interface{}) { delete(cfg, "version") })},
+		{name: "bad crypto", genesis: modify(func(cfg map[string]interface{}) { cfg["crypto"] = "rsa" })},
+		{name: "bad block size", genesis: modify(func(cfg map[string]interface{}) { cfg["maxblocksize"] = "0" })},
+		{name: "bad award", genesis: modify(func(cfg map[string]interface{}) { cfg["award"] = "1.5" })},
+		{name: "no predistribution", ok: true,
+			genesis: modify(func(cfg map[string]interface{}) { delete(cfg, "predistribution") })},
+		{name: "negative quota", genesis: modify(func(cfg map[string]interface{}) {
+			cfg["predistribution"] = []interface{}{map[string]interface{}{"address": "alice", "quota": "-1"}}
+		})},
+		{name: "no predistribution address", genesis: modify(func(cfg map[string]interface{}) {
+			cfg["predistribution"] = []interface{}{map[string]interface{}{"quota": "1"}}
+		})},
+		{name: "no consensus", genesis: modify(consensus(""))},
+		{name: "no genesis consensus", genesis: modify(func(cfg map[string]interface{}) {
+			delete(cfg, "genesis_consensus")
+		})},
+	}
+	for _, c := range cases {
+		err := ValidateGenesis(c.genesis)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: expect ok %v, got %v", c.name, c.ok, err)
+		}
+	}
+}
+
+// 测试引擎，加载的链只记录是否停止
+type testEngine struct {
+	ecom.Engine
+	ctx    *ecom.EngineCtx
+	chains map[string]*testChain
+}
+
+type testChain struct {
+	ecom.Chain
+	stopped bool
+}
+
+func (t *testChain) Stop() {
+	t.stopped = true
+}
+
+func (t *testEngine) Context() *ecom.EngineCtx {
+	return t.ctx
+}
+
+func (t *testEngine) Get(name string) (ecom.Chain, error) {
+	if chain, ok := t.chains[name]; ok {
+		return chain, nil
+	}
+	return nil, ecom.ErrChainNotExist
+}
+
+func (t *testEngine) GetChains() []string {
+	names := make([]string, 0, len(t.chains))
+	for name := range t.chains {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (t *testEngine) RegisterBlockChain(name string) error {
+	t.chains[name] = &testChain{}
+	return nil
+}
+
+func isError(err error, target *ecom.Error) bool {
+	e, ok := err.(*ecom.Error)
+	return ok && e.Equal(target)
+}
+
+func TestChainManagerDisable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "chains")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	envCfg := &xconf.EnvConf{RootPath: dir, DataDir: "data", ChainDir: "blockchain"}
+	for _, name := range []string{"xuper", "hello", "world"} {
+		if err := os.MkdirAll(filepath.Join(envCfg.GenDataAbsPath(envCfg.ChainDir), name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 根链不放入引擎，列出链时不需要读取账本高度
+	hello := &testChain{}
+	engine := &testEngine{
+		ctx: &ecom.EngineCtx{
+			EnvCfg: envCfg,
+			EngCfg: &engconf.EngineConf{RootChain: "xuper"},
+		},
+		chains: map[string]*testChain{"hello": hello},
+	}
+	chainMG := NewChainManager(engine, nopLogger{})
+
+	if err := chainMG.DisableChain("xuper"); !isError(err, ecom.ErrForbidden) {
+		t.Errorf("disable root chain expect forbidden, got %v", err)
+	}
+	if err := chainMG.DisableChain("world"); !isError(err, ecom.ErrChainNotExist) {
+		t.Errorf("disable unloaded chain expect not exist, got %v", err)
+	}
+	if err := chainMG.DisableChain("hello"); err != nil || !hello.stopped {
+		t.Fatalf("disable chain failed, err %v stopped %v", err, hello.stopped)
+	}
+
+	// 停用的链对各服务屏蔽，但仍留在引擎中
+	if _, err := chainMG.Get("hello"); err == nil {
+		t.Error("disabled chain should be hidden")
+	}
+	if len(chainMG.GetChains()) != 0 {
+		t.Errorf("disabled chain should not be listed, got %v", chainMG.GetChains())
+	}
+	if _, err := engine.Get("hello"); err != nil {
+		t.Error("disabled chain should stay in the engine")
+	}
+	states, err := chainMG.ListChains()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]int{"hello": ChainDisabled, "world": ChainUnloaded, "xuper": ChainUnloaded}
+	if len(states) != len(expect) {
+		t.Fatalf("unexpected states %v", states)
+	}
+	for _, st := range states {
+		if st.Status != expect[st.Name] || st.Root != (st.Name == "xuper") {
+			t.Errorf("%s: unexpected state %+v", st.Name, st)
+		}
+	}
+
+	// 停用的链在本进程内不能再次加载或停用
+	if err := chainMG.LoadChain("hello"); !isError(err, ecom.ErrForbidden) {
+		t.Errorf("load disabled chain expect forbidden, got %v", err)
+	}
+	if err := chainMG.DisableChain("hello"); !isError(err, ecom.ErrChainNotExist) {
+		t.Errorf("disable twice expect not exist, got %v", err)
+	}
+
+	// 未加载的链可以加载
+	if err := chainMG.LoadChain("world"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := chainMG.Get("world"); err != nil {
+		t.Error("loaded chain should be visible")
+	}
+	if err := chainMG.LoadChain("world"); !isError(err, ecom.ErrChainExist) {
+		t.Errorf("load twice expect exist, got %v", err)
+	}
+	if err := chainMG.LoadChain("nodir"); !isError(err, ecom.ErrChainNotExist) {
+		t.Errorf("load chain without data expect not exist, got %v", err)
+	}
+	if err := chainMG.LoadChain("bad-name"); !isError(err, ecom.ErrParameter) {
+		t.Errorf("bad chain name expect parameter error, got %v", err)
+	}
+}
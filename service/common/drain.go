@@ -0,0 +1,65 @@
+package common
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// 排空时检查处理中请求数的间隔
+const drainCheckInterval = 100 * time.Millisecond
+
+// Drainer 节点下线前排空请求：拒绝新提交的交易，等待处理中的请求完成
+// 各rpc服务共享同一实例，由拦截器统计处理中的请求数
+type Drainer struct {
+	// 64位原子操作字段放在首位，保证32位平台对齐
+	inflight int64
+	draining int32
+}
+
+func NewDrainer() *Drainer {
+	return &Drainer{}
+}
+
+// IsDraining 是否处于排空状态
+func (t *Drainer) IsDraining() bool {
+	return atomic.LoadInt32(&t.draining) == 1
+}
+
+// Acquire 请求开始处理
+func (t *Drainer) Acquire() {
+	atomic.AddInt64(&t.inflight, 1)
+}
+
+// Release 请求处理完成
+func (t *Drainer) Release() {
+	atomic.AddInt64(&t.inflight, -1)
+}
+
+// Inflight 处理中的请求数
+func (t *Drainer) Inflight() int64 {
+	return atomic.LoadInt64(&t.inflight)
+}
+
+// Drain 进入排空状态，阻塞到处理中的请求完成或ctx超时，返回剩余的请求数
+func (t *Drainer) Drain(ctx context.Context) int64 {
+	atomic.StoreInt32(&t.draining, 1)
+
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+	for {
+		if n := t.Inflight(); n <= 0 {
+			return n
+		}
+		select {
+		case <-ctx.Done():
+			return t.Inflight()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Resume 退出排空状态，恢复接收交易
+func (t *Drainer) Resume() {
+	atomic.StoreInt32(&t.draining, 0)
+}
@@ -0,0 +1,232 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+// 客户端认证模式
+const (
+	TlsClientAuthNone     = "none"
+	TlsClientAuthOptional = "optional"
+	TlsClientAuthRequire  = "require"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// TlsLoader 按配置加载grpc服务的证书，证书文件变化时在下一次握手时重新加载
+// 服务端和客户端使用同一份证书，节点证书同时作为客户端证书
+type TlsLoader struct {
+	conf       sconf.TlsConf
+	tlsPath    string
+	serverName string
+	log        logs.Logger
+	base       *tls.Config
+
+	mutex   sync.Mutex
+	checkAt time.Time
+	modTime time.Time
+	cert    *tls.Certificate
+	pool    *x509.CertPool
+}
+
+func NewTlsLoader(conf sconf.TlsConf, tlsPath, serverName string, log logs.Logger) (*TlsLoader, error) {
+	t := &TlsLoader{
+		conf:       conf,
+		tlsPath:    tlsPath,
+		serverName: serverName,
+		log:        log,
+	}
+
+	base, err := t.baseConfig()
+	if err != nil {
+		return nil, err
+	}
+	t.base = base
+	if err := t.load(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// ServerConfig 服务端配置，每次握手时检查证书是否需要重新加载
+func (t *TlsLoader) ServerConfig() *tls.Config {
+	config := t.base.Clone()
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		cert, pool := t.current()
+		config := t.base.Clone()
+		config.Certificates = []tls.Certificate{*cert}
+		config.ClientCAs = pool
+		// 替换后的配置不再经过grpc处理，需要自己声明http2
+		config.NextProtos = []string{"h2"}
+		return config, nil
+	}
+	return config
+}
+
+// ClientConfig 客户端配置，根证书在创建时确定，客户端证书随文件更新
+func (t *TlsLoader) ClientConfig() *tls.Config {
+	_, pool := t.current()
+	config := t.base.Clone()
+	config.ServerName = t.serverName
+	config.RootCAs = pool
+	config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		cert, _ := t.current()
+		return cert, nil
+	}
+	return config
+}
+
+func (t *TlsLoader) baseConfig() (*tls.Config, error) {
+	config := &tls.Config{}
+	switch t.conf.ClientAuth {
+	case TlsClientAuthNone:
+		config.ClientAuth = tls.NoClientCert
+	case TlsClientAuthOptional:
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	case TlsClientAuthRequire, "":
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return nil, fmt.Errorf("unknown tls client auth: %s", t.conf.ClientAuth)
+	}
+	if config.ClientAuth != tls.NoClientCert && t.conf.CaFile == "" {
+		return nil, fmt.Errorf("tls caFile is required to verify client certificates")
+	}
+
+	if t.conf.MinVersion != "" {
+		version, ok := tlsVersions[t.conf.MinVersion]
+		if !ok {
+			return nil, fmt.Errorf("unknown tls version: %s", t.conf.MinVersion)
+		}
+		config.MinVersion = version
+	}
+	if t.conf.MaxVersion != "" {
+		version, ok := tlsVersions[t.conf.MaxVersion]
+		if !ok {
+			return nil, fmt.Errorf("unknown tls version: %s", t.conf.MaxVersion)
+		}
+		config.MaxVersion = version
+	}
+
+	if len(t.conf.CipherSuites) > 0 {
+		suites := make(map[string]uint16)
+		for _, suite := range tls.CipherSuites() {
+			suites[suite.Name] = suite.ID
+		}
+		for _, name := range t.conf.CipherSuites {
+			id, ok := suites[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown or insecure tls cipher suite: %s", name)
+			}
+			config.CipherSuites = append(config.CipherSuites, id)
+		}
+	}
+	return config, nil
+}
+
+// 返回当前证书，距上次检查超过reloadInterval时检查文件是否更新
+// 重新加载失败时继续使用旧证书
+func (t *TlsLoader) current() (*tls.Certificate, *x509.CertPool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	interval := time.Duration(t.conf.ReloadInterval) * time.Second
+	if interval > 0 && time.Since(t.checkAt) >= interval {
+		if modTime, err := t.latestModTime(); err == nil && modTime.After(t.modTime) {
+			if err := t.loadLocked(); err != nil {
+				t.log.Warn("reload tls certificate failed", "err", err)
+			} else {
+				t.log.Info("tls certificate reloaded", "path", t.tlsPath)
+			}
+		}
+		t.checkAt = time.Now()
+	}
+	return t.cert, t.pool
+}
+
+func (t *TlsLoader) load() error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.loadLocked()
+}
+
+func (t *TlsLoader) loadLocked() error {
+	// 先取修改时间，加载期间文件再次变化时下次检查会重新加载
+	modTime, err := t.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	var pool *x509.CertPool
+	if caFile := t.caFile(); caFile != "" {
+		bs, err := ioutil.ReadFile(t.file(caFile))
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(bs) {
+			return fmt.Errorf("no certificate found in %s", caFile)
+		}
+	}
+	cert, err := tls.LoadX509KeyPair(t.file(t.conf.CertFile), t.file(t.conf.KeyFile))
+	if err != nil {
+		return err
+	}
+
+	t.cert = &cert
+	t.pool = pool
+	t.modTime = modTime
+	t.checkAt = time.Now()
+	return nil
+}
+
+func (t *TlsLoader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, fname := range []string{t.caFile(), t.conf.CertFile, t.conf.KeyFile} {
+		if fname == "" {
+			continue
+		}
+		info, err := os.Stat(t.file(fname))
+		if err != nil {
+			return latest, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// 根证书文件，不校验客户端证书时可以不提供，此时客户端使用系统根证书
+func (t *TlsLoader) caFile() string {
+	if t.conf.CaFile == "" || t.base.ClientAuth != tls.NoClientCert {
+		return t.conf.CaFile
+	}
+	if _, err := os.Stat(t.file(t.conf.CaFile)); os.IsNotExist(err) {
+		return ""
+	}
+	return t.conf.CaFile
+}
+
+// 证书文件相对tls目录
+func (t *TlsLoader) file(fname string) string {
+	if filepath.IsAbs(fname) {
+		return fname
+	}
+	return filepath.Join(t.tlsPath, fname)
+}
@@ -0,0 +1,147 @@
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) GetLogId() string                           { return "test" }
+func (nopLogger) SetCommField(key string, value interface{}) {}
+func (nopLogger) SetInfoField(key string, value interface{}) {}
+func (nopLogger) Error(msg string, ctx ...interface{})       {}
+func (nopLogger) Warn(msg string, ctx ...interface{})        {}
+func (nopLogger) Info(msg string, ctx ...interface{})        {}
+func (nopLogger) Trace(msg string, ctx ...interface{})       {}
+func (nopLogger) Debug(msg string, ctx ...interface{})       {}
+
+// 在dir下生成自签名的cert.crt和节点证书key.pem、private.key
+func writeTestCerts(t *testing.T, dir string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	for name, data := range map[string][]byte{"cert.crt": certPem, "key.pem": certPem, "private.key": keyPem} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTlsLoaderCaFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestCerts(t, dir)
+
+	cases := []struct {
+		name       string
+		clientAuth string
+		caFile     string
+		ok         bool
+		pool       bool
+	}{
+		{name: "none without ca", clientAuth: TlsClientAuthNone, ok: true},
+		{name: "none with missing ca", clientAuth: TlsClientAuthNone, caFile: "missing.crt", ok: true},
+		{name: "none with ca", clientAuth: TlsClientAuthNone, caFile: "cert.crt", ok: true, pool: true},
+		{name: "require with ca", clientAuth: TlsClientAuthRequire, caFile: "cert.crt", ok: true, pool: true},
+		{name: "require without ca", clientAuth: TlsClientAuthRequire},
+		{name: "default without ca"},
+		{name: "optional without ca", clientAuth: TlsClientAuthOptional},
+		{name: "require with missing ca", clientAuth: TlsClientAuthRequire, caFile: "missing.crt"},
+	}
+	for _, c := range cases {
+		conf := sconf.GetDefServConf().Tls
+		conf.ClientAuth = c.clientAuth
+		conf.CaFile = c.caFile
+		loader, err := NewTlsLoader(conf, dir, "localhost", nopLogger{})
+		if (err == nil) != c.ok {
+			t.Errorf("%s: expect ok %v, got %v", c.name, c.ok, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if _, pool := loader.current(); (pool != nil) != c.pool {
+			t.Errorf("%s: expect ca pool %v", c.name, c.pool)
+		}
+	}
+}
+
+func TestTlsLoaderHandshakeWithoutCa(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeTestCerts(t, dir)
+
+	conf := sconf.GetDefServConf().Tls
+	conf.ClientAuth = TlsClientAuthNone
+	conf.CaFile = ""
+	loader, err := NewTlsLoader(conf, dir, "localhost", nopLogger{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caPem, err := ioutil.ReadFile(filepath.Join(dir, "cert.crt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(caPem)
+
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- tls.Server(serverConn, loader.ServerConfig()).Handshake()
+	}()
+	// 服务端不要求客户端证书
+	client := tls.Client(clientConn, &tls.Config{ServerName: "localhost", RootCAs: roots})
+	if err := client.Handshake(); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,641 @@
+package ethrpc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/xuperchain/xupercore/bcs/contract/evm"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/kernel/network/p2p"
+	"github.com/xuperchain/xupercore/lib/logs"
+	"github.com/xuperchain/xupercore/lib/utils"
+	"github.com/xuperchain/xupercore/protos"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	sctx "github.com/xuperchain/xuperos/common/context"
+	"github.com/xuperchain/xuperos/common/metrics"
+	"github.com/xuperchain/xuperos/models"
+	"github.com/xuperchain/xuperos/service/adapter/event"
+	scom "github.com/xuperchain/xuperos/service/common"
+)
+
+// 区块号标签
+const (
+	blockLatest    = "latest"
+	blockPending   = "pending"
+	blockSafe      = "safe"
+	blockFinalized = "finalized"
+	blockEarliest  = "earliest"
+)
+
+// protobuf编码的交易以txid字段(1号bytes字段)开头
+const txidTag = 0x0a
+
+// 方法实现，params为请求中的原始参数
+type ethMethod func(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error)
+
+// ethApi 以太坊JSON-RPC方法到ChainHandle的映射
+type ethApi struct {
+	conf    sconf.EthRpcConf
+	engine  ecom.Engine
+	drainer *scom.Drainer
+	cache   *models.LedgerCache
+	speeds  *metrics.Speeds
+	// 提交的交易推送给待确认交易订阅
+	pendingTxs *event.PendingTxFeed
+	log        logs.Logger
+	methods    map[string]ethMethod
+}
+
+func newEthApi(conf sconf.EthRpcConf, engine ecom.Engine, drainer *scom.Drainer,
+	cache *models.LedgerCache, speeds *metrics.Speeds, pendingTxs *event.PendingTxFeed,
+	log logs.Logger) *ethApi {
+	t := &ethApi{
+		conf:       conf,
+		engine:     engine,
+		drainer:    drainer,
+		cache:      cache,
+		speeds:     speeds,
+		pendingTxs: pendingTxs,
+		log:        log,
+	}
+	t.methods = map[string]ethMethod{
+		"eth_chainId":               t.chainId,
+		"eth_blockNumber":           t.blockNumber,
+		"eth_getBalance":            t.getBalance,
+		"eth_call":                  t.call,
+		"eth_estimateGas":           t.estimateGas,
+		"eth_getBlockByNumber":      t.getBlockByNumber,
+		"eth_getTransactionReceipt": t.getTransactionReceipt,
+		"eth_getLogs":               t.getLogs,
+		"eth_sendRawTransaction":    t.sendRawTransaction,
+	}
+	return t
+}
+
+func (t *ethApi) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	// 统计处理中的请求，用于排空
+	t.drainer.Acquire()
+	defer t.drainer.Release()
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, "request body too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	clientIp, _, _ := net.SplitHostPort(r.RemoteAddr)
+	var result interface{}
+	if isBatch(body) {
+		var raws []json.RawMessage
+		if err := json.Unmarshal(body, &raws); err != nil {
+			result = newErrorResult(nil, newRpcError(codeParseError, "parse error"))
+		} else if len(raws) == 0 {
+			result = newErrorResult(nil, newRpcError(codeInvalidRequest, "empty batch"))
+		} else {
+			resps := make([]*rpcResponse, 0, len(raws))
+			for _, raw := range raws {
+				if resp := t.handle(raw, clientIp); resp != nil {
+					resps = append(resps, resp)
+				}
+			}
+			if len(resps) > 0 {
+				result = resps
+			}
+		}
+	} else if resp := t.handle(body, clientIp); resp != nil {
+		result = resp
+	}
+
+	// 全部是通知时不返回内容
+	if result == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(result)
+}
+
+// 处理单个请求，通知返回nil
+func (t *ethApi) handle(raw json.RawMessage, clientIp string) *rpcResponse {
+	req := new(rpcRequest)
+	if err := json.Unmarshal(raw, req); err != nil {
+		if _, ok := err.(*json.SyntaxError); ok {
+			return newErrorResult(nil, newRpcError(codeParseError, "parse error"))
+		}
+		return newErrorResult(nil, newRpcError(codeInvalidRequest, "invalid request"))
+	}
+	if rerr := req.validate(); rerr != nil {
+		return newErrorResult(req.Id, rerr)
+	}
+
+	result, rerr := t.invoke(req, clientIp)
+	if req.isNotification() {
+		return nil
+	}
+	if rerr != nil {
+		return newErrorResult(req.Id, rerr)
+	}
+	return newResult(req.Id, result)
+}
+
+func (t *ethApi) invoke(req *rpcRequest, clientIp string) (result interface{}, rerr *rpcError) {
+	method, ok := t.methods[req.Method]
+	if !ok {
+		return nil, newRpcError(codeMethodNotFound, "the method %s does not exist/is not available", req.Method)
+	}
+
+	rctx, err := sctx.NewReqCtx(t.engine, utils.GenLogId(), clientIp)
+	if err != nil {
+		t.log.Error("create request context failed", "err", err)
+		return nil, newRpcError(codeInternalError, "create request context failed")
+	}
+	logFields := []interface{}{"client_ip", clientIp, "rpc_method", req.Method}
+	rctx.GetLog().Trace("access request", logFields...)
+	defer func() {
+		if e := recover(); e != nil {
+			t.log.Error("eth rpc server happen panic.", "error", e, "rpc_method", req.Method)
+			rerr = newRpcError(codeInternalError, "internal error")
+		}
+		errCode, errMsg := 0, ""
+		if rerr != nil {
+			errCode, errMsg = rerr.Code, rerr.Message
+		}
+		logFields = append(logFields, "err_code", errCode, "err_msg", errMsg,
+			"cost_time", rctx.GetTimer().Print())
+		rctx.GetLog().Info("request done", logFields...)
+	}()
+
+	handle, err := models.NewChainHandle(t.conf.Bcname, rctx)
+	if err != nil {
+		rctx.GetLog().Warn("new chain handle failed", "err", err.Error())
+		return nil, toRpcError(err)
+	}
+	result, err = method(rctx, handle, req.Params)
+	if err != nil {
+		return nil, toRpcError(err)
+	}
+	return result, nil
+}
+
+func (t *ethApi) chainId(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	return encodeUint(uint64(t.conf.ChainId)), nil
+}
+
+func (t *ethApi) blockNumber(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	height, err := tipHeight(handle)
+	if err != nil {
+		return nil, err
+	}
+	return encodeUint(uint64(height)), nil
+}
+
+// 只能查询最新状态的余额
+func (t *ethApi) getBalance(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	var addr, block string
+	if err := parseParams(params, 1, &addr, &block); err != nil {
+		return nil, err
+	}
+	if err := requireLatest(block); err != nil {
+		return nil, err
+	}
+	account, err := toXchainAddress(addr)
+	if err != nil {
+		return nil, err
+	}
+	rctx.GetLog().SetInfoField("account", account)
+
+	balance, err := handle.GetBalance(account)
+	if err != nil {
+		rctx.GetLog().Warn("get balance failed", "account", account, "err", err)
+		return nil, err
+	}
+	amount, ok := new(big.Int).SetString(balance, 10)
+	if !ok {
+		return nil, ecom.ErrInternal
+	}
+	return encodeBig(amount), nil
+}
+
+// callArgs eth_call和eth_estimateGas的调用参数，gas和gasPrice不使用
+type callArgs struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Gas      string `json:"gas"`
+	GasPrice string `json:"gasPrice"`
+	Value    string `json:"value"`
+	Data     string `json:"data"`
+	Input    string `json:"input"`
+}
+
+func (t *ethApi) call(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	var args callArgs
+	var block string
+	if err := parseParams(params, 1, &args, &block); err != nil {
+		return nil, err
+	}
+	if err := requireLatest(block); err != nil {
+		return nil, err
+	}
+	resp, err := t.preExec(rctx, handle, &args)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	if responses := resp.GetResponses(); len(responses) > 0 {
+		out = responses[len(responses)-1].GetBody()
+	}
+	return encodeBytes(out), nil
+}
+
+func (t *ethApi) estimateGas(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	var args callArgs
+	var block string
+	if err := parseParams(params, 1, &args, &block); err != nil {
+		return nil, err
+	}
+	if err := requireLatest(block); err != nil {
+		return nil, err
+	}
+	resp, err := t.preExec(rctx, handle, &args)
+	if err != nil {
+		return nil, err
+	}
+	return encodeUint(uint64(resp.GetGasUsed())), nil
+}
+
+// 以abi编码的input预执行evm合约，from为空时使用零地址
+func (t *ethApi) preExec(rctx sctx.ReqCtx, handle *models.ChainHandle, args *callArgs) (*protos.InvokeResponse, error) {
+	if args.To == "" {
+		return nil, errInvalidParams("contract creation is not supported, deploy with xchain-cli evm deploy")
+	}
+	to, err := parseAddress(args.To)
+	if err != nil {
+		return nil, err
+	}
+	contractName, err := evm.DetermineContractNameFromEVM(to)
+	if err != nil {
+		return nil, errInvalidParams("%s is not an evm contract address", args.To)
+	}
+
+	initiator, err := evm.EVMAddressToXchain(crypto.ZeroAddress)
+	if err != nil {
+		return nil, ecom.ErrInternal
+	}
+	if args.From != "" {
+		if initiator, err = toXchainAddress(args.From); err != nil {
+			return nil, err
+		}
+	}
+
+	data := args.Input
+	if data == "" {
+		data = args.Data
+	}
+	input := []byte{}
+	if data != "" {
+		if input, err = decodeBytes(data); err != nil {
+			return nil, err
+		}
+	}
+	amount := ""
+	if args.Value != "" {
+		value, err := decodeBig(args.Value)
+		if err != nil {
+			return nil, err
+		}
+		if value.Sign() > 0 {
+			amount = value.String()
+		}
+	}
+
+	req := &protos.InvokeRequest{
+		ModuleName:   evmModule,
+		ContractName: contractName,
+		MethodName:   methodName(handle, contractName, input),
+		Args:         map[string][]byte{evmInputArg: input},
+		Amount:       amount,
+	}
+	rctx.GetLog().SetInfoField("contract_name", contractName)
+	rctx.GetLog().SetInfoField("method_name", req.MethodName)
+
+	resp, err := handle.PreExec([]*protos.InvokeRequest{req}, initiator, nil)
+	if err != nil {
+		rctx.GetLog().Warn("pre exec failed", "contract", contractName, "err", err)
+		return nil, err
+	}
+	return resp, nil
+}
+
+// 按函数选择器从abi查找方法名，合约方法权限按方法名检查；找不到时使用选择器
+func methodName(handle *models.ChainHandle, contractName string, input []byte) string {
+	if len(input) < abi.FunctionIDSize {
+		return "fallback"
+	}
+	var id abi.FunctionID
+	copy(id[:], input[:abi.FunctionIDSize])
+	if spec := newAbiCache(handle).get(contractName); spec != nil {
+		for name, fn := range spec.Functions {
+			if fn.FunctionID == id {
+				return name
+			}
+		}
+	}
+	return hex.EncodeToString(id[:])
+}
+
+func (t *ethApi) getBlockByNumber(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	var number string
+	var fullTx bool
+	if err := parseParams(params, 1, &number, &fullTx); err != nil {
+		return nil, err
+	}
+	height, err := blockHeight(handle, number)
+	if err != nil {
+		return nil, err
+	}
+	rctx.GetLog().SetInfoField("height", height)
+
+	blockInfo, err := handle.QueryBlockByHeight(height, true, t.cache)
+	if err != nil || blockInfo.GetBlock() == nil {
+		// 不存在的区块返回null
+		return nil, nil
+	}
+	return formatBlock(blockInfo.GetBlock(), fullTx), nil
+}
+
+func (t *ethApi) getTransactionReceipt(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	var hash string
+	if err := parseParams(params, 1, &hash); err != nil {
+		return nil, err
+	}
+	txid, err := decodeBytes(hash)
+	if err != nil {
+		return nil, err
+	}
+	rctx.GetLog().SetInfoField("txid", utils.F(txid))
+
+	// 未上链的交易返回null
+	txInfo, err := handle.QueryTx(txid, t.cache)
+	if err != nil || txInfo.GetTx() == nil || len(txInfo.GetTx().GetBlockid()) == 0 {
+		return nil, nil
+	}
+	blockInfo, err := handle.QueryBlock(txInfo.GetTx().GetBlockid(), true, t.cache)
+	if err != nil || blockInfo.GetBlock() == nil || !blockInfo.GetBlock().GetInTrunk() {
+		return nil, nil
+	}
+
+	block := blockInfo.GetBlock()
+	cache := newAbiCache(handle)
+	logIndex := 0
+	for i, tx := range block.GetTransactions() {
+		logs := cache.txLogs(tx, block, i, logIndex)
+		if bytes.Equal(tx.GetTxid(), txid) {
+			return formatReceipt(tx, block, i, logs), nil
+		}
+		logIndex += len(logs)
+	}
+	return nil, nil
+}
+
+// logFilter eth_getLogs的过滤条件，address和topics的每一项可以是单个值或数组
+type logFilter struct {
+	FromBlock string          `json:"fromBlock"`
+	ToBlock   string          `json:"toBlock"`
+	Address   json.RawMessage `json:"address"`
+	Topics    []interface{}   `json:"topics"`
+	BlockHash string          `json:"blockHash"`
+}
+
+func (t *ethApi) getLogs(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	var filter logFilter
+	if err := parseParams(params, 1, &filter); err != nil {
+		return nil, err
+	}
+	addrs, err := parseFilterAddress(filter.Address)
+	if err != nil {
+		return nil, err
+	}
+	topics, err := parseFilterTopics(filter.Topics)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocks []*lpb.InternalBlock
+	if filter.BlockHash != "" {
+		if filter.FromBlock != "" || filter.ToBlock != "" {
+			return nil, errInvalidParams("blockHash can not be used with fromBlock or toBlock")
+		}
+		blockid, err := decodeBytes(filter.BlockHash)
+		if err != nil {
+			return nil, err
+		}
+		blockInfo, err := handle.QueryBlock(blockid, true, t.cache)
+		if err != nil || blockInfo.GetBlock() == nil {
+			return nil, newRpcError(codeServerError, "unknown block")
+		}
+		blocks = append(blocks, blockInfo.GetBlock())
+	} else {
+		from, err := blockHeight(handle, filter.FromBlock)
+		if err != nil {
+			return nil, err
+		}
+		to, err := blockHeight(handle, filter.ToBlock)
+		if err != nil {
+			return nil, err
+		}
+		if from > to {
+			return []*ethLog{}, nil
+		}
+		if to-from >= int64(t.conf.MaxLogRange) {
+			return nil, newRpcError(codeServerError, "block range too large, max %d blocks", t.conf.MaxLogRange)
+		}
+		rctx.GetLog().SetInfoField("from_block", from)
+		rctx.GetLog().SetInfoField("to_block", to)
+		for height := from; height <= to; height++ {
+			blockInfo, err := handle.QueryBlockByHeight(height, true, t.cache)
+			if err != nil || blockInfo.GetBlock() == nil {
+				rctx.GetLog().Warn("query block failed", "height", height, "err", err)
+				return nil, ecom.ErrBlockNotExist
+			}
+			blocks = append(blocks, blockInfo.GetBlock())
+		}
+	}
+
+	cache := newAbiCache(handle)
+	result := make([]*ethLog, 0)
+	for _, block := range blocks {
+		logIndex := 0
+		for i, tx := range block.GetTransactions() {
+			logs := cache.txLogs(tx, block, i, logIndex)
+			logIndex += len(logs)
+			for _, log := range logs {
+				if matchLog(log, addrs, topics) {
+					result = append(result, log)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// 接受protobuf编码并签名的xchain交易，以太坊签名的交易无法转换
+func (t *ethApi) sendRawTransaction(rctx sctx.ReqCtx, handle *models.ChainHandle, params json.RawMessage) (interface{}, error) {
+	var data string
+	if err := parseParams(params, 1, &data); err != nil {
+		return nil, err
+	}
+	raw, err := decodeBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, errInvalidParams("empty transaction")
+	}
+	// 传统交易是以0xc0以上开头的rlp列表，EIP-2718类型交易以类型号开头
+	if raw[0] >= 0xc0 || raw[0] <= 0x7f && raw[0] != txidTag {
+		return nil, newRpcError(codeUnsupportedTx, "ethereum signed transactions are not supported, "+
+			"send a signed xuperchain transaction encoded in protobuf")
+	}
+	tx := new(lpb.Transaction)
+	if err := proto.Unmarshal(raw, tx); err != nil || len(tx.GetTxid()) == 0 {
+		return nil, errInvalidParams("invalid xuperchain transaction")
+	}
+	rctx.GetLog().SetInfoField("txid", utils.F(tx.GetTxid()))
+
+	// 排空期间拒绝新交易
+	if t.drainer.IsDraining() {
+		rctx.GetLog().Warn("node is draining, reject tx")
+		t.speeds.Mark(t.conf.Bcname, metrics.EventTxRejected, 1)
+		return nil, ecom.ErrForbidden
+	}
+	if err := handle.SubmitTx(tx); err != nil {
+		rctx.GetLog().Warn("submit tx failed", "err", err)
+		t.speeds.Mark(t.conf.Bcname, metrics.EventTxRejected, 1)
+		return nil, err
+	}
+	t.speeds.Mark(t.conf.Bcname, metrics.EventTxSubmitted, 1)
+	t.pendingTxs.Publish(t.conf.Bcname, tx)
+	msg := p2p.NewMessage(protos.XuperMessage_POSTTX, tx,
+		p2p.WithBCName(t.conf.Bcname),
+		p2p.WithLogId(rctx.GetLog().GetLogId()),
+	)
+	go t.engine.Context().Net.SendMessage(rctx, msg)
+	return encodeBytes(tx.GetTxid()), nil
+}
+
+func tipHeight(handle *models.ChainHandle) (int64, error) {
+	status, err := handle.QueryChainStatus()
+	if err != nil {
+		return 0, err
+	}
+	return status.GetLedgerMeta().GetTrunkHeight(), nil
+}
+
+// 区块号可以是标签或十六进制高度，为空时取最新块
+func blockHeight(handle *models.ChainHandle, number string) (int64, error) {
+	switch number {
+	case "", blockLatest, blockPending, blockSafe, blockFinalized:
+		return tipHeight(handle)
+	case blockEarliest:
+		return 0, nil
+	}
+	height, err := decodeBig(number)
+	if err != nil {
+		return 0, err
+	}
+	if !height.IsInt64() {
+		return 0, errInvalidParams("invalid block number %s", number)
+	}
+	return height.Int64(), nil
+}
+
+// 状态查询不支持历史区块
+func requireLatest(block string) error {
+	switch block {
+	case "", blockLatest, blockPending:
+		return nil
+	}
+	return errInvalidParams("only latest block is supported")
+}
+
+func parseFilterAddress(raw json.RawMessage) (map[string]bool, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		var addr string
+		if err := json.Unmarshal(raw, &addr); err != nil {
+			return nil, errInvalidParams("invalid address filter")
+		}
+		list = []string{addr}
+	}
+	addrs := make(map[string]bool)
+	for _, addr := range list {
+		if _, err := parseAddress(addr); err != nil {
+			return nil, err
+		}
+		addrs[strings.ToLower(addr)] = true
+	}
+	return addrs, nil
+}
+
+// 每个位置为nil时匹配任意topic，否则匹配其中之一
+func parseFilterTopics(list []interface{}) ([]map[string]bool, error) {
+	topics := make([]map[string]bool, 0, len(list))
+	for _, item := range list {
+		var values []interface{}
+		switch v := item.(type) {
+		case nil:
+			topics = append(topics, nil)
+			continue
+		case string:
+			values = []interface{}{v}
+		case []interface{}:
+			values = v
+		default:
+			return nil, errInvalidParams("invalid topic filter")
+		}
+		set := make(map[string]bool)
+		for _, value := range values {
+			topic, ok := value.(string)
+			if !ok {
+				return nil, errInvalidParams("invalid topic filter")
+			}
+			set[strings.ToLower(topic)] = true
+		}
+		topics = append(topics, set)
+	}
+	return topics, nil
+}
+
+func matchLog(log *ethLog, addrs map[string]bool, topics []map[string]bool) bool {
+	if len(addrs) > 0 && !addrs[log.Address] {
+		return false
+	}
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, set := range topics {
+		if len(set) > 0 && !set[log.Topics[i]] {
+			return false
+		}
+	}
+	return true
+}
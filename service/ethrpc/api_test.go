@@ -0,0 +1,171 @@
+package ethrpc
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseFilterTopics(t *testing.T) {
+	cases := []struct {
+		topics string
+		ok     bool
+		// 每个位置的topic个数，-1表示匹配任意topic
+		sizes []int
+	}{
+		{topics: `[]`, ok: true, sizes: []int{}},
+		{topics: `[null,"0xAA",["0xbb","0xCC"]]`, ok: true, sizes: []int{-1, 1, 2}},
+		{topics: `[[]]`, ok: true, sizes: []int{0}},
+		{topics: `[1]`},
+		{topics: `[["0xaa",1]]`},
+		{topics: `[{"topic":"0xaa"}]`},
+	}
+	for _, c := range cases {
+		var list []interface{}
+		if err := json.Unmarshal([]byte(c.topics), &list); err != nil {
+			t.Fatal(err)
+		}
+		topics, err := parseFilterTopics(list)
+		if (err == nil) != c.ok {
+			t.Errorf("%s: expect ok %v, got %v", c.topics, c.ok, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if len(topics) != len(c.sizes) {
+			t.Errorf("%s: got %d positions", c.topics, len(topics))
+			continue
+		}
+		for i, set := range topics {
+			if (set == nil && c.sizes[i] != -1) || (set != nil && len(set) != c.sizes[i]) {
+				t.Errorf("%s: unexpected position %d %v", c.topics, i, set)
+			}
+			for topic := range set {
+				if topic != strings.ToLower(topic) {
+					t.Errorf("%s: topic %s should be lower case", c.topics, topic)
+				}
+			}
+		}
+	}
+}
+
+func TestParseFilterAddress(t *testing.T) {
+	a := "0x" + strings.Repeat("AB", 20)
+	b := "0x" + strings.Repeat("cd", 20)
+	cases := []struct {
+		raw    string
+		ok     bool
+		expect []string
+	}{
+		{raw: ``, ok: true},
+		{raw: `null`, ok: true},
+		{raw: `"` + a + `"`, ok: true, expect: []string{strings.ToLower(a)}},
+		{raw: `["` + a + `","` + b + `"]`, ok: true, expect: []string{strings.ToLower(a), b}},
+		{raw: `"0x01"`},
+		{raw: `1`},
+	}
+	for _, c := range cases {
+		addrs, err := parseFilterAddress(json.RawMessage(c.raw))
+		if (err == nil) != c.ok {
+			t.Errorf("%s: expect ok %v, got %v", c.raw, c.ok, err)
+			continue
+		}
+		if len(addrs) != len(c.expect) {
+			t.Errorf("%s: got %v", c.raw, addrs)
+			continue
+		}
+		for _, addr := range c.expect {
+			if !addrs[addr] {
+				t.Errorf("%s: %s should be matched", c.raw, addr)
+			}
+		}
+	}
+}
+
+func TestMatchLog(t *testing.T) {
+	addr := "0x" + strings.Repeat("ab", 20)
+	log := &ethLog{Address: addr, Topics: []string{"0x01", "0x02"}}
+	topics := func(list string) []map[string]bool {
+		var items []interface{}
+		json.Unmarshal([]byte(list), &items)
+		topics, err := parseFilterTopics(items)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return topics
+	}
+
+	cases := []struct {
+		name   string
+		addrs  map[string]bool
+		topics string
+		match  bool
+	}{
+		{name: "any", topics: `[]`, match: true},
+		{name: "address", addrs: map[string]bool{addr: true}, topics: `[]`, match: true},
+		{name: "other address", addrs: map[string]bool{"0x00": true}, topics: `[]`},
+		{name: "topic0", topics: `["0x01"]`, match: true},
+		{name: "any topic0", topics: `[null,"0x02"]`, match: true},
+		{name: "one of topics", topics: `[["0x03","0x01"],"0x02"]`, match: true},
+		{name: "topic not match", topics: `["0x02"]`},
+		{name: "empty set matches any", topics: `[[],"0x02"]`, match: true},
+		{name: "more topics than log", topics: `[null,null,null]`},
+	}
+	for _, c := range cases {
+		if matchLog(log, c.addrs, topics(c.topics)) != c.match {
+			t.Errorf("%s: expect match %v", c.name, c.match)
+		}
+	}
+}
+
+func TestBlockHeight(t *testing.T) {
+	// 不是标签的区块号不需要查询链
+	cases := []struct {
+		number string
+		ok     bool
+		expect int64
+	}{
+		{number: blockEarliest, ok: true},
+		{number: "0x10", ok: true, expect: 16},
+		{number: "16"},
+		{number: "0x" + strings.Repeat("f", 17)},
+	}
+	for _, c := range cases {
+		height, err := blockHeight(nil, c.number)
+		if (err == nil) != c.ok || height != c.expect {
+			t.Errorf("%s: got %d %v", c.number, height, err)
+		}
+	}
+
+	for block, ok := range map[string]bool{"": true, blockLatest: true, blockPending: true,
+		blockEarliest: false, blockFinalized: false, "0x1": false} {
+		if (requireLatest(block) == nil) != ok {
+			t.Errorf("requireLatest(%q) expect ok %v", block, ok)
+		}
+	}
+}
+
+func TestSendRawTransaction(t *testing.T) {
+	api := &ethApi{}
+	// 以太坊签名的交易在访问链之前拒绝
+	cases := []struct {
+		name string
+		data string
+		code int
+	}{
+		{name: "legacy rlp", data: "0xf86c0a8502540be400", code: codeUnsupportedTx},
+		{name: "eip-1559", data: "0x02f8720101", code: codeUnsupportedTx},
+		{name: "eip-2930", data: "0x01f8710101", code: codeUnsupportedTx},
+		{name: "empty", data: "0x", code: codeInvalidParams},
+		{name: "not hex", data: "0xzz", code: codeInvalidParams},
+		{name: "bad protobuf", data: "0x0a0201", code: codeInvalidParams},
+	}
+	for _, c := range cases {
+		params, _ := json.Marshal([]string{c.data})
+		_, err := api.sendRawTransaction(nil, nil, params)
+		if rerr, ok := err.(*rpcError); !ok || rerr.Code != c.code {
+			t.Errorf("%s: expect code %d, got %v", c.name, c.code, err)
+		}
+	}
+}
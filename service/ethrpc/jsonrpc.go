@@ -0,0 +1,132 @@
+package ethrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+)
+
+const jsonrpcVersion = "2.0"
+
+// JSON-RPC 2.0错误码，-32000为以太坊节点通用的服务端错误
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+	codeServerError    = -32000
+	// 以太坊签名的交易，只接受protobuf编码的xchain交易，客户端据此区分
+	codeUnsupportedTx = -32090
+)
+
+type rpcRequest struct {
+	Version string `json:"jsonrpc"`
+	// 没有id的请求是通知，不返回响应
+	Id     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+}
+
+// 成功时result必须存在，可以为null；失败时不能有result
+type rpcResponse struct {
+	Version string           `json:"jsonrpc"`
+	Id      json.RawMessage  `json:"id"`
+	Result  *json.RawMessage `json:"result,omitempty"`
+	Error   *rpcError        `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int         `json:"code"`
+	Message string      `json:"message"`
+	Data    interface{} `json:"data,omitempty"`
+}
+
+func (t *rpcError) Error() string {
+	return fmt.Sprintf("jsonrpc error %d: %s", t.Code, t.Message)
+}
+
+func newRpcError(code int, format string, args ...interface{}) *rpcError {
+	return &rpcError{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func errInvalidParams(format string, args ...interface{}) *rpcError {
+	return newRpcError(codeInvalidParams, format, args...)
+}
+
+// 引擎的标准错误转换为JSON-RPC错误，参数错误对应invalid params
+func toRpcError(err error) *rpcError {
+	if rerr, ok := err.(*rpcError); ok {
+		return rerr
+	}
+	stdErr := ecom.CastError(err)
+	if stdErr.Equal(ecom.ErrParameter) {
+		return &rpcError{Code: codeInvalidParams, Message: stdErr.Msg}
+	}
+	return &rpcError{Code: codeServerError, Message: stdErr.Msg}
+}
+
+func (t *rpcRequest) isNotification() bool {
+	return t.Id == nil
+}
+
+func (t *rpcRequest) validate() *rpcError {
+	if t.Version != jsonrpcVersion || t.Method == "" {
+		return newRpcError(codeInvalidRequest, "invalid request")
+	}
+	// id只能是字符串、数字或null，无效的id不在响应中返回
+	if t.Id != nil && len(t.Id) > 0 && (t.Id[0] == '{' || t.Id[0] == '[') {
+		t.Id = nil
+		return newRpcError(codeInvalidRequest, "invalid request id")
+	}
+	return nil
+}
+
+func newResult(id json.RawMessage, result interface{}) *rpcResponse {
+	buf, err := json.Marshal(result)
+	if err != nil {
+		return newErrorResult(id, newRpcError(codeInternalError, "marshal result failed"))
+	}
+	raw := json.RawMessage(buf)
+	return &rpcResponse{Version: jsonrpcVersion, Id: id, Result: &raw}
+}
+
+func newErrorResult(id json.RawMessage, err *rpcError) *rpcResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &rpcResponse{Version: jsonrpcVersion, Id: id, Error: err}
+}
+
+// 请求体为数组时是批量请求
+func isBatch(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+// 按位置解析params，参数个数在required和len(args)之间，缺省的参数保持零值
+func parseParams(params json.RawMessage, required int, args ...interface{}) error {
+	var list []json.RawMessage
+	if len(params) > 0 && string(params) != "null" {
+		if err := json.Unmarshal(params, &list); err != nil {
+			return errInvalidParams("params should be an array")
+		}
+	}
+	if len(list) < required {
+		return errInvalidParams("missing value for required argument %d", len(list))
+	}
+	if len(list) > len(args) {
+		return errInvalidParams("too many arguments, want at most %d", len(args))
+	}
+	for i, raw := range list {
+		if string(raw) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(raw, args[i]); err != nil {
+			return errInvalidParams("invalid argument %d: %v", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package ethrpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	scom "github.com/xuperchain/xuperos/service/common"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) GetLogId() string                           { return "test" }
+func (nopLogger) SetCommField(key string, value interface{}) {}
+func (nopLogger) SetInfoField(key string, value interface{}) {}
+func (nopLogger) Error(msg string, ctx ...interface{})       {}
+func (nopLogger) Warn(msg string, ctx ...interface{})        {}
+func (nopLogger) Info(msg string, ctx ...interface{})        {}
+func (nopLogger) Trace(msg string, ctx ...interface{})       {}
+func (nopLogger) Debug(msg string, ctx ...interface{})       {}
+
+// 没有注册方法的api，请求都返回method not found，不依赖引擎
+func serveTest(t *testing.T, method, body string) *httptest.ResponseRecorder {
+	api := &ethApi{drainer: scom.NewDrainer(), log: nopLogger{}, methods: map[string]ethMethod{}}
+	w := httptest.NewRecorder()
+	api.serveHTTP(w, httptest.NewRequest(method, "/", strings.NewReader(body)))
+	return w
+}
+
+type testResponse struct {
+	Id     json.RawMessage
+	Result json.RawMessage
+	Error  *rpcError
+}
+
+func TestServeHTTP(t *testing.T) {
+	notFound := `{"jsonrpc":"2.0","id":1,"method":"eth_foo"}`
+	notify := `{"jsonrpc":"2.0","method":"eth_foo"}`
+	cases := []struct {
+		name  string
+		body  string
+		batch bool
+		// 按顺序期望的响应id和错误码，为空表示不返回内容
+		ids   []string
+		codes []int
+	}{
+		{name: "single", body: notFound, ids: []string{"1"}, codes: []int{codeMethodNotFound}},
+		{name: "notification", body: notify},
+		{name: "parse error", body: `{"jsonrpc":`, ids: []string{"null"}, codes: []int{codeParseError}},
+		{name: "invalid request", body: `{"jsonrpc":"1.0","id":"a","method":"eth_foo"}`,
+			ids: []string{`"a"`}, codes: []int{codeInvalidRequest}},
+		{name: "invalid id", body: `{"jsonrpc":"2.0","id":{},"method":"eth_foo"}`,
+			ids: []string{"null"}, codes: []int{codeInvalidRequest}},
+		{name: "empty batch", body: `[]`, ids: []string{"null"}, codes: []int{codeInvalidRequest}},
+		{name: "broken batch", body: `[` + notFound, ids: []string{"null"}, codes: []int{codeParseError}},
+		{
+			name:  "batch",
+			body:  " [" + notFound + "," + notify + `,1,{"jsonrpc":"2.0","id":"b"}]`,
+			batch: true,
+			ids:   []string{"1", "null", `"b"`},
+			codes: []int{codeMethodNotFound, codeInvalidRequest, codeInvalidRequest},
+		},
+		{name: "batch of notifications", body: "[" + notify + "," + notify + "]"},
+	}
+	for _, c := range cases {
+		w := serveTest(t, http.MethodPost, c.body)
+		if w.Code != http.StatusOK {
+			t.Errorf("%s: status %d", c.name, w.Code)
+			continue
+		}
+		if len(c.ids) == 0 {
+			if w.Body.Len() != 0 {
+				t.Errorf("%s: expect no content, got %s", c.name, w.Body)
+			}
+			continue
+		}
+
+		var resps []*testResponse
+		if c.batch {
+			if err := json.Unmarshal(w.Body.Bytes(), &resps); err != nil {
+				t.Errorf("%s: batch response should be an array, got %s", c.name, w.Body)
+				continue
+			}
+		} else {
+			resp := new(testResponse)
+			if err := json.Unmarshal(w.Body.Bytes(), resp); err != nil {
+				t.Errorf("%s: invalid response %s", c.name, w.Body)
+				continue
+			}
+			resps = append(resps, resp)
+		}
+		if len(resps) != len(c.ids) {
+			t.Errorf("%s: expect %d responses, got %s", c.name, len(c.ids), w.Body)
+			continue
+		}
+		for i, resp := range resps {
+			if string(resp.Id) != c.ids[i] || resp.Error == nil || resp.Error.Code != c.codes[i] ||
+				resp.Result != nil {
+				t.Errorf("%s: response %d unexpected %s", c.name, i, w.Body)
+			}
+		}
+	}
+
+	if w := serveTest(t, http.MethodGet, ""); w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("GET should not be allowed, got %d", w.Code)
+	}
+}
+
+func TestNewResult(t *testing.T) {
+	// 成功时result为null也要返回
+	buf, _ := json.Marshal(newResult(json.RawMessage("1"), nil))
+	if string(buf) != `{"jsonrpc":"2.0","id":1,"result":null}` {
+		t.Errorf("unexpected result %s", buf)
+	}
+	buf, _ = json.Marshal(newErrorResult(nil, errInvalidParams("bad")))
+	if string(buf) != `{"jsonrpc":"2.0","id":null,"error":{"code":-32602,"message":"bad"}}` {
+		t.Errorf("unexpected error result %s", buf)
+	}
+}
+
+func TestParseParams(t *testing.T) {
+	cases := []struct {
+		params string
+		ok     bool
+		addr   string
+		block  string
+	}{
+		{params: `["0x01","latest"]`, ok: true, addr: "0x01", block: "latest"},
+		{params: `["0x01"]`, ok: true, addr: "0x01"},
+		{params: `["0x01",null]`, ok: true, addr: "0x01"},
+		{params: `[]`},
+		{params: ``},
+		{params: `null`},
+		{params: `{"addr":"0x01"}`},
+		{params: `["0x01","latest",true]`},
+		{params: `[1]`},
+	}
+	for _, c := range cases {
+		var addr, block string
+		err := parseParams(json.RawMessage(c.params), 1, &addr, &block)
+		if (err == nil) != c.ok {
+			t.Errorf("params %s: expect ok %v, got %v", c.params, c.ok, err)
+			continue
+		}
+		if err != nil {
+			if rerr, ok := err.(*rpcError); !ok || rerr.Code != codeInvalidParams {
+				t.Errorf("params %s: expect invalid params, got %v", c.params, err)
+			}
+			continue
+		}
+		if addr != c.addr || block != c.block {
+			t.Errorf("params %s: got %s %s", c.params, addr, block)
+		}
+	}
+}
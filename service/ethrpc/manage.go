@@ -0,0 +1,99 @@
+package ethrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	"github.com/xuperchain/xuperos/common/metrics"
+	"github.com/xuperchain/xuperos/models"
+	"github.com/xuperchain/xuperos/service/adapter/event"
+	scom "github.com/xuperchain/xuperos/service/common"
+)
+
+const (
+	SubModName = "ethrpc"
+	// 单个http请求体上限
+	maxBodySize = 5 << 20
+)
+
+// EthRpcServ 以太坊JSON-RPC兼容服务，供ethers、web3等工具访问evm合约
+// 请求映射到一条链的ChainHandle，地址按xupercore evm规则和xchain地址、合约名互转
+type EthRpcServ struct {
+	scfg     *sconf.ServConf
+	log      logs.Logger
+	api      *ethApi
+	server   *http.Server
+	isInit   bool
+	exitOnce *sync.Once
+}
+
+func NewEthRpcServ(scfg *sconf.ServConf, engine ecom.Engine, drainer *scom.Drainer,
+	ledgerCache *models.LedgerCache, speeds *metrics.Speeds,
+	pendingTxs *event.PendingTxFeed) (*EthRpcServ, error) {
+	if scfg == nil || engine == nil || drainer == nil || speeds == nil || pendingTxs == nil {
+		return nil, fmt.Errorf("param error")
+	}
+	conf := scfg.EthRpc
+	if conf.Bcname == "" {
+		return nil, fmt.Errorf("eth rpc bcname unset")
+	}
+	if conf.ChainId <= 0 {
+		return nil, fmt.Errorf("eth rpc chain id should be positive")
+	}
+
+	log, _ := loglevel.NewLogger("", SubModName)
+	obj := &EthRpcServ{
+		scfg:     scfg,
+		log:      log,
+		api:      newEthApi(conf, engine, drainer, ledgerCache, speeds, pendingTxs, log),
+		isInit:   true,
+		exitOnce: &sync.Once{},
+	}
+	return obj, nil
+}
+
+// 启动eth rpc服务，阻塞直到退出
+func (t *EthRpcServ) Run() error {
+	if !t.isInit {
+		return errors.New("eth rpc server not init")
+	}
+
+	conf := t.scfg.EthRpc
+	t.server = &http.Server{
+		Addr:        fmt.Sprintf("%s:%d", conf.Host, conf.Port),
+		Handler:     http.HandlerFunc(t.api.serveHTTP),
+		ReadTimeout: 30 * time.Second,
+	}
+	t.log.Trace("eth rpc server started", "addr", t.server.Addr, "bcname", conf.Bcname,
+		"chain_id", conf.ChainId)
+	err := t.server.ListenAndServe()
+	if err != http.ErrServerClosed {
+		t.log.Error("eth rpc server abnormal exit", "err", err)
+		return err
+	}
+
+	t.log.Trace("eth rpc server exit")
+	return nil
+}
+
+// 退出eth rpc服务，需要幂等
+func (t *EthRpcServ) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		if t.server != nil {
+			t.server.Shutdown(context.Background())
+		}
+	})
+}
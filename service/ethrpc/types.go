@@ -0,0 +1,465 @@
+package ethrpc
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/xuperchain/xupercore/bcs/contract/evm"
+	lpb "github.com/xuperchain/xupercore/bcs/ledger/xledger/xldgpb"
+	"github.com/xuperchain/xupercore/kernel/contract"
+	"github.com/xuperchain/xupercore/kernel/contract/sandbox"
+	"github.com/xuperchain/xupercore/protos"
+
+	"github.com/xuperchain/xuperos/models"
+)
+
+const (
+	// 区块没有叔块，取以太坊空叔块列表的哈希
+	emptyUncleHash = "0x1dcc4de8dec75d7aab85b567b6ccd41ad312451b948a7413f0a142fd40d49347"
+	// evm合约的模块名和部署方法
+	evmModule         = "evm"
+	evmInitMethod     = "initialize"
+	evmInputArg       = "input"
+	deployModule      = "xkernel"
+	deployMethod      = "deployContract"
+	evmContractType   = "evm"
+	ethAddressHexSize = 40
+)
+
+var (
+	zeroHash    = "0x" + strings.Repeat("0", 64)
+	zeroBloom   = "0x" + strings.Repeat("0", 512)
+	zeroAddress = "0x" + strings.Repeat("0", ethAddressHexSize)
+)
+
+type ethBlock struct {
+	Number           string        `json:"number"`
+	Hash             string        `json:"hash"`
+	ParentHash       string        `json:"parentHash"`
+	Nonce            string        `json:"nonce"`
+	Sha3Uncles       string        `json:"sha3Uncles"`
+	LogsBloom        string        `json:"logsBloom"`
+	TransactionsRoot string        `json:"transactionsRoot"`
+	StateRoot        string        `json:"stateRoot"`
+	ReceiptsRoot     string        `json:"receiptsRoot"`
+	Miner            string        `json:"miner"`
+	Difficulty       string        `json:"difficulty"`
+	TotalDifficulty  string        `json:"totalDifficulty"`
+	ExtraData        string        `json:"extraData"`
+	Size             string        `json:"size"`
+	GasLimit         string        `json:"gasLimit"`
+	GasUsed          string        `json:"gasUsed"`
+	Timestamp        string        `json:"timestamp"`
+	Transactions     []interface{} `json:"transactions"`
+	Uncles           []string      `json:"uncles"`
+}
+
+type ethTransaction struct {
+	Hash             string  `json:"hash"`
+	Nonce            string  `json:"nonce"`
+	BlockHash        string  `json:"blockHash"`
+	BlockNumber      string  `json:"blockNumber"`
+	TransactionIndex string  `json:"transactionIndex"`
+	From             string  `json:"from"`
+	To               *string `json:"to"`
+	Value            string  `json:"value"`
+	Gas              string  `json:"gas"`
+	GasPrice         string  `json:"gasPrice"`
+	Input            string  `json:"input"`
+	Type             string  `json:"type"`
+	V                string  `json:"v"`
+	R                string  `json:"r"`
+	S                string  `json:"s"`
+}
+
+type ethReceipt struct {
+	TransactionHash   string    `json:"transactionHash"`
+	TransactionIndex  string    `json:"transactionIndex"`
+	BlockHash         string    `json:"blockHash"`
+	BlockNumber       string    `json:"blockNumber"`
+	From              string    `json:"from"`
+	To                *string   `json:"to"`
+	CumulativeGasUsed string    `json:"cumulativeGasUsed"`
+	GasUsed           string    `json:"gasUsed"`
+	EffectiveGasPrice string    `json:"effectiveGasPrice"`
+	ContractAddress   *string   `json:"contractAddress"`
+	Logs              []*ethLog `json:"logs"`
+	LogsBloom         string    `json:"logsBloom"`
+	Type              string    `json:"type"`
+	Status            string    `json:"status"`
+}
+
+type ethLog struct {
+	Address          string   `json:"address"`
+	Topics           []string `json:"topics"`
+	Data             string   `json:"data"`
+	BlockNumber      string   `json:"blockNumber"`
+	BlockHash        string   `json:"blockHash"`
+	TransactionHash  string   `json:"transactionHash"`
+	TransactionIndex string   `json:"transactionIndex"`
+	LogIndex         string   `json:"logIndex"`
+	Removed          bool     `json:"removed"`
+}
+
+func encodeUint(n uint64) string {
+	return "0x" + strconv.FormatUint(n, 16)
+}
+
+func encodeBig(n *big.Int) string {
+	return "0x" + n.Text(16)
+}
+
+func encodeBytes(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+func has0xPrefix(s string) bool {
+	return len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X')
+}
+
+func decodeBytes(s string) ([]byte, error) {
+	if !has0xPrefix(s) {
+		return nil, errInvalidParams("hex string without 0x prefix")
+	}
+	b, err := hex.DecodeString(s[2:])
+	if err != nil {
+		return nil, errInvalidParams("invalid hex string")
+	}
+	return b, nil
+}
+
+func decodeBig(s string) (*big.Int, error) {
+	if !has0xPrefix(s) || len(s) == 2 {
+		return nil, errInvalidParams("invalid hex quantity %s", s)
+	}
+	n, ok := new(big.Int).SetString(s[2:], 16)
+	if !ok || n.Sign() < 0 {
+		return nil, errInvalidParams("invalid hex quantity %s", s)
+	}
+	return n, nil
+}
+
+func parseAddress(s string) (crypto.Address, error) {
+	if !has0xPrefix(s) || len(s) != ethAddressHexSize+2 {
+		return crypto.ZeroAddress, errInvalidParams("invalid address %s", s)
+	}
+	addr, err := crypto.AddressFromHexString(s[2:])
+	if err != nil {
+		return crypto.ZeroAddress, errInvalidParams("invalid address %s", s)
+	}
+	return addr, nil
+}
+
+// 以太坊地址转换为xchain地址、合约账户或合约名
+func toXchainAddress(s string) (string, error) {
+	addr, err := parseAddress(s)
+	if err != nil {
+		return "", err
+	}
+	xAddr, _, err := evm.DetermineEVMAddress(addr)
+	if err != nil {
+		return "", errInvalidParams("invalid address %s", s)
+	}
+	return xAddr, nil
+}
+
+// xchain地址、合约账户或合约名转换为以太坊地址，无法转换时返回空
+func toEthAddress(xAddr string) string {
+	if xAddr == "" {
+		return ""
+	}
+	addr, _, err := evm.DetermineXchainAddress(xAddr)
+	if err != nil {
+		return ""
+	}
+	return "0x" + strings.ToLower(addr)
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// 交易的以太坊视图：evm合约调用的目标合约、输入和转账金额，普通转账取第一个非找零的输出
+func txCall(tx *lpb.Transaction) (to string, input []byte, value *big.Int) {
+	value = big.NewInt(0)
+	for _, req := range tx.GetContractRequests() {
+		if req.GetModuleName() != evmModule || req.GetMethodName() == evmInitMethod {
+			continue
+		}
+		if amount, ok := new(big.Int).SetString(req.GetAmount(), 10); ok {
+			value = amount
+		}
+		return toEthAddress(req.GetContractName()), req.GetArgs()[evmInputArg], value
+	}
+	if len(tx.GetContractRequests()) > 0 {
+		return "", nil, value
+	}
+
+	for _, output := range tx.GetTxOutputs() {
+		toAddr := string(output.GetToAddr())
+		if toAddr == tx.GetInitiator() || toAddr == lpb.FeePlaceholder {
+			continue
+		}
+		return toEthAddress(toAddr), nil, new(big.Int).SetBytes(output.GetAmount())
+	}
+	return "", nil, value
+}
+
+// 交易手续费，gasPrice固定为1，gasUsed即手续费
+func txFee(tx *lpb.Transaction) *big.Int {
+	fee := big.NewInt(0)
+	for _, output := range tx.GetTxOutputs() {
+		if string(output.GetToAddr()) == lpb.FeePlaceholder {
+			fee.Add(fee, new(big.Int).SetBytes(output.GetAmount()))
+		}
+	}
+	return fee
+}
+
+// 部署evm合约的交易返回合约地址
+func txContractAddress(tx *lpb.Transaction) string {
+	for _, req := range tx.GetContractRequests() {
+		if req.GetModuleName() != deployModule || req.GetMethodName() != deployMethod {
+			continue
+		}
+		desc := new(protos.WasmCodeDesc)
+		if err := proto.Unmarshal(req.GetArgs()["contract_desc"], desc); err != nil {
+			continue
+		}
+		if desc.GetContractType() == evmContractType {
+			return toEthAddress(string(req.GetArgs()["contract_name"]))
+		}
+	}
+	return ""
+}
+
+// 区块时间戳单位为纳秒
+func blockTime(block *lpb.InternalBlock) uint64 {
+	return uint64(block.GetTimestamp() / 1e9)
+}
+
+func formatBlock(block *lpb.InternalBlock, fullTx bool) *ethBlock {
+	gasUsed := big.NewInt(0)
+	txs := make([]interface{}, 0, len(block.GetTransactions()))
+	for i, tx := range block.GetTransactions() {
+		gasUsed.Add(gasUsed, txFee(tx))
+		if fullTx {
+			txs = append(txs, formatTx(tx, block, i))
+		} else {
+			txs = append(txs, encodeBytes(tx.GetTxid()))
+		}
+	}
+
+	return &ethBlock{
+		Number:           encodeUint(uint64(block.GetHeight())),
+		Hash:             encodeBytes(block.GetBlockid()),
+		ParentHash:       hashOrZero(block.GetPreHash()),
+		Nonce:            "0x0000000000000000",
+		Sha3Uncles:       emptyUncleHash,
+		LogsBloom:        zeroBloom,
+		TransactionsRoot: hashOrZero(block.GetMerkleRoot()),
+		StateRoot:        zeroHash,
+		ReceiptsRoot:     zeroHash,
+		Miner:            addressOrZero(toEthAddress(string(block.GetProposer()))),
+		Difficulty:       "0x0",
+		TotalDifficulty:  "0x0",
+		ExtraData:        "0x",
+		Size:             encodeUint(uint64(proto.Size(block))),
+		GasLimit:         encodeUint(uint64(contract.MaxLimits.Cpu)),
+		GasUsed:          encodeBig(gasUsed),
+		Timestamp:        encodeUint(blockTime(block)),
+		Transactions:     txs,
+		Uncles:           []string{},
+	}
+}
+
+func formatTx(tx *lpb.Transaction, block *lpb.InternalBlock, index int) *ethTransaction {
+	to, input, value := txCall(tx)
+	return &ethTransaction{
+		Hash:             encodeBytes(tx.GetTxid()),
+		Nonce:            "0x0",
+		BlockHash:        encodeBytes(block.GetBlockid()),
+		BlockNumber:      encodeUint(uint64(block.GetHeight())),
+		TransactionIndex: encodeUint(uint64(index)),
+		From:             addressOrZero(toEthAddress(tx.GetInitiator())),
+		To:               optional(to),
+		Value:            encodeBig(value),
+		Gas:              encodeBig(txFee(tx)),
+		GasPrice:         "0x1",
+		Input:            encodeBytes(input),
+		Type:             "0x0",
+		V:                "0x0",
+		R:                "0x0",
+		S:                "0x0",
+	}
+}
+
+func formatReceipt(tx *lpb.Transaction, block *lpb.InternalBlock, index int, logs []*ethLog) *ethReceipt {
+	cumulative := big.NewInt(0)
+	for _, prev := range block.GetTransactions()[:index+1] {
+		cumulative.Add(cumulative, txFee(prev))
+	}
+	status := "0x1"
+	if _, ok := block.GetFailedTxs()[hex.EncodeToString(tx.GetTxid())]; ok {
+		status = "0x0"
+	}
+
+	to, _, _ := txCall(tx)
+	return &ethReceipt{
+		TransactionHash:   encodeBytes(tx.GetTxid()),
+		TransactionIndex:  encodeUint(uint64(index)),
+		BlockHash:         encodeBytes(block.GetBlockid()),
+		BlockNumber:       encodeUint(uint64(block.GetHeight())),
+		From:              addressOrZero(toEthAddress(tx.GetInitiator())),
+		To:                optional(to),
+		CumulativeGasUsed: encodeBig(cumulative),
+		GasUsed:           encodeBig(txFee(tx)),
+		EffectiveGasPrice: "0x1",
+		ContractAddress:   optional(txContractAddress(tx)),
+		Logs:              logs,
+		LogsBloom:         zeroBloom,
+		Type:              "0x0",
+		Status:            status,
+	}
+}
+
+func hashOrZero(hash []byte) string {
+	if len(hash) == 0 {
+		return zeroHash
+	}
+	return encodeBytes(hash)
+}
+
+func addressOrZero(addr string) string {
+	if addr == "" {
+		return zeroAddress
+	}
+	return addr
+}
+
+// abiCache 一次请求内缓存合约abi，转换合约事件时使用
+type abiCache struct {
+	handle *models.ChainHandle
+	specs  map[string]*abi.Spec
+}
+
+func newAbiCache(handle *models.ChainHandle) *abiCache {
+	return &abiCache{
+		handle: handle,
+		specs:  make(map[string]*abi.Spec),
+	}
+}
+
+// 非evm合约或abi解析失败时返回nil
+func (t *abiCache) get(contractName string) *abi.Spec {
+	if spec, ok := t.specs[contractName]; ok {
+		return spec
+	}
+	var spec *abi.Spec
+	if buf, err := t.handle.QueryContractAbi(contractName); err == nil && len(buf) > 0 {
+		spec, _ = abi.ReadSpec(buf)
+	}
+	t.specs[contractName] = spec
+	return spec
+}
+
+// 区块中交易的合约事件转换为以太坊日志，logIndex从区块内第一个事件开始计数
+func (t *abiCache) txLogs(tx *lpb.Transaction, block *lpb.InternalBlock, index int, logIndex int) []*ethLog {
+	events, err := sandbox.ParseContractEvents(tx)
+	if err != nil {
+		return nil
+	}
+	logs := make([]*ethLog, 0, len(events))
+	for _, event := range events {
+		topics, data := t.convertEvent(event)
+		logs = append(logs, &ethLog{
+			Address:          addressOrZero(toEthAddress(event.GetContract())),
+			Topics:           topics,
+			Data:             encodeBytes(data),
+			BlockNumber:      encodeUint(uint64(block.GetHeight())),
+			BlockHash:        encodeBytes(block.GetBlockid()),
+			TransactionHash:  encodeBytes(tx.GetTxid()),
+			TransactionIndex: encodeUint(uint64(index)),
+			LogIndex:         encodeUint(uint64(logIndex)),
+		})
+		logIndex++
+	}
+	return logs
+}
+
+// evm合约事件在链上保存为abi解码后的json数组，按abi重新编码为topics和data；
+// 其他合约的事件或无法编码时，topic0为事件名的keccak256，data为原始事件内容
+func (t *abiCache) convertEvent(event *protos.ContractEvent) ([]string, []byte) {
+	if spec := t.get(event.GetContract()); spec != nil {
+		if eventSpec, ok := spec.EventsByName[event.GetName()]; ok {
+			if topics, data, err := packEvent(eventSpec, event.GetBody()); err == nil {
+				return topics, data
+			}
+		}
+	}
+	id := abi.GetEventID(event.GetName())
+	return []string{encodeBytes(id.Bytes())}, event.GetBody()
+}
+
+func packEvent(eventSpec *abi.EventSpec, body []byte) ([]string, []byte, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(body, &raws); err != nil {
+		return nil, nil, err
+	}
+	if len(raws) != len(eventSpec.Inputs) {
+		return nil, nil, fmt.Errorf("event %s want %d values, got %d", eventSpec.Name,
+			len(eventSpec.Inputs), len(raws))
+	}
+
+	vals := abi.GetPackingTypes(eventSpec.Inputs)
+	for i, val := range vals {
+		// bytes类型在事件内容中保存为hex字符串
+		if _, ok := val.(*[]byte); ok {
+			var s string
+			if err := json.Unmarshal(raws[i], &s); err != nil {
+				return nil, nil, err
+			}
+			buf, err := hex.DecodeString(s)
+			if err != nil {
+				return nil, nil, err
+			}
+			vals[i] = buf
+			continue
+		}
+		// 数组类型不是指针，需要新建指针解码
+		ptr := reflect.ValueOf(val)
+		if ptr.Kind() != reflect.Ptr {
+			ptr = reflect.New(ptr.Type())
+		}
+		if err := json.Unmarshal(raws[i], ptr.Interface()); err != nil {
+			return nil, nil, err
+		}
+		// 编码时不接受指针，大整数以十进制字符串传入
+		if n, ok := ptr.Interface().(*big.Int); ok {
+			vals[i] = n.String()
+		} else {
+			vals[i] = ptr.Elem().Interface()
+		}
+	}
+
+	words, data, err := abi.PackEvent(eventSpec, vals...)
+	if err != nil {
+		return nil, nil, err
+	}
+	topics := make([]string, 0, len(words))
+	for _, word := range words {
+		topics = append(topics, encodeBytes(word.Bytes()))
+	}
+	return topics, data, nil
+}
@@ -0,0 +1,109 @@
+package ethrpc
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestDecodeBig(t *testing.T) {
+	cases := []struct {
+		s      string
+		ok     bool
+		expect int64
+	}{
+		{s: "0x0", ok: true},
+		{s: "0x1f", ok: true, expect: 31},
+		{s: "0X1F", ok: true, expect: 31},
+		{s: "0x00ff", ok: true, expect: 255},
+		{s: "0x"},
+		{s: "1f"},
+		{s: ""},
+		{s: "0xzz"},
+		{s: "0x-1"},
+	}
+	for _, c := range cases {
+		n, err := decodeBig(c.s)
+		if (err == nil) != c.ok {
+			t.Errorf("%q: expect ok %v, got %v", c.s, c.ok, err)
+			continue
+		}
+		if err == nil && n.Int64() != c.expect {
+			t.Errorf("%q: got %s, expect %d", c.s, n, c.expect)
+		}
+		if rerr, ok := err.(*rpcError); err != nil && (!ok || rerr.Code != codeInvalidParams) {
+			t.Errorf("%q: expect invalid params, got %v", c.s, err)
+		}
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	cases := []struct {
+		s      string
+		ok     bool
+		expect string
+	}{
+		{s: "0x", ok: true},
+		{s: "0x0aff", ok: true, expect: "\x0a\xff"},
+		{s: "0X0A", ok: true, expect: "\x0a"},
+		{s: "0x0"},
+		{s: "0aff"},
+		{s: "0xgg"},
+	}
+	for _, c := range cases {
+		b, err := decodeBytes(c.s)
+		if (err == nil) != c.ok {
+			t.Errorf("%q: expect ok %v, got %v", c.s, c.ok, err)
+			continue
+		}
+		if err == nil && string(b) != c.expect {
+			t.Errorf("%q: got %x", c.s, b)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	if s := encodeUint(0); s != "0x0" {
+		t.Errorf("encodeUint(0) = %s", s)
+	}
+	if s := encodeUint(255); s != "0xff" {
+		t.Errorf("encodeUint(255) = %s", s)
+	}
+	if s := encodeBig(big.NewInt(4096)); s != "0x1000" {
+		t.Errorf("encodeBig(4096) = %s", s)
+	}
+	if s := encodeBytes(nil); s != "0x" {
+		t.Errorf("encodeBytes(nil) = %s", s)
+	}
+	// 编码后可以解码回原值
+	n, err := decodeBig(encodeBig(big.NewInt(123456789)))
+	if err != nil || n.Int64() != 123456789 {
+		t.Errorf("round trip got %v %v", n, err)
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	valid := "0x" + strings.Repeat("ab", 20)
+	for _, s := range []string{valid, "0x" + strings.Repeat("AB", 20)} {
+		if _, err := parseAddress(s); err != nil {
+			t.Errorf("%s should be valid, got %v", s, err)
+		}
+	}
+	for _, s := range []string{valid[2:], valid[:40], valid + "00", "0x" + strings.Repeat("zz", 20)} {
+		if _, err := parseAddress(s); err == nil {
+			t.Errorf("%s should be invalid", s)
+		}
+	}
+
+	// 合约名和以太坊地址互转
+	addr := toEthAddress("counter")
+	if addr == "" || addr != strings.ToLower(addr) {
+		t.Fatalf("unexpected eth address %q", addr)
+	}
+	if name, err := toXchainAddress(addr); err != nil || name != "counter" {
+		t.Errorf("expect counter, got %s %v", name, err)
+	}
+	if toEthAddress("") != "" {
+		t.Error("empty address should not be converted")
+	}
+}
@@ -0,0 +1,181 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	"github.com/xuperchain/xuperos/common/sink"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	"github.com/xuperchain/xuperos/service/adapter/event"
+)
+
+const (
+	SubModName = "export"
+	// 订阅失败后的重试间隔
+	retryInterval = 3 * time.Second
+)
+
+// ExportServ 进程内事件导出服务，匹配filter的区块写入sink，重启后从游标继续导出
+type ExportServ struct {
+	scfg     *sconf.ServConf
+	log      logs.Logger
+	router   *event.Router
+	exporter *sink.Exporter
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	exitCh   chan struct{}
+	isInit   bool
+	exitOnce *sync.Once
+}
+
+func NewExportServ(scfg *sconf.ServConf, engine ecom.Engine,
+	pendingTxs *event.PendingTxFeed) (*ExportServ, error) {
+	if scfg == nil || engine == nil || pendingTxs == nil {
+		return nil, fmt.Errorf("param error")
+	}
+	conf := scfg.Export
+	if conf.Filter == nil || conf.Filter.Bcname == "" {
+		return nil, fmt.Errorf("export filter bcname unset")
+	}
+
+	log, _ := loglevel.NewLogger("", SubModName)
+	envCfg := engine.Context().EnvCfg
+	// 相对路径的导出目录基于根目录，游标文件基于数据目录
+	sinkConf := conf.Sink
+	if sinkConf.Dir != "" && !filepath.IsAbs(sinkConf.Dir) {
+		sinkConf.Dir = envCfg.GenDirAbsPath(sinkConf.Dir)
+	}
+	cursorFile := conf.CursorFile
+	if cursorFile != "" && !filepath.IsAbs(cursorFile) {
+		cursorFile = envCfg.GenDataAbsPath(cursorFile)
+	}
+
+	snk, err := sink.NewSink(&sinkConf)
+	if err != nil {
+		return nil, fmt.Errorf("create export sink failed.err:%v", err)
+	}
+	exporter, err := sink.NewExporter(snk, cursorFile, conf.SkipEmpty)
+	if err != nil {
+		snk.Close()
+		return nil, fmt.Errorf("load export cursor failed.err:%v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	obj := &ExportServ{
+		scfg:     scfg,
+		log:      log,
+		router:   event.NewRouter(engine, pendingTxs),
+		exporter: exporter,
+		ctx:      ctx,
+		cancel:   cancel,
+		exitCh:   make(chan struct{}),
+		isInit:   true,
+		exitOnce: &sync.Once{},
+	}
+	return obj, nil
+}
+
+// 启动导出服务，阻塞直到退出
+func (t *ExportServ) Run() error {
+	if !t.isInit {
+		return errors.New("export server not init")
+	}
+	defer close(t.exitCh)
+	t.log.Trace("export server started", "bcname", t.scfg.Export.Filter.Bcname,
+		"sink", t.scfg.Export.Sink.Type)
+
+	for t.ctx.Err() == nil {
+		err := t.export()
+		if t.ctx.Err() != nil {
+			break
+		}
+		t.log.Warn("export events failed", "cursor", t.exporter.Cursor(), "err", err)
+		select {
+		case <-t.ctx.Done():
+		case <-time.After(retryInterval):
+		}
+	}
+
+	if err := t.exporter.Close(); err != nil {
+		t.log.Warn("close export sink failed", "err", err)
+	}
+	t.log.Trace("export server exit")
+	return nil
+}
+
+// 退出导出服务，需要幂等
+func (t *ExportServ) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		t.cancel()
+		// 等待当前文件完成滚动
+		select {
+		case <-t.exitCh:
+		case <-time.After(retryInterval):
+		}
+	})
+}
+
+func (t *ExportServ) export() error {
+	filter := t.scfg.Export.Filter
+	blockFilter := &pb.BlockFilter{
+		Bcname: filter.Bcname,
+		Range: &pb.BlockRange{
+			Start: filter.Start,
+		},
+		Contract:    filter.Contract,
+		EventName:   filter.EventName,
+		Initiator:   filter.Initiator,
+		AuthRequire: filter.AuthRequire,
+		FromAddr:    filter.FromAddr,
+		ToAddr:      filter.ToAddr,
+	}
+	buf, err := proto.Marshal(blockFilter)
+	if err != nil {
+		return err
+	}
+
+	req := &pb.SubscribeRequest{
+		Type:        pb.SubscribeType_BLOCK,
+		Filter:      buf,
+		StartCursor: t.exporter.Cursor(),
+	}
+	iter, err := t.router.Subscribe(req)
+	if err != nil {
+		return err
+	}
+	// 迭代器可能阻塞等待新区块，服务退出时关闭
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-t.ctx.Done():
+		case <-done:
+		}
+		iter.Close()
+	}()
+
+	for iter.Next() {
+		if err := t.exporter.Export(iter.Data().(*pb.Event)); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return err
+	}
+	return errors.New("subscription closed")
+}
@@ -2,15 +2,27 @@ package service
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/xuperchain/xupercore/kernel/engines"
+	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
 	"github.com/xuperchain/xupercore/lib/logs"
 
 	sconf "github.com/xuperchain/xuperos/common/config"
 	def "github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	"github.com/xuperchain/xuperos/common/metrics"
+	"github.com/xuperchain/xuperos/models"
+	"github.com/xuperchain/xuperos/service/adapter/event"
 	adpgw "github.com/xuperchain/xuperos/service/adapter/gateway"
 	adprpc "github.com/xuperchain/xuperos/service/adapter/rpc"
+	"github.com/xuperchain/xuperos/service/admin"
+	scom "github.com/xuperchain/xuperos/service/common"
+	"github.com/xuperchain/xuperos/service/ethrpc"
+	"github.com/xuperchain/xuperos/service/export"
+	"github.com/xuperchain/xuperos/service/metric"
 	"github.com/xuperchain/xuperos/service/rpc"
+	"github.com/xuperchain/xuperos/service/webhook"
 )
 
 // 由于需要同时启动多个服务组件，采用注册机制管理
@@ -31,27 +43,52 @@ func NewServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*ServMG, error) {
 		return nil, fmt.Errorf("param error")
 	}
 
-	log, _ := logs.NewLogger("", def.SubModName)
+	log, _ := loglevel.NewLogger("", def.SubModName)
 	obj := &ServMG{
 		scfg:    scfg,
 		log:     log,
 		servers: make([]ServCom, 0),
 	}
 
+	// 各rpc服务共享排空控制
+	drainer := scom.NewDrainer()
+	// 各服务通过链管理访问引擎，屏蔽运行时卸载的链
+	xosEngine, err := xuperos.EngineConvert(engine)
+	if err != nil {
+		return nil, fmt.Errorf("not xuperos engine")
+	}
+	chainMG := scom.NewChainManager(xosEngine, log)
+	// 已确认区块和交易的查询缓存，各服务共享
+	ledgerCache := models.NewLedgerCache(scfg.LedgerCache.BlockSize, scfg.LedgerCache.TxSize)
+	// 交易和区块速率统计，各服务提交的交易都计入
+	speeds := metrics.NewSpeeds(time.Duration(scfg.SpeedWindow) * time.Second)
+	// 交易进入交易池的事件源，各服务的订阅共享
+	pendingTxs := event.NewPendingTxFeed(chainMG)
+
 	// 实例化rpc服务
-	rpcServ, err := rpc.NewRpcServMG(scfg, engine)
+	rpcServ, err := rpc.NewRpcServMG(scfg, chainMG, drainer)
 	if err != nil {
 		return nil, err
 	}
 	obj.servers = append(obj.servers, rpcServ)
 
+	// 实例化metric服务
+	if scfg.EnableMetric {
+		metricServ, err := metric.NewMetricServ(scfg)
+		if err != nil {
+			return nil, err
+		}
+		obj.servers = append(obj.servers, metricServ)
+	}
+
 	// 实例化老版本接口适配服务
 	if scfg.EnableAdapter {
-		adpServ, err := adprpc.NewRpcServMG(scfg, engine)
+		adpServ, err := adprpc.NewRpcServMG(scfg, chainMG, drainer, ledgerCache, speeds, pendingTxs)
 		if err != nil {
 			return nil, err
 		}
-		adpGW, err := adpgw.NewGateway(scfg)
+		envCfg := xosEngine.Context().EnvCfg
+		adpGW, err := adpgw.NewGateway(scfg, envCfg.GenDataAbsPath(envCfg.TlsDir))
 		if err != nil {
 			return nil, err
 		}
@@ -59,6 +96,43 @@ func NewServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*ServMG, error) {
 		obj.servers = append(obj.servers, adpServ, adpGW)
 	}
 
+	// 实例化以太坊JSON-RPC兼容服务
+	if scfg.EnableEthRpc {
+		ethServ, err := ethrpc.NewEthRpcServ(scfg, chainMG, drainer, ledgerCache, speeds, pendingTxs)
+		if err != nil {
+			return nil, err
+		}
+		obj.servers = append(obj.servers, ethServ)
+	}
+
+	// 实例化webhook推送服务
+	var webhookServ *webhook.WebhookServ
+	if scfg.EnableWebhook {
+		webhookServ, err = webhook.NewWebhookServ(scfg, chainMG, pendingTxs)
+		if err != nil {
+			return nil, err
+		}
+		obj.servers = append(obj.servers, webhookServ)
+	}
+
+	// 实例化事件导出服务
+	if scfg.EnableExport {
+		exportServ, err := export.NewExportServ(scfg, chainMG, pendingTxs)
+		if err != nil {
+			return nil, err
+		}
+		obj.servers = append(obj.servers, exportServ)
+	}
+
+	// 实例化运维管理服务
+	if scfg.EnableAdmin {
+		adminServ, err := admin.NewAdminServMG(scfg, chainMG, drainer, webhookServ)
+		if err != nil {
+			return nil, err
+		}
+		obj.servers = append(obj.servers, adminServ)
+	}
+
 	return obj, nil
 }
 
@@ -0,0 +1,75 @@
+package metric
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/loglevel"
+)
+
+// prometheus指标服务
+type MetricServ struct {
+	scfg     *sconf.ServConf
+	log      logs.Logger
+	server   *http.Server
+	isInit   bool
+	exitOnce *sync.Once
+}
+
+func NewMetricServ(scfg *sconf.ServConf) (*MetricServ, error) {
+	if scfg == nil {
+		return nil, fmt.Errorf("param error")
+	}
+
+	log, _ := loglevel.NewLogger("", "metric")
+	obj := &MetricServ{
+		scfg:     scfg,
+		log:      log,
+		isInit:   true,
+		exitOnce: &sync.Once{},
+	}
+
+	return obj, nil
+}
+
+// 启动metric服务，阻塞直到退出
+func (t *MetricServ) Run() error {
+	if !t.isInit {
+		return errors.New("metric server not init")
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	t.server = &http.Server{
+		Addr:    fmt.Sprintf(":%d", t.scfg.MetricPort),
+		Handler: mux,
+	}
+	err := t.server.ListenAndServe()
+	if err != http.ErrServerClosed {
+		t.log.Error("metric server abnormal exit", "err", err)
+		return err
+	}
+
+	t.log.Trace("metric server exit")
+	return nil
+}
+
+// 退出metric服务，需要幂等
+func (t *MetricServ) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		if t.server != nil {
+			t.server.Shutdown(context.Background())
+		}
+	})
+}
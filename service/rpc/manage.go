@@ -8,7 +8,9 @@ import (
 
 	sconf "github.com/xuperchain/xuperos/common/config"
 	"github.com/xuperchain/xuperos/common/def"
+	"github.com/xuperchain/xuperos/common/loglevel"
 	pb "github.com/xuperchain/xuperos/common/xupospb"
+	scom "github.com/xuperchain/xuperos/service/common"
 
 	"github.com/xuperchain/xupercore/kernel/engines"
 	"github.com/xuperchain/xupercore/kernel/engines/xuperos"
@@ -17,6 +19,9 @@ import (
 
 	middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	// 注册gzip压缩，客户端请求压缩时响应使用相同算法
+	_ "google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -32,21 +37,24 @@ type RpcServMG struct {
 	exitOnce  *sync.Once
 }
 
-func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine) (*RpcServMG, error) {
-	if scfg == nil || engine == nil {
+func NewRpcServMG(scfg *sconf.ServConf, engine engines.BCEngine, drainer *scom.Drainer) (*RpcServMG, error) {
+	if scfg == nil || engine == nil || drainer == nil {
 		return nil, fmt.Errorf("param error")
 	}
 	xosEngine, err := xuperos.EngineConvert(engine)
 	if err != nil {
 		return nil, fmt.Errorf("not xuperos engine")
 	}
+	if scfg.RpcTlsPort > 0 && scfg.RpcTlsPort == scfg.RpcPort {
+		return nil, fmt.Errorf("rpc tls port conflicts with rpc port")
+	}
 
-	log, _ := logs.NewLogger("", def.SubModName)
+	log, _ := loglevel.NewLogger("", def.SubModName)
 	obj := &RpcServMG{
 		scfg:     scfg,
 		engine:   xosEngine,
 		log:      log,
-		rpcServ:  NewRpcServ(engine.(ecom.Engine), log),
+		rpcServ:  NewRpcServ(engine.(ecom.Engine), log, drainer),
 		isInit:   true,
 		exitOnce: &sync.Once{},
 	}
@@ -83,6 +91,7 @@ func (t *RpcServMG) Exit() {
 }
 
 // 启动rpc服务，阻塞直到退出
+// 开启rpcTlsPort时同时提供明文和tls端口，任一端口异常退出时返回
 func (t *RpcServMG) runRpcServ() error {
 	rpcOptions := make([]grpc.ServerOption, 0)
 	unaryInterceptors := make([]grpc.UnaryServerInterceptor, 0)
@@ -96,18 +105,46 @@ func (t *RpcServMG) runRpcServ() error {
 		grpc.WriteBufferSize(t.scfg.WriteBufSize),
 	)
 
-	t.servHD = grpc.NewServer(rpcOptions...)
-	pb.RegisterXuperOSServer(t.servHD, t.rpcServ)
+	t.servHD = t.newServer(rpcOptions)
+	servers := map[int]*grpc.Server{
+		t.scfg.RpcPort: t.servHD,
+	}
+	if t.scfg.RpcTlsPort > 0 {
+		envConf := t.engine.Context().EnvCfg
+		loader, err := scom.NewTlsLoader(t.scfg.Tls, envConf.GenDataAbsPath(envConf.TlsDir),
+			t.scfg.TlsServerName, t.log)
+		if err != nil {
+			t.log.Error("load tls config failed", "err", err.Error())
+			return err
+		}
+		creds := credentials.NewTLS(loader.ServerConfig())
+		t.tlsServHD = t.newServer(append(rpcOptions, grpc.Creds(creds)))
+		servers[t.scfg.RpcTlsPort] = t.tlsServHD
+	}
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", t.scfg.RpcPort))
-	if err != nil {
-		t.log.Error("failed to listen", "err", err.Error())
-		return fmt.Errorf("failed to listen")
+	errCh := make(chan error, len(servers))
+	for port, server := range servers {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if err != nil {
+			t.log.Error("failed to listen", "port", port, "err", err.Error())
+			t.stopRpcServ()
+			return fmt.Errorf("failed to listen")
+		}
+		go func(server *grpc.Server, lis net.Listener) {
+			errCh <- server.Serve(lis)
+		}(server, lis)
 	}
 
-	reflection.Register(t.servHD)
-	if err := t.servHD.Serve(lis); err != nil {
-		t.log.Error("failed to serve", "err", err.Error())
+	// 一个端口退出时关闭其他端口
+	var err error
+	for range servers {
+		if e := <-errCh; e != nil && err == nil {
+			t.log.Error("failed to serve", "err", e.Error())
+			err = e
+		}
+		t.stopRpcServ()
+	}
+	if err != nil {
 		return err
 	}
 
@@ -115,10 +152,20 @@ func (t *RpcServMG) runRpcServ() error {
 	return nil
 }
 
+func (t *RpcServMG) newServer(rpcOptions []grpc.ServerOption) *grpc.Server {
+	servHD := grpc.NewServer(rpcOptions...)
+	pb.RegisterXuperOSServer(servHD, t.rpcServ)
+	reflection.Register(servHD)
+	return servHD
+}
+
 // 需要幂等
 func (t *RpcServMG) stopRpcServ() {
+	// 优雅关闭grpc server
 	if t.servHD != nil {
-		// 优雅关闭grpc server
 		t.servHD.GracefulStop()
 	}
+	if t.tlsServHD != nil {
+		t.tlsServHD.GracefulStop()
+	}
 }
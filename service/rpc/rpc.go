@@ -13,20 +13,23 @@ import (
 	"github.com/xuperchain/xupercore/lib/logs"
 	"github.com/xuperchain/xupercore/lib/utils"
 	sctx "github.com/xuperchain/xuperos/common/context"
+	scom "github.com/xuperchain/xuperos/service/common"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 )
 
 type RpcServ struct {
-	engine ecom.Engine
-	log    logs.Logger
+	engine  ecom.Engine
+	log     logs.Logger
+	drainer *scom.Drainer
 }
 
-func NewRpcServ(engine ecom.Engine, log logs.Logger) *RpcServ {
+func NewRpcServ(engine ecom.Engine, log logs.Logger, drainer *scom.Drainer) *RpcServ {
 	return &RpcServ{
-		engine: engine,
-		log:    log,
+		engine:  engine,
+		log:     log,
+		drainer: drainer,
 	}
 }
 
@@ -35,6 +38,10 @@ func (t *RpcServ) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 
+		// 统计处理中的请求，用于排空
+		t.drainer.Acquire()
+		defer t.drainer.Release()
+
 		// panic recover
 		defer func() {
 			if e := recover(); e != nil {
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// 推送请求header
+const (
+	HeaderWebhook   = "X-Xuper-Webhook"
+	HeaderTimestamp = "X-Xuper-Timestamp"
+	HeaderSignature = "X-Xuper-Signature"
+)
+
+// Sign 计算推送签名：hex(hmac-sha256(secret, timestamp + "." + body))
+// 接收方用相同方式计算并比较，同时检查时间戳防止重放
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliverer 发送单次推送请求，2xx视为成功
+type deliverer struct {
+	client *http.Client
+}
+
+func newDeliverer(timeout time.Duration) *deliverer {
+	return &deliverer{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (t *deliverer) post(ctx context.Context, name, url, secret string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhook, name)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if secret != "" {
+		req.Header.Set(HeaderSignature, Sign(secret, timestamp, body))
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// 读完响应以复用连接
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected http status %d", resp.StatusCode)
+	}
+	return nil
+}
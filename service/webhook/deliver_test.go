@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestDeliverSignature(t *testing.T) {
+	body := []byte(`{"webhook":"test"}`)
+	var gotSign, gotName string
+	var gotTimestamp int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		buf, _ := ioutil.ReadAll(r.Body)
+		if string(buf) != string(body) {
+			t.Errorf("unexpected body %s", buf)
+		}
+		gotName = r.Header.Get(HeaderWebhook)
+		gotSign = r.Header.Get(HeaderSignature)
+		gotTimestamp, _ = strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+	}))
+	defer server.Close()
+
+	d := newDeliverer(time.Second)
+	if err := d.post(context.Background(), "test", server.URL, "secret", body); err != nil {
+		t.Fatal(err)
+	}
+	if gotName != "test" {
+		t.Errorf("unexpected webhook header %s", gotName)
+	}
+	if gotSign != Sign("secret", gotTimestamp, body) {
+		t.Errorf("signature mismatch %s", gotSign)
+	}
+}
+
+func TestDeliverStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	d := newDeliverer(time.Second)
+	if err := d.post(context.Background(), "test", server.URL, "", []byte("{}")); err == nil {
+		t.Fatal("expect error for non-2xx status")
+	}
+}
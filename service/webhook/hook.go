@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/metrics"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+	acom "github.com/xuperchain/xuperos/service/adapter/common"
+)
+
+// 首次重试间隔
+var minBackoff = time.Second
+
+// Payload 推送的json body，区块格式与xchain-cli watch一致
+type Payload struct {
+	Webhook string              `json:"webhook"`
+	Cursor  *pb.EventCursor     `json:"cursor"`
+	Block   *acom.FilteredBlock `json:"block"`
+}
+
+// Status 订阅推送状态
+type Status struct {
+	Conf      *sconf.WebhookConf
+	Source    string
+	Cursor    *pb.EventCursor
+	Delivered int64
+	Retries   int64
+	LastError string
+}
+
+// hook 单个订阅的推送循环，推送成功后才前进游标
+type hook struct {
+	serv *WebhookServ
+	rec  *record
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mutex     sync.Mutex
+	delivered int64
+	retries   int64
+	lastErr   string
+}
+
+func newHook(serv *WebhookServ, rec *record) *hook {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &hook{
+		serv:   serv,
+		rec:    rec,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+func (t *hook) start() {
+	go t.run()
+}
+
+func (t *hook) stop() {
+	t.cancel()
+}
+
+// 订阅失败（如链未加载）时按退避间隔重新订阅
+func (t *hook) run() {
+	backoff := minBackoff
+	for t.ctx.Err() == nil {
+		err := t.subscribe()
+		if err == nil || t.ctx.Err() != nil {
+			return
+		}
+		t.setError(err)
+		t.serv.log.Warn("webhook subscribe failed", "webhook", t.rec.Conf.Name, "err", err)
+		if !t.sleep(backoff) {
+			return
+		}
+		backoff = t.nextBackoff(backoff)
+	}
+}
+
+func (t *hook) subscribe() error {
+	filter := t.rec.Conf.Filter
+	blockFilter := &pb.BlockFilter{
+		Bcname: filter.Bcname,
+		Range: &pb.BlockRange{
+			Start: filter.Start,
+		},
+		Contract:    filter.Contract,
+		EventName:   filter.EventName,
+		Initiator:   filter.Initiator,
+		AuthRequire: filter.AuthRequire,
+		FromAddr:    filter.FromAddr,
+		ToAddr:      filter.ToAddr,
+	}
+	buf, err := proto.Marshal(blockFilter)
+	if err != nil {
+		return err
+	}
+
+	req := &pb.SubscribeRequest{
+		Type:        pb.SubscribeType_BLOCK,
+		Filter:      buf,
+		StartCursor: t.cursor(),
+	}
+	iter, err := t.serv.router.Subscribe(req)
+	if err != nil {
+		return err
+	}
+	// 迭代器可能阻塞等待新区块，订阅停止时关闭
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-t.ctx.Done():
+			iter.Close()
+		case <-done:
+			iter.Close()
+		}
+	}()
+
+	for iter.Next() {
+		ev := iter.Data().(*pb.Event)
+		block := new(pb.FilteredBlock)
+		if err := proto.Unmarshal(ev.GetPayload(), block); err != nil {
+			return err
+		}
+		// 没有匹配交易的区块不推送，游标在下次推送成功时一并前进
+		if len(block.GetTxs()) == 0 {
+			continue
+		}
+		if !t.deliver(ev.GetCursor(), block) {
+			return nil
+		}
+	}
+	return iter.Error()
+}
+
+// 推送直到成功或订阅停止，返回false表示订阅已停止
+func (t *hook) deliver(cursor *pb.EventCursor, block *pb.FilteredBlock) bool {
+	conf := t.rec.Conf
+	body, _ := json.Marshal(&Payload{
+		Webhook: conf.Name,
+		Cursor:  cursor,
+		Block:   acom.FromFilteredBlockPB(block),
+	})
+
+	backoff := minBackoff
+	for {
+		err := t.serv.deliverer.post(t.ctx, conf.Name, conf.Url, conf.Secret, body)
+		if err == nil {
+			break
+		}
+		if t.ctx.Err() != nil {
+			return false
+		}
+		metrics.WebhookCounter.WithLabelValues(conf.Name, "failed").Inc()
+		t.mutex.Lock()
+		t.retries++
+		t.lastErr = err.Error()
+		t.mutex.Unlock()
+		t.serv.log.Warn("webhook delivery failed", "webhook", conf.Name, "height", cursor.GetBlockHeight(),
+			"retry_after", backoff, "err", err)
+		if !t.sleep(backoff) {
+			return false
+		}
+		backoff = t.nextBackoff(backoff)
+	}
+
+	metrics.WebhookCounter.WithLabelValues(conf.Name, "delivered").Inc()
+	t.mutex.Lock()
+	t.rec.Cursor = cursor
+	t.delivered++
+	t.lastErr = ""
+	t.mutex.Unlock()
+	if err := t.serv.save(t.rec); err != nil {
+		t.serv.log.Warn("save webhook cursor failed", "webhook", conf.Name, "err", err)
+	}
+	return true
+}
+
+func (t *hook) sleep(d time.Duration) bool {
+	select {
+	case <-t.ctx.Done():
+		return false
+	case <-time.After(d):
+		return true
+	}
+}
+
+func (t *hook) nextBackoff(backoff time.Duration) time.Duration {
+	backoff *= 2
+	if backoff > t.serv.maxBackoff {
+		backoff = t.serv.maxBackoff
+	}
+	return backoff
+}
+
+func (t *hook) cursor() *pb.EventCursor {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.rec.Cursor
+}
+
+func (t *hook) setError(err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.lastErr = err.Error()
+}
+
+func (t *hook) status() *Status {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return &Status{
+		Conf:      t.rec.Conf,
+		Source:    t.rec.Source,
+		Cursor:    t.rec.Cursor,
+		Delivered: t.delivered,
+		Retries:   t.retries,
+		LastError: t.lastErr,
+	}
+}
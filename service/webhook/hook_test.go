@@ -0,0 +1,213 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+type nopLogger struct{}
+
+func (nopLogger) GetLogId() string                           { return "test" }
+func (nopLogger) SetCommField(key string, value interface{}) {}
+func (nopLogger) SetInfoField(key string, value interface{}) {}
+func (nopLogger) Error(msg string, ctx ...interface{})       {}
+func (nopLogger) Warn(msg string, ctx ...interface{})        {}
+func (nopLogger) Info(msg string, ctx ...interface{})        {}
+func (nopLogger) Trace(msg string, ctx ...interface{})       {}
+func (nopLogger) Debug(msg string, ctx ...interface{})       {}
+
+func init() {
+	minBackoff = 10 * time.Millisecond
+}
+
+// 不依赖引擎的服务，只用于推送和游标持久化
+func newTestServ(t *testing.T, dir string, confs ...*sconf.WebhookConf) *WebhookServ {
+	serv := &WebhookServ{
+		scfg:       &sconf.ServConf{Webhooks: confs},
+		log:        nopLogger{},
+		deliverer:  newDeliverer(time.Second),
+		maxBackoff: 40 * time.Millisecond,
+		dataDir:    dir,
+		hooks:      make(map[string]*hook),
+	}
+	if err := serv.loadHooks(); err != nil {
+		t.Fatal(err)
+	}
+	return serv
+}
+
+func testConf(url string) *sconf.WebhookConf {
+	return &sconf.WebhookConf{
+		Name:   "counter",
+		Url:    url,
+		Secret: "secret",
+		Filter: &sconf.EventFilter{Bcname: "xuper", Contract: "counter"},
+	}
+}
+
+func testBlock() *pb.FilteredBlock {
+	return &pb.FilteredBlock{
+		Bcname:      "xuper",
+		BlockHeight: 10,
+		Txs: []*pb.FilteredTransaction{
+			{Txid: "t1", Events: []*pb.ContractEvent{{Contract: "counter", Name: "increase", Body: []byte("1")}}},
+		},
+	}
+}
+
+func TestHookDeliverRetry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var mutex sync.Mutex
+	var times []time.Time
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		times = append(times, time.Now())
+		// 前3次失败
+		if len(times) <= 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	serv := newTestServ(t, dir, testConf(server.URL))
+	h := serv.hooks["counter"]
+	cursor := &pb.EventCursor{BlockHeight: 10, EventIndex: 1}
+	if !h.deliver(cursor, testBlock()) {
+		t.Fatal("delivery should succeed after retries")
+	}
+
+	if len(times) != 4 {
+		t.Fatalf("expect 4 requests, got %d", len(times))
+	}
+	// 退避间隔10ms、20ms、40ms
+	for i, min := range []time.Duration{10, 20, 40} {
+		if d := times[i+1].Sub(times[i]); d < min*time.Millisecond {
+			t.Errorf("retry %d after %v, expect at least %vms", i+1, d, min)
+		}
+	}
+	status := h.status()
+	if status.Retries != 3 || status.Delivered != 1 || status.LastError != "" || status.Cursor != cursor {
+		t.Errorf("unexpected status %+v", status)
+	}
+
+	var payload struct {
+		Webhook string
+		Cursor  *pb.EventCursor
+		Block   struct {
+			BlockHeight int64 `json:"block_height"`
+			Txs         []struct {
+				Events []struct{ Body string }
+			}
+		}
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		t.Fatal(err)
+	}
+	if payload.Webhook != "counter" || payload.Cursor.GetEventIndex() != 1 || payload.Block.BlockHeight != 10 ||
+		payload.Block.Txs[0].Events[0].Body != "1" {
+		t.Errorf("unexpected payload %s", body)
+	}
+}
+
+func TestHookNextBackoff(t *testing.T) {
+	h := &hook{serv: &WebhookServ{maxBackoff: 300 * time.Second}}
+	backoff := time.Second
+	for _, expect := range []time.Duration{2, 4, 8, 16, 32, 64, 128, 256, 300, 300} {
+		backoff = h.nextBackoff(backoff)
+		if backoff != expect*time.Second {
+			t.Fatalf("backoff %v, expect %vs", backoff, expect)
+		}
+	}
+}
+
+func TestHookStopWhileRetrying(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	serv := newTestServ(t, dir, testConf(server.URL))
+	h := serv.hooks["counter"]
+	time.AfterFunc(100*time.Millisecond, h.stop)
+	if h.deliver(&pb.EventCursor{BlockHeight: 10}, testBlock()) {
+		t.Fatal("delivery should stop with the hook")
+	}
+	status := h.status()
+	if status.Cursor != nil || status.Retries == 0 || status.LastError == "" {
+		t.Errorf("unexpected status %+v", status)
+	}
+}
+
+func TestHookCursorRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	conf := testConf(server.URL)
+	serv := newTestServ(t, dir, conf)
+	admin := testConf(server.URL)
+	admin.Name = "admin"
+	if err := serv.Add(admin); err != nil {
+		t.Fatal(err)
+	}
+	if !serv.hooks["counter"].deliver(&pb.EventCursor{BlockHeight: 10, TxIndex: 2}, testBlock()) {
+		t.Fatal("delivery failed")
+	}
+
+	// 重启后从游标继续
+	serv = newTestServ(t, dir, conf)
+	cursor := serv.hooks["counter"].cursor()
+	if cursor.GetBlockHeight() != 10 || cursor.GetTxIndex() != 2 {
+		t.Errorf("cursor should be kept across restart, got %v", cursor)
+	}
+	if h, ok := serv.hooks["admin"]; !ok || h.rec.Source != SourceAdmin {
+		t.Error("webhook added by admin should be kept across restart")
+	}
+
+	// 过滤条件变化后重新开始
+	changed := testConf(server.URL)
+	changed.Filter.EventName = "increase"
+	serv = newTestServ(t, dir, changed)
+	if cursor := serv.hooks["counter"].cursor(); cursor != nil {
+		t.Errorf("cursor should be reset when filter changed, got %v", cursor)
+	}
+
+	// 配置中删除的订阅同时删除记录
+	serv = newTestServ(t, dir)
+	if _, ok := serv.hooks["counter"]; ok {
+		t.Error("webhook removed from config should be dropped")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "counter"+recordFileExt)); !os.IsNotExist(err) {
+		t.Errorf("record of removed webhook should be deleted, got %v", err)
+	}
+}
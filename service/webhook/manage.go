@@ -0,0 +1,260 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	ecom "github.com/xuperchain/xupercore/kernel/engines/xuperos/common"
+	"github.com/xuperchain/xupercore/lib/logs"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/loglevel"
+	"github.com/xuperchain/xuperos/service/adapter/event"
+)
+
+const (
+	SubModName = "webhook"
+	// 订阅记录目录，相对数据目录
+	dataDirName = "webhook"
+)
+
+// 订阅名只允许字母、数字、下划线和中划线
+var nameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// WebhookServ 合约事件webhook推送服务
+// 订阅来自配置文件或admin接口，定义和推送游标持久化在数据目录下，重启后从游标继续推送
+type WebhookServ struct {
+	scfg       *sconf.ServConf
+	log        logs.Logger
+	router     *event.Router
+	deliverer  *deliverer
+	maxBackoff time.Duration
+	dataDir    string
+
+	mutex    sync.Mutex
+	hooks    map[string]*hook
+	running  bool
+	exitCh   chan struct{}
+	isInit   bool
+	exitOnce *sync.Once
+}
+
+func NewWebhookServ(scfg *sconf.ServConf, engine ecom.Engine,
+	pendingTxs *event.PendingTxFeed) (*WebhookServ, error) {
+	if scfg == nil || engine == nil || pendingTxs == nil {
+		return nil, fmt.Errorf("param error")
+	}
+
+	log, _ := loglevel.NewLogger("", SubModName)
+	envCfg := engine.Context().EnvCfg
+	obj := &WebhookServ{
+		scfg:       scfg,
+		log:        log,
+		router:     event.NewRouter(engine, pendingTxs),
+		deliverer:  newDeliverer(time.Duration(scfg.WebhookTimeout) * time.Second),
+		maxBackoff: time.Duration(scfg.WebhookMaxBackoff) * time.Second,
+		dataDir:    envCfg.GenDataAbsPath(dataDirName),
+		hooks:      make(map[string]*hook),
+		exitCh:     make(chan struct{}),
+		isInit:     true,
+		exitOnce:   &sync.Once{},
+	}
+	if obj.maxBackoff < minBackoff {
+		obj.maxBackoff = minBackoff
+	}
+
+	if err := obj.loadHooks(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// 合并配置文件和持久化的订阅，配置文件中删除的订阅同时删除其记录
+func (t *WebhookServ) loadHooks() error {
+	if err := os.MkdirAll(t.dataDir, 0755); err != nil {
+		return fmt.Errorf("create webhook data dir failed.err:%v", err)
+	}
+	records, err := loadRecords(t.dataDir)
+	if err != nil {
+		return fmt.Errorf("load webhook records failed.err:%v", err)
+	}
+
+	for _, conf := range t.scfg.Webhooks {
+		if err := validateConf(conf); err != nil {
+			return fmt.Errorf("webhook config error.err:%v", err)
+		}
+		if _, ok := t.hooks[conf.Name]; ok {
+			return fmt.Errorf("duplicate webhook %s", conf.Name)
+		}
+		rec := &record{
+			Conf:   conf,
+			Source: SourceConfig,
+		}
+		// 过滤条件未变时保留游标
+		if old, ok := records[conf.Name]; ok && sameFilter(old.Conf.Filter, conf.Filter) {
+			rec.Cursor = old.Cursor
+		}
+		if err := saveRecord(t.dataDir, rec); err != nil {
+			return err
+		}
+		t.hooks[conf.Name] = newHook(t, rec)
+	}
+
+	for name, rec := range records {
+		if _, ok := t.hooks[name]; ok {
+			continue
+		}
+		if rec.Source == SourceConfig {
+			removeRecord(t.dataDir, name)
+			continue
+		}
+		t.hooks[name] = newHook(t, rec)
+	}
+	return nil
+}
+
+// 启动webhook服务，阻塞直到退出
+func (t *WebhookServ) Run() error {
+	if !t.isInit {
+		return errors.New("webhook server not init")
+	}
+
+	t.mutex.Lock()
+	for _, h := range t.hooks {
+		h.start()
+	}
+	t.running = true
+	t.mutex.Unlock()
+	t.log.Trace("webhook server started", "webhooks", len(t.hooks))
+
+	<-t.exitCh
+	t.log.Trace("webhook server exit")
+	return nil
+}
+
+// 退出webhook服务，需要幂等
+func (t *WebhookServ) Exit() {
+	if !t.isInit {
+		return
+	}
+
+	t.exitOnce.Do(func() {
+		t.mutex.Lock()
+		for _, h := range t.hooks {
+			h.stop()
+		}
+		t.running = false
+		t.mutex.Unlock()
+		close(t.exitCh)
+	})
+}
+
+// Add 运行时添加订阅
+func (t *WebhookServ) Add(conf *sconf.WebhookConf) error {
+	if err := validateConf(conf); err != nil {
+		return ecom.ErrParameter.More("%v", err)
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if _, ok := t.hooks[conf.Name]; ok {
+		return ecom.ErrForbidden.More("webhook %s already exists", conf.Name)
+	}
+
+	rec := &record{
+		Conf:   conf,
+		Source: SourceAdmin,
+	}
+	if err := saveRecord(t.dataDir, rec); err != nil {
+		return ecom.ErrInternal.More("%v", err)
+	}
+	h := newHook(t, rec)
+	t.hooks[conf.Name] = h
+	if t.running {
+		h.start()
+	}
+	return nil
+}
+
+// Remove 删除运行时添加的订阅，配置文件中的订阅需要修改配置
+func (t *WebhookServ) Remove(name string) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	h, ok := t.hooks[name]
+	if !ok {
+		return ecom.ErrParameter.More("webhook %s not exist", name)
+	}
+	if h.rec.Source == SourceConfig {
+		return ecom.ErrForbidden.More("webhook %s is defined in config", name)
+	}
+
+	h.stop()
+	delete(t.hooks, name)
+	if err := removeRecord(t.dataDir, name); err != nil {
+		return ecom.ErrInternal.More("%v", err)
+	}
+	return nil
+}
+
+// List 查询全部订阅的推送状态
+func (t *WebhookServ) List() []*Status {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	list := make([]*Status, 0, len(t.hooks))
+	for _, h := range t.hooks {
+		list = append(list, h.status())
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Conf.Name < list[j].Conf.Name
+	})
+	return list
+}
+
+// 持久化推送游标
+func (t *WebhookServ) save(rec *record) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	// 已删除的订阅不再写入
+	if h, ok := t.hooks[rec.Conf.Name]; !ok || h.rec != rec {
+		return nil
+	}
+	return saveRecord(t.dataDir, rec)
+}
+
+func validateConf(conf *sconf.WebhookConf) error {
+	if conf == nil {
+		return errors.New("webhook unset")
+	}
+	if !nameRegexp.MatchString(conf.Name) {
+		return fmt.Errorf("invalid webhook name %q", conf.Name)
+	}
+	u, err := url.Parse(conf.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid webhook url %q", conf.Url)
+	}
+	if conf.Filter == nil || conf.Filter.Bcname == "" {
+		return errors.New("webhook filter bcname unset")
+	}
+	for _, re := range []string{conf.Filter.Contract, conf.Filter.EventName, conf.Filter.Initiator,
+		conf.Filter.AuthRequire, conf.Filter.FromAddr, conf.Filter.ToAddr} {
+		if _, err := regexp.Compile(re); err != nil {
+			return fmt.Errorf("invalid webhook filter %q", re)
+		}
+	}
+	return nil
+}
+
+func sameFilter(a, b *sconf.EventFilter) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
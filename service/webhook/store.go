@@ -0,0 +1,75 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	sconf "github.com/xuperchain/xuperos/common/config"
+	"github.com/xuperchain/xuperos/common/xupospb/pb"
+)
+
+// 订阅来源
+const (
+	SourceConfig = "config"
+	SourceAdmin  = "admin"
+)
+
+const recordFileExt = ".json"
+
+// record 持久化的订阅定义和推送游标，每个订阅一个文件
+type record struct {
+	Conf   *sconf.WebhookConf `json:"conf"`
+	Source string             `json:"source"`
+	// 最后一次推送成功的事件游标
+	Cursor *pb.EventCursor `json:"cursor,omitempty"`
+}
+
+func loadRecords(dir string) (map[string]*record, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(map[string]*record)
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), recordFileExt) {
+			continue
+		}
+		buf, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		rec := new(record)
+		if err := json.Unmarshal(buf, rec); err != nil || rec.Conf == nil {
+			// 损坏的记录忽略，避免影响其他订阅
+			continue
+		}
+		records[rec.Conf.Name] = rec
+	}
+	return records, nil
+}
+
+// 先写临时文件再重命名，避免进程中断时记录损坏
+func saveRecord(dir string, rec *record) error {
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	fname := filepath.Join(dir, rec.Conf.Name+recordFileExt)
+	tmpName := fname + ".tmp"
+	if err := ioutil.WriteFile(tmpName, buf, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpName, fname)
+}
+
+func removeRecord(dir, name string) error {
+	err := os.Remove(filepath.Join(dir, name+recordFileExt))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}